@@ -3,18 +3,31 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/alecthomas/kingpin"
 
 	"github.com/aphistic/softcopy/internal/app/softcopy-admin/backup"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/blobs"
 	"github.com/aphistic/softcopy/internal/app/softcopy-admin/config"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/duplicates"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/gc"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/migrate"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/reencrypt"
 	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/scrub"
 	"github.com/aphistic/softcopy/internal/pkg/consts"
 )
 
 func main() {
 	cmdRunners := []runner.Runner{
 		backup.NewRunner(),
+		blobs.NewRunner(),
+		duplicates.NewRunner(),
+		gc.NewRunner(),
+		migrate.NewRunner(),
+		reencrypt.NewRunner(),
+		scrub.NewRunner(),
 	}
 
 	cfg := config.NewConfig()
@@ -40,6 +53,10 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Subcommands are parsed as "command subcommand", the runner handles
+	// its own subcommands.
+	cmd = strings.Fields(cmd)[0]
+
 	runner, ok := runners[cmd]
 	if !ok {
 		fmt.Fprintf(os.Stderr, "Couldn't find command '%s'", cmd)
@@ -8,6 +8,8 @@ import (
 
 	"github.com/aphistic/softcopy/internal/app/softcopy-server/apiserver"
 	"github.com/aphistic/softcopy/internal/app/softcopy-server/importserver"
+	"github.com/aphistic/softcopy/internal/app/softcopy-server/scrubserver"
+	"github.com/aphistic/softcopy/internal/app/softcopy-server/trashserver"
 	"github.com/aphistic/softcopy/internal/app/softcopy-server/uiserver"
 	"github.com/aphistic/softcopy/internal/pkg/api"
 )
@@ -45,6 +47,14 @@ func main() {
 				importserver.NewProcess(),
 				nacelle.WithProcessName("importers"),
 			)
+			runner.RegisterProcess(
+				trashserver.NewProcess(),
+				nacelle.WithProcessName("trash"),
+			)
+			runner.RegisterProcess(
+				scrubserver.NewProcess(),
+				nacelle.WithProcessName("scrub"),
+			)
 
 			return nil
 		},
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aphistic/softcopy/internal/app/softcopy/commander"
 	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
 	"github.com/aphistic/softcopy/pkg/proto"
 )
 
@@ -32,7 +33,11 @@ func main() {
 		os.Exit(1)
 	}
 
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", host, port), grpc.WithInsecure())
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:%d", host, port),
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(protoutil.ActorInterceptor(protoutil.CurrentActor())),
+	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error connecting to server: %s\n", err)
 		os.Exit(1)
@@ -32,15 +32,21 @@ require (
 	github.com/gobuffalo/packr v1.22.0 // indirect
 	github.com/gogo/protobuf v1.3.1
 	github.com/google/uuid v1.0.0
+	github.com/json-iterator/go v1.1.12 // indirect
+	github.com/klauspost/compress v1.11.13
 	github.com/kr/fs v0.1.0 // indirect
+	github.com/ledongthuc/pdf v0.0.0-20220302134840-0c2507a12d80
+	github.com/lib/pq v1.8.0
 	github.com/mattn/go-isatty v0.0.6 // indirect
 	github.com/mattn/go-runewidth v0.0.3 // indirect
 	github.com/mattn/go-sqlite3 v1.9.0
 	github.com/mattn/go-tty v0.0.0-20181127064339-e4f871175a2f // indirect
+	github.com/minio/minio-go/v6 v6.0.55
 	github.com/olekukonko/tablewriter v0.0.0-20180912035003-be2c049b30cc
 	github.com/onsi/gomega v1.5.0
 	github.com/pkg/sftp v1.8.3
 	github.com/pkg/term v0.0.0-20180730021639-bffc007b7fd5 // indirect
+	github.com/prometheus/client_golang v1.2.1
 	github.com/rogpeppe/go-internal v1.6.0 // indirect
 	github.com/rubenv/sql-migrate v0.0.0-20180704111356-3f452fc0ebeb
 	github.com/spaolacci/murmur3 v0.0.0-20180118202830-f09979ecbc72 // indirect
@@ -53,5 +59,6 @@ require (
 	google.golang.org/grpc v1.31.0
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
 	gopkg.in/gorp.v1 v1.7.1 // indirect
+	gopkg.in/ini.v1 v1.46.0 // indirect
 	gopkg.in/yaml.v2 v2.2.4 // indirect
 )
@@ -0,0 +1,94 @@
+package blobs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	fileLocal "github.com/aphistic/softcopy/internal/pkg/storage/file/local"
+)
+
+type Runner struct{}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) CommandName() string {
+	return CommandName
+}
+
+func (r *Runner) Setup(app *kingpin.Application) runner.Config {
+	cfg := NewConfig()
+
+	cmd := app.Command(
+		CommandName,
+		fmt.Sprintf(
+			"Manage the files kept by the %s local files engine. Stop the server first.",
+			consts.ProcessName,
+		),
+	)
+	cmd.Flag("path", "Path of the files").
+		Default("./data").StringVar(&cfg.Path)
+	cmd.Flag("dry-run", "Show what would be changed without changing it").
+		BoolVar(&cfg.DryRun)
+
+	setAction := func(action string) kingpin.Action {
+		return func(*kingpin.ParseContext) error {
+			cfg.Action = action
+			return nil
+		}
+	}
+
+	layoutCmd := cmd.Command(ActionLayout, "Move the files to another layout").
+		Action(setAction(ActionLayout))
+	layoutCmd.Arg("layout", "Layout to move the files to").
+		Required().EnumVar(&cfg.Layout, string(fileLocal.LayoutID), string(fileLocal.LayoutContent))
+
+	return cfg
+}
+
+func (r *Runner) Run(cfg runner.Config, runCfg runner.Config) int {
+	blobsCfg := runCfg.(*Config)
+
+	switch blobsCfg.Action {
+	case ActionLayout:
+		return runLayout(blobsCfg)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown action '%s'\n", blobsCfg.Action)
+		return 1
+	}
+}
+
+func runLayout(cfg *Config) int {
+	layout, err := fileLocal.ParseLayout(cfg.Layout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing layout: %s\n", err)
+		return 1
+	}
+
+	ids, err := fileLocal.MigrateLayout(cfg.Path, layout, cfg.DryRun, logging.NewNilLogger())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error migrating files: %s\n", err)
+		return 1
+	}
+
+	action := "Moved"
+	if cfg.DryRun {
+		action = "Would move"
+	}
+	for _, id := range ids {
+		fmt.Printf("%s %s\n", action, id)
+	}
+	fmt.Printf("%s %d files to the %s layout\n", action, len(ids), layout)
+
+	if !cfg.DryRun {
+		fmt.Printf("Set the layout option of the files engine to %s before starting the server\n", layout)
+	}
+
+	return 0
+}
@@ -0,0 +1,20 @@
+package blobs
+
+const CommandName = "blobs"
+
+const (
+	ActionLayout = "layout"
+)
+
+type Config struct {
+	Action string
+
+	Path   string
+	DryRun bool
+
+	Layout string
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
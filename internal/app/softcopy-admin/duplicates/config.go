@@ -0,0 +1,12 @@
+package duplicates
+
+const CommandName = "duplicates"
+
+type Config struct {
+	Near        bool
+	MaxDistance int
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
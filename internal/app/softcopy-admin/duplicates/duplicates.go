@@ -0,0 +1,99 @@
+package duplicates
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/config"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type Runner struct{}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) CommandName() string {
+	return CommandName
+}
+
+func (r *Runner) Setup(app *kingpin.Application) runner.Config {
+	cfg := NewConfig()
+
+	cmd := app.Command(CommandName, "Report documents that are stored more than once")
+	cmd.Flag("near", "Also find scanned documents that look the same but have different contents").
+		BoolVar(&cfg.Near)
+	cmd.Flag("max-distance", "How different near-duplicates can look, the server's default if 0").
+		Default("0").IntVar(&cfg.MaxDistance)
+
+	return cfg
+}
+
+func (r *Runner) Run(cfg runner.Config, runCfg runner.Config) int {
+	genCfg := cfg.(*config.Config)
+	dupCfg := runCfg.(*Config)
+
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:%d", genCfg.Host, genCfg.Port),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error dialing server: %s\n", err)
+		return 1
+	}
+	defer conn.Close()
+
+	adminClient := scproto.NewSoftcopyAdminClient(conn)
+
+	res, err := adminClient.FindDuplicates(
+		context.Background(),
+		&scproto.FindDuplicatesRequest{
+			Near:        dupCfg.Near,
+			MaxDistance: int32(dupCfg.MaxDistance),
+		},
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error finding duplicates: %s\n", err)
+		return 1
+	}
+
+	exact, near := 0, 0
+	for _, group := range res.GetGroups() {
+		if group.GetHash() != "" {
+			exact++
+			fmt.Printf("Same contents %s:\n", group.GetHash())
+		} else {
+			near++
+			fmt.Printf("Look alike, distance %d:\n", group.GetDistance())
+		}
+
+		for _, file := range group.GetFiles() {
+			docDate, err := types.TimestampFromProto(file.GetDocumentDate())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading document date: %s\n", err)
+				return 1
+			}
+
+			fmt.Printf(
+				"  %s  %s  %s (%d bytes)\n",
+				file.GetId(), docDate.Format("2006-01-02"),
+				file.GetFilename(), file.GetContentSize(),
+			)
+		}
+	}
+
+	if dupCfg.Near {
+		fmt.Printf("Found %d sets of duplicates and %d sets of near-duplicates\n", exact, near)
+	} else {
+		fmt.Printf("Found %d sets of duplicates\n", exact)
+	}
+
+	return 0
+}
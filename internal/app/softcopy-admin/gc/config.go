@@ -0,0 +1,16 @@
+package gc
+
+import (
+	"time"
+)
+
+const CommandName = "gc"
+
+type Config struct {
+	DryRun  bool
+	TempAge time.Duration
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
@@ -0,0 +1,99 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"google.golang.org/grpc"
+
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/config"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type Runner struct{}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) CommandName() string {
+	return CommandName
+}
+
+func (r *Runner) Setup(app *kingpin.Application) runner.Config {
+	cfg := NewConfig()
+
+	cmd := app.Command(
+		CommandName,
+		fmt.Sprintf("Remove %s data that's no longer used by any document", consts.ProcessName),
+	)
+	cmd.Flag("dry-run", "Show what would be removed without removing it").
+		BoolVar(&cfg.DryRun)
+	cmd.Flag("temp-age", "How old unfinished uploads need to be before they're removed").
+		Default("24h").DurationVar(&cfg.TempAge)
+
+	return cfg
+}
+
+func (r *Runner) Run(cfg runner.Config, runCfg runner.Config) int {
+	genCfg := cfg.(*config.Config)
+	gcCfg := runCfg.(*Config)
+
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:%d", genCfg.Host, genCfg.Port),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error dialing server: %s\n", err)
+		return 1
+	}
+	defer conn.Close()
+
+	adminClient := scproto.NewSoftcopyAdminClient(conn)
+
+	res, err := adminClient.CollectGarbage(
+		context.Background(),
+		&scproto.CollectGarbageRequest{
+			DryRun:         gcCfg.DryRun,
+			TempAgeSeconds: int64(gcCfg.TempAge.Seconds()),
+		},
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error collecting garbage: %s\n", err)
+		return 1
+	}
+
+	action := "Removed"
+	if res.GetDryRun() {
+		action = "Would remove"
+	}
+
+	totalSize := uint64(0)
+	for _, md := range res.GetMetadata() {
+		fmt.Printf("%s unused contents %s (%d bytes)\n", action, md.GetHash(), md.GetContentSize())
+		totalSize += md.GetContentSize()
+	}
+	for _, blobID := range res.GetBlobIds() {
+		fmt.Printf("%s stored file %s without metadata\n", action, blobID)
+	}
+	for _, tempFile := range res.GetTempFiles() {
+		fmt.Printf(
+			"%s unfinished upload %s (%d bytes)\n",
+			action, tempFile.GetId(), tempFile.GetContentSize(),
+		)
+		totalSize += tempFile.GetContentSize()
+	}
+
+	fmt.Printf(
+		"%s %d unused contents, %d stored files and %d unfinished uploads, at least %d bytes\n",
+		action,
+		len(res.GetMetadata()), len(res.GetBlobIds()), len(res.GetTempFiles()),
+		totalSize,
+	)
+
+	return 0
+}
@@ -0,0 +1,29 @@
+package migrate
+
+const CommandName = "migrate"
+
+const (
+	ActionStatus = "status"
+	ActionUp     = "up"
+	ActionDown   = "down"
+)
+
+type Config struct {
+	Action string
+
+	Engine string
+	Path   string
+	DSN    string
+
+	// To is the version to migrate to, up migrates to the latest
+	// version unless ToSet.
+	To    int
+	ToSet bool
+
+	SnapshotDir string
+	NoSnapshot  bool
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
@@ -0,0 +1,204 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"text/tabwriter"
+
+	"github.com/alecthomas/kingpin"
+	sqlmigrate "github.com/rubenv/sql-migrate"
+
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	dataPostgres "github.com/aphistic/softcopy/internal/pkg/storage/data/postgres"
+	dataSqlite "github.com/aphistic/softcopy/internal/pkg/storage/data/sqlite"
+	"github.com/aphistic/softcopy/internal/pkg/storage/migration"
+)
+
+// migrator is a data engine whose schema can be migrated.
+type migrator interface {
+	Migrations() ([]*migration.Migration, error)
+	MigrateTo(version int) (int, error)
+	Snapshot(dir string) (string, error)
+	Close() error
+}
+
+type Runner struct{}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) CommandName() string {
+	return CommandName
+}
+
+func (r *Runner) Setup(app *kingpin.Application) runner.Config {
+	cfg := NewConfig()
+
+	cmd := app.Command(
+		CommandName,
+		fmt.Sprintf(
+			"Manage the %s database schema. Stop the server first, it migrates to the latest version when it starts.",
+			consts.ProcessName,
+		),
+	)
+	cmd.Flag("engine", "Metadata engine of the database").
+		Default("sqlite").EnumVar(&cfg.Engine, "sqlite", "postgres")
+	cmd.Flag("path", "Path of the sqlite database").
+		Default("./data/softcopy.db").StringVar(&cfg.Path)
+	cmd.Flag("dsn", "Connection string of the postgres database").
+		Envar("SOFTCOPY_DSN").StringVar(&cfg.DSN)
+	cmd.Flag("snapshot-dir", "Where to save a snapshot of the database before migrating, next to a sqlite database by default").
+		StringVar(&cfg.SnapshotDir)
+	cmd.Flag("no-snapshot", "Migrate without saving a snapshot of the database").
+		BoolVar(&cfg.NoSnapshot)
+
+	setAction := func(action string) kingpin.Action {
+		return func(*kingpin.ParseContext) error {
+			cfg.Action = action
+			return nil
+		}
+	}
+
+	cmd.Command(ActionStatus, "Show applied and pending migrations").
+		Action(setAction(ActionStatus))
+
+	upCmd := cmd.Command(ActionUp, "Apply pending migrations").
+		Action(setAction(ActionUp))
+	upCmd.Flag("to", "Version to migrate up to, the latest by default").
+		Action(func(*kingpin.ParseContext) error {
+			cfg.ToSet = true
+			return nil
+		}).
+		IntVar(&cfg.To)
+
+	downCmd := cmd.Command(ActionDown, "Revert applied migrations").
+		Action(setAction(ActionDown))
+	downCmd.Flag("to", "Version to migrate down to, 0 reverts every migration").
+		Required().IntVar(&cfg.To)
+
+	return cfg
+}
+
+func (r *Runner) Run(cfg runner.Config, runCfg runner.Config) int {
+	migrateCfg := runCfg.(*Config)
+
+	m, err := openMigrator(migrateCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening database: %s\n", err)
+		return 1
+	}
+	defer m.Close()
+
+	status, err := m.Migrations()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting migrations: %s\n", err)
+		return 1
+	}
+
+	if migrateCfg.Action == ActionStatus {
+		printStatus(status)
+		return 0
+	}
+
+	version := migrateCfg.To
+	if migrateCfg.Action == ActionUp && !migrateCfg.ToSet {
+		version = migration.Latest(status)
+	}
+
+	dir, count, err := migration.Plan(status, version)
+	if err == migration.ErrNewerSchema {
+		fmt.Fprintf(
+			os.Stderr,
+			"The database was migrated by a newer version of %s, use that version to migrate it\n",
+			consts.ProcessName,
+		)
+		return 1
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "Error planning migration: %s\n", err)
+		return 1
+	}
+	if migrateCfg.Action == ActionUp && dir == sqlmigrate.Down {
+		fmt.Fprintf(os.Stderr, "Version %d is older than the current schema, use down instead\n", version)
+		return 1
+	}
+	if migrateCfg.Action == ActionDown && dir == sqlmigrate.Up && count > 0 {
+		fmt.Fprintf(os.Stderr, "Version %d is newer than the current schema, use up instead\n", version)
+		return 1
+	}
+	if count == 0 {
+		fmt.Printf("Schema is already at version %d\n", migration.Current(status))
+		return 0
+	}
+
+	if !migrateCfg.NoSnapshot {
+		snapshotPath, err := m.Snapshot(snapshotDir(migrateCfg))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving snapshot, use --no-snapshot to skip it: %s\n", err)
+			return 1
+		}
+		fmt.Printf("Saved snapshot of the database to %s\n", snapshotPath)
+	}
+
+	applied, err := m.MigrateTo(version)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error migrating after %d migrations: %s\n", applied, err)
+		return 1
+	}
+
+	fmt.Printf("Applied %d migrations, schema is at version %d\n", applied, version)
+
+	return 0
+}
+
+func openMigrator(cfg *Config) (migrator, error) {
+	switch cfg.Engine {
+	case "postgres":
+		if cfg.DSN == "" {
+			return nil, fmt.Errorf("--dsn or SOFTCOPY_DSN is required for postgres")
+		}
+		return dataPostgres.NewClient(cfg.DSN)
+	default:
+		// Don't create a new, empty database for a mistyped path
+		_, err := os.Stat(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		return dataSqlite.NewClient(cfg.Path)
+	}
+}
+
+func snapshotDir(cfg *Config) string {
+	if cfg.SnapshotDir != "" {
+		return cfg.SnapshotDir
+	}
+	if cfg.Engine == "sqlite" {
+		return path.Join(path.Dir(cfg.Path), "snapshots")
+	}
+	return "snapshots"
+}
+
+func printStatus(status []*migration.Migration) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "VERSION\tMIGRATION\tSTATUS\n")
+	for _, m := range status {
+		state := "pending"
+		if m.Unknown {
+			state = "applied by a newer version"
+		} else if m.Applied {
+			state = fmt.Sprintf("applied %s", m.AppliedAt.Local().Format("2006-01-02 15:04:05"))
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", m.Version, m.ID, state)
+	}
+	w.Flush()
+
+	fmt.Printf(
+		"\nSchema is at version %d, this version of %s supports up to %d\n",
+		migration.Current(status), consts.ProcessName, migration.Latest(status),
+	)
+	if migration.Check(status) == migration.ErrNewerSchema {
+		fmt.Printf("The database was migrated by a newer version and can't be migrated by this one\n")
+	}
+}
@@ -0,0 +1,11 @@
+package reencrypt
+
+const CommandName = "reencrypt"
+
+type Config struct {
+	DryRun bool
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
@@ -0,0 +1,89 @@
+package reencrypt
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/config"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type Runner struct{}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) CommandName() string {
+	return CommandName
+}
+
+func (r *Runner) Setup(app *kingpin.Application) runner.Config {
+	cfg := NewConfig()
+
+	cmd := app.Command(
+		CommandName,
+		fmt.Sprintf(
+			"Encrypt %s documents with the current key, so earlier keys can be removed from the config",
+			consts.ProcessName,
+		),
+	)
+	cmd.Flag("dry-run", "Show what would be encrypted without encrypting it").
+		BoolVar(&cfg.DryRun)
+
+	return cfg
+}
+
+func (r *Runner) Run(cfg runner.Config, runCfg runner.Config) int {
+	genCfg := cfg.(*config.Config)
+	reencryptCfg := runCfg.(*Config)
+
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:%d", genCfg.Host, genCfg.Port),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error dialing server: %s\n", err)
+		return 1
+	}
+	defer conn.Close()
+
+	adminClient := scproto.NewSoftcopyAdminClient(conn)
+
+	res, err := adminClient.ReencryptFiles(
+		context.Background(),
+		&scproto.ReencryptFilesRequest{
+			DryRun: reencryptCfg.DryRun,
+		},
+	)
+	if status.Code(err) == codes.FailedPrecondition {
+		fmt.Fprintf(os.Stderr, "Encryption isn't configured for the files engine\n")
+		return 1
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "Error encrypting files: %s\n", err)
+		return 1
+	}
+
+	action := "Encrypted"
+	if res.GetDryRun() {
+		action = "Would encrypt"
+	}
+
+	for _, blobID := range res.GetBlobIds() {
+		fmt.Printf("%s stored file %s\n", action, blobID)
+	}
+	fmt.Printf(
+		"%s %d stored files with key %s\n",
+		action, len(res.GetBlobIds()), res.GetKeyId(),
+	)
+
+	return 0
+}
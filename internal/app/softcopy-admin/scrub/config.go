@@ -0,0 +1,12 @@
+package scrub
+
+const CommandName = "scrub"
+
+type Config struct {
+	Run    bool
+	Repair bool
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
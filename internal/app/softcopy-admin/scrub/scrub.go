@@ -0,0 +1,137 @@
+package scrub
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/config"
+	"github.com/aphistic/softcopy/internal/app/softcopy-admin/runner"
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type Runner struct{}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) CommandName() string {
+	return CommandName
+}
+
+func (r *Runner) Setup(app *kingpin.Application) runner.Config {
+	cfg := NewConfig()
+
+	cmd := app.Command(
+		CommandName,
+		fmt.Sprintf(
+			"Show what the latest checks of %s documents against their hashes found",
+			consts.ProcessName,
+		),
+	)
+	cmd.Flag("run", "Check every document now instead of showing the latest results").
+		BoolVar(&cfg.Run)
+	cmd.Flag("repair", "Restore damaged documents from the server's backup, implies --run").
+		BoolVar(&cfg.Repair)
+
+	return cfg
+}
+
+func (r *Runner) Run(cfg runner.Config, runCfg runner.Config) int {
+	genCfg := cfg.(*config.Config)
+	scrubCfg := runCfg.(*Config)
+
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:%d", genCfg.Host, genCfg.Port),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error dialing server: %s\n", err)
+		return 1
+	}
+	defer conn.Close()
+
+	adminClient := scproto.NewSoftcopyAdminClient(conn)
+
+	if scrubCfg.Run || scrubCfg.Repair {
+		return runScrub(adminClient, scrubCfg.Repair)
+	}
+
+	res, err := adminClient.GetScrubResults(
+		context.Background(),
+		&scproto.GetScrubResultsRequest{},
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting scrub results: %s\n", err)
+		return 1
+	}
+
+	printProblems(res.GetProblems())
+	for _, count := range res.GetCounts() {
+		fmt.Printf("%s: %d\n", statusName(count.GetStatus()), count.GetCount())
+	}
+
+	return 0
+}
+
+func runScrub(adminClient scproto.SoftcopyAdminClient, repair bool) int {
+	res, err := adminClient.ScrubFiles(
+		context.Background(),
+		&scproto.ScrubFilesRequest{
+			Repair: repair,
+		},
+	)
+	if status.Code(err) == codes.FailedPrecondition {
+		fmt.Fprintf(os.Stderr, "No backup to repair from is configured on the server\n")
+		return 1
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "Error scrubbing files: %s\n", err)
+		return 1
+	}
+
+	printProblems(res.GetProblems())
+	fmt.Printf(
+		"Checked %d stored files, %d had problems\n",
+		res.GetChecked(), len(res.GetProblems()),
+	)
+
+	for _, problem := range res.GetProblems() {
+		if problem.GetStatus() != scproto.ScrubStatus_SCRUB_STATUS_REPAIRED {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func printProblems(problems []*scproto.ScrubResult) {
+	for _, problem := range problems {
+		md := problem.GetMetadata()
+		fmt.Printf(
+			"%s contents %s (stored file %s)\n",
+			statusName(problem.GetStatus()), md.GetHash(), md.GetId(),
+		)
+	}
+}
+
+func statusName(s scproto.ScrubStatus) string {
+	switch s {
+	case scproto.ScrubStatus_SCRUB_STATUS_OK:
+		return "Ok"
+	case scproto.ScrubStatus_SCRUB_STATUS_CORRUPT:
+		return "Corrupt"
+	case scproto.ScrubStatus_SCRUB_STATUS_MISSING:
+		return "Missing"
+	case scproto.ScrubStatus_SCRUB_STATUS_REPAIRED:
+		return "Repaired"
+	default:
+		return "Not checked"
+	}
+}
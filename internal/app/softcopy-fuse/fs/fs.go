@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/aphistic/softcopy/internal/pkg/logging"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
 	scproto "github.com/aphistic/softcopy/pkg/proto"
 )
 
@@ -69,7 +70,11 @@ type FileSystem struct {
 }
 
 func NewFileSystem(host string, port int, opts ...FileSystemOption) (*FileSystem, error) {
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", host, port), grpc.WithInsecure())
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:%d", host, port),
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(protoutil.ActorInterceptor(protoutil.CurrentActor())),
+	)
 	if err != nil {
 		return nil, err
 	}
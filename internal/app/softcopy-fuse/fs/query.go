@@ -0,0 +1,125 @@
+package fs
+
+import (
+	"context"
+	"os"
+
+	"bazil.org/fuse"
+	fusefs "bazil.org/fuse/fs"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+// fsByQueryDir treats the name of any entry looked up in it as a query,
+// so `ls "by-query/taxes AND date:2020"` lists the matching files. It
+// has no entries of its own.
+type fsByQueryDir struct {
+	fs *FileSystem
+}
+
+func newFSByQueryDir(fs *FileSystem) *fsByQueryDir {
+	return &fsByQueryDir{
+		fs: fs,
+	}
+}
+
+func (bqd *fsByQueryDir) Attr(ctx context.Context, attr *fuse.Attr) error {
+	attr.Mode = os.ModeDir | 0555
+	return nil
+}
+
+func (bqd *fsByQueryDir) Lookup(ctx context.Context, name string) (fusefs.Node, error) {
+	qd := &fsQueryDir{
+		query: name,
+		fs:    bqd.fs,
+	}
+
+	// Make sure the query is valid before returning it as a directory
+	_, err := qd.queryFiles(ctx)
+	if status.Code(err) == codes.InvalidArgument {
+		return nil, fuse.ENOENT
+	} else if err != nil {
+		return nil, err
+	}
+
+	return qd, nil
+}
+
+func (bqd *fsByQueryDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	return []fuse.Dirent{}, nil
+}
+
+type fsQueryDir struct {
+	query string
+	fs    *FileSystem
+}
+
+func (qd *fsQueryDir) Attr(ctx context.Context, attr *fuse.Attr) error {
+	attr.Mode = os.ModeDir | 0555
+	return nil
+}
+
+func (qd *fsQueryDir) queryFiles(ctx context.Context) ([]*scproto.File, error) {
+	res, err := qd.fs.client.QueryFiles(ctx, &scproto.QueryFilesRequest{
+		Query: qd.query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetFiles(), nil
+}
+
+func (qd *fsQueryDir) Lookup(ctx context.Context, name string) (fusefs.Node, error) {
+	files, err := qd.queryFiles(ctx)
+	if err != nil {
+		qd.fs.logger.Error("could not query files for '%s': %s", qd.query, err)
+		return nil, err
+	}
+
+	for _, protoFile := range files {
+		file, err := protoutil.ProtoToFile(protoFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if getFullFilename(file.DocumentDate, file.Filename) == name {
+			return newFSFile(file, records.FILE_MODE_READ, qd.fs), nil
+		}
+	}
+
+	return nil, fuse.ENOENT
+}
+
+func (qd *fsQueryDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	files, err := qd.queryFiles(ctx)
+	if err != nil {
+		qd.fs.logger.Error("could not query files for '%s': %s", qd.query, err)
+		return nil, err
+	}
+
+	entries := []fuse.Dirent{}
+	for idx, file := range files {
+		date, err := types.TimestampFromProto(file.GetDocumentDate())
+		if err != nil {
+			qd.fs.logger.Error(
+				"could not get date for %s: %s",
+				file.GetFilename(), err,
+			)
+			return nil, err
+		}
+
+		entries = append(entries, fuse.Dirent{
+			Inode: uint64(idx),
+			Type:  fuse.DT_File,
+			Name:  getFullFilename(date, file.GetFilename()),
+		})
+	}
+
+	return entries, nil
+}
@@ -12,6 +12,7 @@ import (
 var byTagID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000001"))
 var byDateID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000002"))
 var uploadID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000003"))
+var byQueryID = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000004"))
 
 type fsRootDir struct {
 	fs *FileSystem
@@ -34,6 +35,8 @@ func (rd *fsRootDir) Lookup(ctx context.Context, name string) (fusefs.Node, erro
 		return newFSByTagDir(rd.fs), nil
 	case "by-date":
 		return newFSByDateDir(rd.fs), nil
+	case "by-query":
+		return newFSByQueryDir(rd.fs), nil
 	case "upload":
 		return newFSUploadDir(rd.fs), nil
 	default:
@@ -53,6 +56,11 @@ func (rd *fsRootDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
 			Type:  fuse.DT_Dir,
 			Name:  "by-date",
 		},
+		{
+			Inode: rd.fs.inodeForID(byQueryID),
+			Type:  fuse.DT_Dir,
+			Name:  "by-query",
+		},
 		{
 			Inode: rd.fs.inodeForID(uploadID),
 			Type:  fuse.DT_Dir,
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -25,6 +28,8 @@ type fsByTagDir struct {
 }
 
 var _ fusefs.NodeMkdirer = &fsByTagDir{}
+var _ fusefs.NodeRenamer = &fsByTagDir{}
+var _ fusefs.NodeRemover = &fsByTagDir{}
 
 func newFSByTagDir(fs *FileSystem) *fsByTagDir {
 	return &fsByTagDir{
@@ -70,36 +75,167 @@ func (btd *fsByTagDir) Mkdir(ctx context.Context, req *fuse.MkdirRequest) (fusef
 	}, nil
 }
 
-func (btd *fsByTagDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
-	res, err := btd.fs.client.GetAllTags(ctx, &scproto.GetAllTagsRequest{})
+// tagErrorToFuse converts errors from modifying tags to the matching
+// fuse error.
+func tagErrorToFuse(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return fuse.ENOENT
+	case codes.AlreadyExists:
+		return fuse.EEXIST
+	case codes.PermissionDenied:
+		return fuse.EPERM
+	case codes.FailedPrecondition:
+		return fuse.Errno(syscall.ENOTEMPTY)
+	default:
+		return err
+	}
+}
+
+func (btd *fsByTagDir) Rename(
+	ctx context.Context,
+	req *fuse.RenameRequest,
+	newDir fusefs.Node,
+) error {
+	return renameTag(ctx, btd.fs, req.OldName, newDir, req.NewName)
+}
+
+// childTagName returns the full name of a tag created as name in the
+// directory, or false if the directory can't contain tags.
+func childTagName(dir fusefs.Node, name string) (string, bool) {
+	switch d := dir.(type) {
+	case *fsByTagDir:
+		return name, true
+	case *TagDir:
+		return d.tag + records.TagSeparator + name, true
+	default:
+		return "", false
+	}
+}
+
+func renameTag(
+	ctx context.Context,
+	fs *FileSystem,
+	oldName string,
+	newDir fusefs.Node,
+	newBaseName string,
+) error {
+	newName, ok := childTagName(newDir, newBaseName)
+	if !ok {
+		return fuse.EPERM
+	}
+
+	_, err := fs.client.RenameTag(ctx, &scproto.RenameTagRequest{
+		OldName: oldName,
+		NewName: newName,
+	})
+	if err != nil {
+		fs.logger.Debug("could not rename tag '%s': %s", oldName, err)
+		return tagErrorToFuse(err)
+	}
+
+	return nil
+}
+
+func deleteTag(ctx context.Context, fs *FileSystem, name string) error {
+	_, err := fs.client.DeleteTag(ctx, &scproto.DeleteTagRequest{
+		Name: name,
+	})
+	if err != nil {
+		fs.logger.Debug("could not delete tag '%s': %s", name, err)
+		return tagErrorToFuse(err)
+	}
+
+	return nil
+}
+
+// tagDirEntries returns directory entries for the child tags of parent, or
+// the top level tags if parent is empty.
+func tagDirEntries(ctx context.Context, fs *FileSystem, parent string) ([]fuse.Dirent, error) {
+	res, err := fs.client.GetAllTags(ctx, &scproto.GetAllTagsRequest{})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading all tags: %s\n", err)
 		return nil, err
 	}
 
 	entries := []fuse.Dirent{}
-	for idx, tag := range res.GetTags() {
+	for _, tag := range res.GetTags() {
+		if records.TagParentName(tag.GetName()) != parent {
+			continue
+		}
+
+		tagID, err := uuid.Parse(tag.GetId())
+		if err != nil {
+			return nil, err
+		}
+
 		entries = append(entries, fuse.Dirent{
-			Inode: uint64(idx),
+			Inode: fs.inodeForID(tagID),
 			Type:  fuse.DT_Dir,
-			Name:  tag.GetName(),
+			Name:  records.TagBaseName(tag.GetName()),
 		})
 	}
 
 	return entries, nil
 }
 
+func (btd *fsByTagDir) Remove(ctx context.Context, req *fuse.RemoveRequest) error {
+	if !req.Dir {
+		return fuse.ENOENT
+	}
+
+	return deleteTag(ctx, btd.fs, req.Name)
+}
+
+func (btd *fsByTagDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	entries, err := tagDirEntries(ctx, btd.fs, "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading all tags: %s\n", err)
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// TagDir contains the files with a tag, including files tagged with any
+// of its descendants, and a directory for each of its child tags.
 type TagDir struct {
 	tag string
 	fs  *FileSystem
 }
 
+var _ fusefs.NodeMkdirer = &TagDir{}
+
 func (td *TagDir) Attr(ctx context.Context, attr *fuse.Attr) error {
 	attr.Mode = os.ModeDir | 0755
 	return nil
 }
 
+func (td *TagDir) childTag(ctx context.Context, name string) (string, bool, error) {
+	childName := td.tag + records.TagSeparator + name
+	_, err := td.fs.client.FindTagByName(ctx, &scproto.FindTagByNameRequest{
+		Name: childName,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return childName, true, nil
+}
+
 func (td *TagDir) Lookup(ctx context.Context, name string) (fusefs.Node, error) {
+	childName, ok, err := td.childTag(ctx, name)
+	if err != nil {
+		td.fs.logger.Error("error finding tag by name: %s", err)
+		return nil, err
+	} else if ok {
+		return &TagDir{
+			tag: childName,
+			fs:  td.fs,
+		}, nil
+	}
+
 	date, filename, err := splitFullFilename(name)
 	if err != nil {
 		return nil, fuse.ENOENT
@@ -138,6 +274,13 @@ func (td *TagDir) Rename(
 	// TODO try moving from outside of mount point into a tag directory
 	td.fs.logger.Debug("rename:\n%#v\n%#v", req, newDir)
 
+	childName, ok, err := td.childTag(ctx, req.OldName)
+	if err != nil {
+		return err
+	} else if ok {
+		return renameTag(ctx, td.fs, childName, newDir, req.NewName)
+	}
+
 	addTag := ""
 	if tagDir, ok := newDir.(*TagDir); ok {
 		addTag = tagDir.tag
@@ -251,6 +394,8 @@ func (td *TagDir) Create(
 	createRes, err := td.fs.client.CreateFile(ctx, &scproto.CreateFileRequest{
 		Filename:     req.Name,
 		DocumentDate: docDate,
+		Source:       records.FileSourceFuse,
+		OriginalPath: path.Join("/by-tag", td.tag, req.Name),
 	})
 	if status.Code(err) == codes.AlreadyExists {
 		return nil, nil, fuse.EEXIST
@@ -293,10 +438,29 @@ func (td *TagDir) Create(
 	return file, fileHandle, nil
 }
 
+func (td *TagDir) Mkdir(ctx context.Context, req *fuse.MkdirRequest) (fusefs.Node, error) {
+	childName := td.tag + records.TagSeparator + req.Name
+	_, err := td.fs.client.CreateTags(ctx, &scproto.CreateTagsRequest{
+		Names: []string{childName},
+	})
+	if err != nil {
+		return nil, tagErrorToFuse(err)
+	}
+
+	return &TagDir{
+		tag: childName,
+		fs:  td.fs,
+	}, nil
+}
+
 func (td *TagDir) Remove(
 	ctx context.Context,
 	req *fuse.RemoveRequest,
 ) error {
+	if req.Dir {
+		return deleteTag(ctx, td.fs, td.tag+records.TagSeparator+req.Name)
+	}
+
 	rmDate, rmName, err := splitFullFilename(req.Name)
 	if err != nil {
 		return err
@@ -317,9 +481,16 @@ func (td *TagDir) Remove(
 		return err
 	}
 
+	// The file could be in the directory because of a descendant tag, so
+	// remove those as well.
+	removedTags, err := td.tagsForFileUnder(ctx, protoFile.File.Id)
+	if err != nil {
+		return err
+	}
+
 	_, err = td.fs.client.UpdateFileTags(ctx, &scproto.UpdateFileTagsRequest{
 		FileId:      protoFile.File.Id,
-		RemovedTags: []string{td.tag},
+		RemovedTags: removedTags,
 	})
 	if err != nil {
 		return err
@@ -328,7 +499,36 @@ func (td *TagDir) Remove(
 	return nil
 }
 
+// tagsForFileUnder returns the directory's tag along with any of the file's
+// tags that are descendants of it.
+func (td *TagDir) tagsForFileUnder(ctx context.Context, fileID string) ([]string, error) {
+	res, err := td.fs.client.GetTagsForFile(ctx, &scproto.GetTagsForFileRequest{
+		FileId: fileID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{td.tag}
+	for _, tag := range res.GetTags() {
+		if strings.HasPrefix(tag.GetName(), td.tag+records.TagSeparator) {
+			tags = append(tags, tag.GetName())
+		}
+	}
+
+	return tags, nil
+}
+
 func (td *TagDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	entries, err := tagDirEntries(ctx, td.fs, td.tag)
+	if err != nil {
+		td.fs.logger.Error(
+			"could not read child tags for tag '%s': %s",
+			td.tag, err,
+		)
+		return nil, err
+	}
+
 	res, err := td.fs.client.FindFilesWithTags(ctx, &scproto.FindFilesWithTagsRequest{
 		TagNames: []string{td.tag},
 	})
@@ -340,7 +540,6 @@ func (td *TagDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
 		return nil, err
 	}
 
-	entries := []fuse.Dirent{}
 	for idx, file := range res.GetFiles() {
 		date, err := types.TimestampFromProto(file.GetDocumentDate())
 		if err != nil {
@@ -0,0 +1,209 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"bazil.org/fuse"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+	protomock "github.com/aphistic/softcopy/pkg/proto/mock"
+)
+
+func newTestFileSystem(client scproto.SoftcopyClient) *FileSystem {
+	return &FileSystem{
+		logger: logging.NewNilLogger(),
+		client: client,
+
+		inodeToID: map[uint64]uuid.UUID{},
+		idToInode: map[uuid.UUID]uint64{},
+	}
+}
+
+func TestByTagDirRename(t *testing.T) {
+	t.Run("renames tag", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.RenameTagFunc.PushHook(func(
+			ctx context.Context,
+			req *scproto.RenameTagRequest,
+			opts ...grpc.CallOption,
+		) (*scproto.RenameTagResponse, error) {
+			assert.Equal(t, "taxes", req.GetOldName())
+			assert.Equal(t, "tax-returns", req.GetNewName())
+			return &scproto.RenameTagResponse{}, nil
+		})
+
+		btd := newFSByTagDir(newTestFileSystem(scc))
+		err := btd.Rename(context.Background(), &fuse.RenameRequest{
+			OldName: "taxes",
+			NewName: "tax-returns",
+		}, btd)
+		require.NoError(t, err)
+		assert.Len(t, scc.RenameTagFunc.History(), 1)
+	})
+	t.Run("new name exists", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.RenameTagFunc.SetDefaultReturn(nil, status.Error(codes.AlreadyExists, "exists"))
+
+		btd := newFSByTagDir(newTestFileSystem(scc))
+		err := btd.Rename(context.Background(), &fuse.RenameRequest{
+			OldName: "taxes",
+			NewName: "bills",
+		}, btd)
+		assert.Equal(t, fuse.EEXIST, err)
+	})
+	t.Run("outside of by-tag", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+
+		btd := newFSByTagDir(newTestFileSystem(scc))
+		err := btd.Rename(context.Background(), &fuse.RenameRequest{
+			OldName: "taxes",
+			NewName: "taxes",
+		}, newFSUploadDir(btd.fs))
+		assert.Equal(t, fuse.EPERM, err)
+		assert.Len(t, scc.RenameTagFunc.History(), 0)
+	})
+}
+
+func TestByTagDirRemove(t *testing.T) {
+	tests := map[codes.Code]error{
+		codes.OK:                 nil,
+		codes.NotFound:           fuse.ENOENT,
+		codes.PermissionDenied:   fuse.EPERM,
+		codes.FailedPrecondition: fuse.Errno(syscall.ENOTEMPTY),
+	}
+
+	for code, expected := range tests {
+		t.Run(code.String(), func(t *testing.T) {
+			scc := protomock.NewMockSoftcopyClient()
+			scc.DeleteTagFunc.SetDefaultHook(func(
+				ctx context.Context,
+				req *scproto.DeleteTagRequest,
+				opts ...grpc.CallOption,
+			) (*scproto.DeleteTagResponse, error) {
+				assert.Equal(t, "taxes", req.GetName())
+				if code != codes.OK {
+					return nil, status.Error(code, "error")
+				}
+				return &scproto.DeleteTagResponse{}, nil
+			})
+
+			btd := newFSByTagDir(newTestFileSystem(scc))
+			err := btd.Remove(context.Background(), &fuse.RemoveRequest{
+				Name: "taxes",
+				Dir:  true,
+			})
+			assert.Equal(t, expected, err)
+		})
+	}
+}
+
+func TestNestedTagDirs(t *testing.T) {
+	allTags := func(
+		ctx context.Context,
+		req *scproto.GetAllTagsRequest,
+		opts ...grpc.CallOption,
+	) (*scproto.GetAllTagsResponse, error) {
+		res := &scproto.GetAllTagsResponse{}
+		for _, name := range []string{"finance", "finance/taxes", "finance/taxes/2020", "unfiled"} {
+			res.Tags = append(res.Tags, &scproto.Tag{
+				Id:   uuid.New().String(),
+				Name: name,
+			})
+		}
+		return res, nil
+	}
+	dirNames := func(entries []fuse.Dirent) []string {
+		names := []string{}
+		for _, entry := range entries {
+			if entry.Type == fuse.DT_Dir {
+				names = append(names, entry.Name)
+			}
+		}
+		return names
+	}
+
+	t.Run("by-tag lists top level tags", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.GetAllTagsFunc.SetDefaultHook(allTags)
+
+		entries, err := newFSByTagDir(newTestFileSystem(scc)).ReadDirAll(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"finance", "unfiled"}, dirNames(entries))
+	})
+	t.Run("tag dir lists child tags", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.GetAllTagsFunc.SetDefaultHook(allTags)
+		scc.FindFilesWithTagsFunc.SetDefaultReturn(&scproto.FindFilesWithTagsResponse{}, nil)
+
+		td := &TagDir{tag: "finance", fs: newTestFileSystem(scc)}
+		entries, err := td.ReadDirAll(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"taxes"}, dirNames(entries))
+	})
+	t.Run("lookup child tag", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.FindTagByNameFunc.SetDefaultHook(func(
+			ctx context.Context,
+			req *scproto.FindTagByNameRequest,
+			opts ...grpc.CallOption,
+		) (*scproto.FindTagByNameResponse, error) {
+			assert.Equal(t, "finance/taxes", req.GetName())
+			return &scproto.FindTagByNameResponse{
+				Tag: &scproto.Tag{Name: req.GetName()},
+			}, nil
+		})
+
+		td := &TagDir{tag: "finance", fs: newTestFileSystem(scc)}
+		n, err := td.Lookup(context.Background(), "taxes")
+		require.NoError(t, err)
+		require.IsType(t, &TagDir{}, n)
+		assert.Equal(t, "finance/taxes", n.(*TagDir).tag)
+	})
+	t.Run("mkdir creates child tag", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.CreateTagsFunc.SetDefaultHook(func(
+			ctx context.Context,
+			req *scproto.CreateTagsRequest,
+			opts ...grpc.CallOption,
+		) (*scproto.CreateTagsResponse, error) {
+			assert.Equal(t, []string{"finance/taxes"}, req.GetNames())
+			return &scproto.CreateTagsResponse{}, nil
+		})
+
+		td := &TagDir{tag: "finance", fs: newTestFileSystem(scc)}
+		n, err := td.Mkdir(context.Background(), &fuse.MkdirRequest{Name: "taxes"})
+		require.NoError(t, err)
+		assert.Equal(t, "finance/taxes", n.(*TagDir).tag)
+	})
+	t.Run("move tag to another parent", func(t *testing.T) {
+		scc := protomock.NewMockSoftcopyClient()
+		scc.FindTagByNameFunc.SetDefaultReturn(&scproto.FindTagByNameResponse{}, nil)
+		scc.RenameTagFunc.SetDefaultHook(func(
+			ctx context.Context,
+			req *scproto.RenameTagRequest,
+			opts ...grpc.CallOption,
+		) (*scproto.RenameTagResponse, error) {
+			assert.Equal(t, "finance/taxes", req.GetOldName())
+			assert.Equal(t, "personal/tax-returns", req.GetNewName())
+			return &scproto.RenameTagResponse{}, nil
+		})
+
+		fs := newTestFileSystem(scc)
+		td := &TagDir{tag: "finance", fs: fs}
+		err := td.Rename(context.Background(), &fuse.RenameRequest{
+			OldName: "taxes",
+			NewName: "tax-returns",
+		}, &TagDir{tag: "personal", fs: fs})
+		require.NoError(t, err)
+		assert.Len(t, scc.RenameTagFunc.History(), 1)
+	})
+}
@@ -3,6 +3,7 @@ package fs
 import (
 	"context"
 	"os"
+	"path"
 	"time"
 
 	"bazil.org/fuse"
@@ -47,6 +48,8 @@ func (ud *fsUploadDir) Create(
 	createRes, err := ud.fs.client.CreateFile(ctx, &scproto.CreateFileRequest{
 		Filename:     req.Name,
 		DocumentDate: docDate,
+		Source:       records.FileSourceFuse,
+		OriginalPath: path.Join("/upload", req.Name),
 	})
 	if status.Code(err) == codes.AlreadyExists {
 		return nil, nil, fuse.EEXIST
@@ -76,9 +76,34 @@ func (as *adminServer) AllFiles(
 				}
 			}
 
+			fields, err := as.api.GetFileFields(item.File.ID.String())
+			if err != nil {
+				as.logger.Error("Error getting fields for file: %s", err)
+				continue
+			}
+
+			resFields := []*scproto.Field{}
+			for _, field := range fields {
+				resFields = append(resFields, protoutil.FieldToProto(field))
+			}
+
+			links, err := as.api.GetFileLinks(item.File.ID.String())
+			if err != nil {
+				as.logger.Error("Error getting links for file: %s", err)
+				continue
+			}
+
+			resLinks, err := protoutil.FileLinksToProto(links)
+			if err != nil {
+				as.logger.Error("Error getting grpc version of links: %s", err)
+				continue
+			}
+
 			taggedFile := &scproto.TaggedFile{
-				File: resFile,
-				Tags: resTags,
+				File:   resFile,
+				Tags:   resTags,
+				Fields: resFields,
+				Links:  resLinks,
 			}
 
 			err = srv.Send(taggedFile)
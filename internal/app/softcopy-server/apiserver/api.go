@@ -1,12 +1,21 @@
 package apiserver
 
 import (
+	"context"
+
 	"github.com/efritz/nacelle"
 
 	"github.com/aphistic/softcopy/internal/pkg/api"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
 )
 
 type apiServer struct {
 	logger nacelle.Logger
 	api    *api.Client
 }
+
+// actorAPI returns an api client that records changes as made by the
+// actor of the request.
+func (as *apiServer) actorAPI(ctx context.Context) *api.Client {
+	return as.api.WithActor(protoutil.ActorFromContext(ctx))
+}
@@ -0,0 +1,65 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func auditEntryToGrpc(entry *records.AuditEntry) (*scproto.AuditEntry, error) {
+	created, err := types.TimestampProto(entry.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	fileID := ""
+	if entry.FileID != uuid.Nil {
+		fileID = entry.FileID.String()
+	}
+
+	return &scproto.AuditEntry{
+		Id:      entry.ID,
+		Created: created,
+		Actor:   entry.Actor,
+		Action:  entry.Action,
+		FileId:  fileID,
+		Before:  entry.Before,
+		After:   entry.After,
+	}, nil
+}
+
+func (as *apiServer) GetAuditLog(
+	ctx context.Context,
+	req *scproto.GetAuditLogRequest,
+) (*scproto.GetAuditLogResponse, error) {
+	if req.GetFileId() != "" {
+		if _, err := uuid.Parse(req.GetFileId()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid file id")
+		}
+	}
+
+	entries, err := as.api.GetAuditLog(req.GetFileId(), int(req.GetLimit()))
+	if err != nil {
+		as.logger.Error("Could not get audit log: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.GetAuditLogResponse{
+		Entries: []*scproto.AuditEntry{},
+	}
+	for _, entry := range entries {
+		resEntry, err := auditEntryToGrpc(entry)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Entries = append(res.Entries, resEntry)
+	}
+
+	return res, nil
+}
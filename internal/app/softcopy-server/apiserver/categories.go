@@ -0,0 +1,140 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func categoryToGrpc(category *records.TagCategory) *scproto.TagCategory {
+	return &scproto.TagCategory{
+		Id:    category.ID.String(),
+		Name:  category.Name,
+		Color: category.Color,
+	}
+}
+
+// categoryErrorToGrpc converts errors from tag category operations to
+// the matching grpc status.
+func categoryErrorToGrpc(err error) error {
+	switch err {
+	case scerrors.ErrNotFound:
+		return status.Error(codes.NotFound, "tag category not found")
+	case scerrors.ErrExists:
+		return status.Error(codes.AlreadyExists, "tag category already exists")
+	case api.ErrInvalidColor:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (as *apiServer) GetAllTagCategories(
+	ctx context.Context,
+	req *scproto.GetAllTagCategoriesRequest,
+) (*scproto.GetAllTagCategoriesResponse, error) {
+	categories, err := as.api.AllTagCategories()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.GetAllTagCategoriesResponse{
+		Categories: []*scproto.TagCategory{},
+	}
+	for _, category := range categories {
+		res.Categories = append(res.Categories, categoryToGrpc(category))
+	}
+
+	return res, nil
+}
+
+func (as *apiServer) CreateTagCategory(
+	ctx context.Context,
+	req *scproto.CreateTagCategoryRequest,
+) (*scproto.CreateTagCategoryResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	category, err := as.api.CreateTagCategory(req.GetName(), req.GetColor())
+	if err != nil {
+		return nil, categoryErrorToGrpc(err)
+	}
+
+	return &scproto.CreateTagCategoryResponse{
+		Category: categoryToGrpc(category),
+	}, nil
+}
+
+func (as *apiServer) UpdateTagCategory(
+	ctx context.Context,
+	req *scproto.UpdateTagCategoryRequest,
+) (*scproto.UpdateTagCategoryResponse, error) {
+	reqCategory := req.GetCategory()
+	if reqCategory == nil || reqCategory.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category with a name is required")
+	}
+
+	id, err := uuid.Parse(reqCategory.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = as.api.UpdateTagCategory(&records.TagCategory{
+		ID:    id,
+		Name:  reqCategory.GetName(),
+		Color: reqCategory.GetColor(),
+	})
+	if err != nil {
+		return nil, categoryErrorToGrpc(err)
+	}
+
+	return &scproto.UpdateTagCategoryResponse{}, nil
+}
+
+func (as *apiServer) RemoveTagCategory(
+	ctx context.Context,
+	req *scproto.RemoveTagCategoryRequest,
+) (*scproto.RemoveTagCategoryResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = as.api.RemoveTagCategory(id)
+	if err != nil {
+		return nil, categoryErrorToGrpc(err)
+	}
+
+	return &scproto.RemoveTagCategoryResponse{}, nil
+}
+
+func (as *apiServer) SetTagCategory(
+	ctx context.Context,
+	req *scproto.SetTagCategoryRequest,
+) (*scproto.SetTagCategoryResponse, error) {
+	categoryID := uuid.Nil
+	if req.GetCategoryId() != "" {
+		id, err := uuid.Parse(req.GetCategoryId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		categoryID = id
+	}
+
+	err := as.api.SetTagCategory(req.GetTagName(), categoryID)
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "tag or tag category not found")
+	} else if err != nil {
+		return nil, categoryErrorToGrpc(err)
+	}
+
+	return &scproto.SetTagCategoryResponse{}, nil
+}
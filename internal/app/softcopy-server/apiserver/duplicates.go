@@ -0,0 +1,48 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *adminServer) FindDuplicates(
+	ctx context.Context,
+	req *scproto.FindDuplicatesRequest,
+) (*scproto.FindDuplicatesResponse, error) {
+	groups, err := as.api.FindDuplicates(&api.FindDuplicatesOptions{
+		Near:        req.GetNear(),
+		MaxDistance: int(req.GetMaxDistance()),
+	})
+	if err != nil {
+		as.logger.Error("Could not find duplicates: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.FindDuplicatesResponse{
+		Groups: []*scproto.DuplicateGroup{},
+	}
+	for _, group := range groups {
+		resGroup := &scproto.DuplicateGroup{
+			Hash:     group.Hash,
+			Distance: int32(group.Distance),
+			Files:    []*scproto.File{},
+		}
+		for _, file := range group.Files {
+			resFile, err := protoutil.FileToProto(file)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			resGroup.Files = append(resGroup.Files, resFile)
+		}
+
+		res.Groups = append(res.Groups, resGroup)
+	}
+
+	return res, nil
+}
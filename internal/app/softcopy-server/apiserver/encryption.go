@@ -0,0 +1,39 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *adminServer) ReencryptFiles(
+	ctx context.Context,
+	req *scproto.ReencryptFilesRequest,
+) (*scproto.ReencryptFilesResponse, error) {
+	res, err := as.api.ReencryptFiles(req.GetDryRun())
+	if err == api.ErrNotEncrypted {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		as.logger.Error("Could not encrypt files again: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !res.DryRun {
+		as.logger.Info("Encrypted %d stored files with key %s", len(res.Blobs), res.KeyID)
+	}
+
+	resReencrypt := &scproto.ReencryptFilesResponse{
+		DryRun:  res.DryRun,
+		KeyId:   res.KeyID,
+		BlobIds: []string{},
+	}
+	for _, blobID := range res.Blobs {
+		resReencrypt.BlobIds = append(resReencrypt.BlobIds, blobID.String())
+	}
+
+	return resReencrypt, nil
+}
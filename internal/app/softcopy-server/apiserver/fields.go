@@ -0,0 +1,61 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *apiServer) GetFileFields(
+	ctx context.Context,
+	req *scproto.GetFileFieldsRequest,
+) (*scproto.GetFileFieldsResponse, error) {
+	fields, err := as.api.GetFileFields(req.GetFileId())
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.GetFileFieldsResponse{
+		Fields: []*scproto.Field{},
+	}
+	for _, field := range fields {
+		res.Fields = append(res.Fields, protoutil.FieldToProto(field))
+	}
+
+	return res, nil
+}
+
+func (as *apiServer) SetFileFields(
+	ctx context.Context,
+	req *scproto.SetFileFieldsRequest,
+) (*scproto.SetFileFieldsResponse, error) {
+	fields := []*records.Field{}
+	for _, reqField := range req.GetFields() {
+		field, err := protoutil.ProtoToField(reqField)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		fields = append(fields, field)
+	}
+
+	err := as.actorAPI(ctx).SetFileFields(req.GetFileId(), fields, req.GetRemovedFields())
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err == api.ErrInvalidField {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.SetFileFieldsResponse{}, nil
+}
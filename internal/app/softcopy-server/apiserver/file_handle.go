@@ -4,7 +4,9 @@ import (
 	"context"
 	"io"
 
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
 	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,11 +26,21 @@ func (as *apiServer) OpenFile(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	of, err := as.api.OpenFile(
-		handleID,
-		protoutil.ProtoToFileMode(req.GetMode()),
-	)
-	if err != nil {
+	mode := protoutil.ProtoToFileMode(req.GetMode())
+
+	var of records.OpenFile
+	if req.GetVersion() != 0 {
+		if mode != records.FILE_MODE_READ {
+			return nil, status.Error(codes.InvalidArgument, "versions can only be opened for reading")
+		}
+
+		of, err = as.api.OpenFileVersion(handleID, int(req.GetVersion()))
+	} else {
+		of, err = as.actorAPI(ctx).OpenFile(handleID, mode)
+	}
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -10,8 +10,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/aphistic/softcopy/internal/pkg/api"
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
 	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 	scproto "github.com/aphistic/softcopy/pkg/proto"
 )
 
@@ -154,6 +157,32 @@ func (as *apiServer) FindFilesWithTags(
 	return res, nil
 }
 
+func (as *apiServer) QueryFiles(
+	ctx context.Context,
+	req *scproto.QueryFilesRequest,
+) (*scproto.QueryFilesResponse, error) {
+	files, err := as.api.QueryFiles(req.GetQuery())
+	if _, ok := err.(*query.SyntaxError); ok {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.QueryFilesResponse{
+		Files: []*scproto.File{},
+	}
+
+	for _, file := range files {
+		f, err := protoutil.FileToProto(file)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Files = append(res.Files, f)
+	}
+
+	return res, nil
+}
+
 func (as *apiServer) FindFilesWithIdPrefix(
 	ctx context.Context,
 	req *scproto.FindFilesWithIdPrefixRequest,
@@ -196,7 +225,15 @@ func (as *apiServer) CreateFile(
 		req.GetFilename(),
 	)
 
-	id, err := as.api.CreateFile(req.GetFilename(), date)
+	source := req.GetSource()
+	if source == "" {
+		source = records.FileSourceGRPC
+	}
+
+	id, err := as.actorAPI(ctx).CreateFileWithSource(
+		req.GetFilename(), date,
+		source, req.GetOriginalPath(),
+	)
 	if err == scerrors.ErrExists {
 		return nil, status.Error(codes.AlreadyExists, "filename already exists on this date")
 	} else if err != nil {
@@ -224,9 +261,21 @@ func (as *apiServer) GetFile(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	links, err := as.api.GetFileLinks(req.GetId())
+	if err != nil {
+		as.logger.Error("Could not get links of file %s: %s", req.GetId(), err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resLinks, err := protoutil.FileLinksToProto(links)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &scproto.GetFileResponse{
 		File: &scproto.TaggedFile{
-			File: resFile,
+			File:  resFile,
+			Links: resLinks,
 		},
 	}, nil
 }
@@ -235,7 +284,7 @@ func (as *apiServer) RemoveFile(
 	ctx context.Context,
 	req *scproto.RemoveFileRequest,
 ) (*scproto.RemoveFileResponse, error) {
-	err := as.api.RemoveFile(req.GetId())
+	err := as.actorAPI(ctx).RemoveFile(req.GetId())
 	if err == scerrors.ErrNotFound {
 		return nil, status.Error(codes.NotFound, "file not found")
 	} else if err != nil {
@@ -246,6 +295,60 @@ func (as *apiServer) RemoveFile(
 	return &scproto.RemoveFileResponse{}, nil
 }
 
+func (as *apiServer) UpdateFile(
+	ctx context.Context,
+	req *scproto.UpdateFileRequest,
+) (*scproto.UpdateFileResponse, error) {
+	id, err := uuid.Parse(req.GetFileId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid file id")
+	}
+
+	docDate, err := types.TimestampFromProto(req.GetDocumentDate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fields := []*records.Field{}
+	for _, reqField := range req.GetFields() {
+		field, err := protoutil.ProtoToField(reqField)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		fields = append(fields, field)
+	}
+
+	file, err := as.actorAPI(ctx).UpdateFile(&records.File{
+		ID:           id,
+		Filename:     req.GetFilename(),
+		DocumentDate: docDate,
+		Title:        req.GetTitle(),
+		Notes:        req.GetNotes(),
+		Revision:     int(req.GetRevision()),
+	}, fields, req.GetRemovedFields())
+	if err == scerrors.ErrStaleRevision {
+		return nil, status.Error(codes.FailedPrecondition, "file has been changed since it was read")
+	} else if err == scerrors.ErrExists {
+		return nil, status.Error(codes.AlreadyExists, "destination exists")
+	} else if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err == api.ErrInvalidField || err == api.ErrInvalidFilename {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resFile, err := protoutil.FileToProto(file)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.UpdateFileResponse{
+		File: resFile,
+	}, nil
+}
+
 func (as *apiServer) UpdateFileDate(
 	ctx context.Context,
 	req *scproto.UpdateFileDateRequest,
@@ -260,7 +363,7 @@ func (as *apiServer) UpdateFileDate(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	err = as.api.UpdateFileDate(id, req.GetNewFilename(), newDate)
+	err = as.actorAPI(ctx).UpdateFileDate(id, req.GetNewFilename(), newDate)
 	if err == scerrors.ErrExists {
 		return nil, status.Error(codes.AlreadyExists, "destination exists")
 	} else if err == scerrors.ErrNotFound {
@@ -0,0 +1,79 @@
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func gcResultToGrpc(res *api.GCResult) (*scproto.CollectGarbageResponse, error) {
+	resGC := &scproto.CollectGarbageResponse{
+		DryRun:    res.DryRun,
+		Metadata:  []*scproto.FileMetadata{},
+		BlobIds:   []string{},
+		TempFiles: []*scproto.TempFile{},
+	}
+
+	for _, md := range res.Metadata {
+		resGC.Metadata = append(resGC.Metadata, &scproto.FileMetadata{
+			Id:          md.ID.String(),
+			Hash:        md.Hash,
+			ContentSize: md.FileSize,
+		})
+	}
+
+	for _, blobID := range res.Blobs {
+		resGC.BlobIds = append(resGC.BlobIds, blobID.String())
+	}
+
+	for _, tempFile := range res.TempFiles {
+		modified, err := types.TimestampProto(tempFile.Modified)
+		if err != nil {
+			return nil, err
+		}
+
+		resGC.TempFiles = append(resGC.TempFiles, &scproto.TempFile{
+			Id:          tempFile.ID.String(),
+			ContentSize: tempFile.Size,
+			Modified:    modified,
+		})
+	}
+
+	return resGC, nil
+}
+
+func (as *adminServer) CollectGarbage(
+	ctx context.Context,
+	req *scproto.CollectGarbageRequest,
+) (*scproto.CollectGarbageResponse, error) {
+	tempAge := api.DefaultTempFileAge
+	if req.GetTempAgeSeconds() > 0 {
+		tempAge = time.Duration(req.GetTempAgeSeconds()) * time.Second
+	}
+
+	res, err := as.api.CollectGarbage(req.GetDryRun(), tempAge)
+	if err != nil {
+		as.logger.Error("Could not collect garbage: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !res.DryRun {
+		as.logger.Info(
+			"Collected %d metadata, %d stored files and %d temp files",
+			len(res.Metadata), len(res.Blobs), len(res.TempFiles),
+		)
+	}
+
+	resGC, err := gcResultToGrpc(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resGC, nil
+}
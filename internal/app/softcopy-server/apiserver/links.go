@@ -0,0 +1,74 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *apiServer) CreateFileLink(
+	ctx context.Context,
+	req *scproto.CreateFileLinkRequest,
+) (*scproto.CreateFileLinkResponse, error) {
+	err := as.actorAPI(ctx).LinkFiles(
+		req.GetSourceId(), req.GetTargetId(),
+		protoutil.ProtoToLinkType(req.GetType()),
+	)
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err == scerrors.ErrExists {
+		return nil, status.Error(codes.AlreadyExists, "files are already linked")
+	} else if err == api.ErrInvalidLink {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.CreateFileLinkResponse{}, nil
+}
+
+func (as *apiServer) RemoveFileLink(
+	ctx context.Context,
+	req *scproto.RemoveFileLinkRequest,
+) (*scproto.RemoveFileLinkResponse, error) {
+	err := as.actorAPI(ctx).UnlinkFiles(
+		req.GetSourceId(), req.GetTargetId(),
+		protoutil.ProtoToLinkType(req.GetType()),
+	)
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "link not found")
+	} else if err == api.ErrInvalidLink {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.RemoveFileLinkResponse{}, nil
+}
+
+func (as *apiServer) GetFileLinks(
+	ctx context.Context,
+	req *scproto.GetFileLinksRequest,
+) (*scproto.GetFileLinksResponse, error) {
+	links, err := as.api.GetFileLinks(req.GetFileId())
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resLinks, err := protoutil.FileLinksToProto(links)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.GetFileLinksResponse{
+		Links: resLinks,
+	}, nil
+}
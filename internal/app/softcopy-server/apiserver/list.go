@@ -0,0 +1,48 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *apiServer) ListFiles(
+	ctx context.Context,
+	req *scproto.ListFilesRequest,
+) (*scproto.ListFilesResponse, error) {
+	list, err := as.api.ListFiles(&api.ListFilesOptions{
+		Query:      req.GetQuery(),
+		Sort:       protoutil.ProtoToFileSort(req.GetSort()),
+		Descending: req.GetDescending(),
+		Cursor:     req.GetCursor(),
+		Limit:      int(req.GetLimit()),
+	})
+	if _, ok := err.(*query.SyntaxError); ok {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err == api.ErrInvalidSort || err == api.ErrInvalidCursor {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.ListFilesResponse{
+		Files:      []*scproto.File{},
+		Total:      int32(list.Total),
+		NextCursor: list.NextCursor,
+	}
+	for _, file := range list.Files {
+		f, err := protoutil.FileToProto(file)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Files = append(res.Files, f)
+	}
+
+	return res, nil
+}
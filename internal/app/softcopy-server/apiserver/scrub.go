@@ -0,0 +1,99 @@
+package apiserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func scrubProblemsToGrpc(problems []*records.FileMetadata) ([]*scproto.ScrubResult, error) {
+	res := []*scproto.ScrubResult{}
+	for _, md := range problems {
+		var scrubbed *types.Timestamp
+		if !md.Scrubbed.IsZero() {
+			var err error
+			scrubbed, err = types.TimestampProto(md.Scrubbed)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res = append(res, &scproto.ScrubResult{
+			Metadata: &scproto.FileMetadata{
+				Id:          md.ID.String(),
+				Hash:        md.Hash,
+				ContentSize: md.FileSize,
+			},
+			Status:   protoutil.ScrubStatusToProto(md.ScrubStatus),
+			Scrubbed: scrubbed,
+		})
+	}
+
+	return res, nil
+}
+
+func (as *adminServer) ScrubFiles(
+	ctx context.Context,
+	req *scproto.ScrubFilesRequest,
+) (*scproto.ScrubFilesResponse, error) {
+	res, err := as.api.ScrubFiles(req.GetRepair())
+	if err == api.ErrNoBackup {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		as.logger.Error("Could not scrub files: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	as.logger.Info("Scrubbed %d stored files, %d had problems", res.Checked, len(res.Problems))
+
+	problems, err := scrubProblemsToGrpc(res.Problems)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.ScrubFilesResponse{
+		Repair:   res.Repair,
+		Checked:  int64(res.Checked),
+		Problems: problems,
+	}, nil
+}
+
+func (as *adminServer) GetScrubResults(
+	ctx context.Context,
+	req *scproto.GetScrubResultsRequest,
+) (*scproto.GetScrubResultsResponse, error) {
+	res, err := as.api.GetScrubResults()
+	if err != nil {
+		as.logger.Error("Could not get scrub results: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	problems, err := scrubProblemsToGrpc(res.Problems)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resScrub := &scproto.GetScrubResultsResponse{
+		Counts:   []*scproto.ScrubStatusCount{},
+		Problems: problems,
+	}
+	for scrubStatus, count := range res.Statuses {
+		resScrub.Counts = append(resScrub.Counts, &scproto.ScrubStatusCount{
+			Status: protoutil.ScrubStatusToProto(scrubStatus),
+			Count:  int64(count),
+		})
+	}
+	sort.Slice(resScrub.Counts, func(i, j int) bool {
+		return resScrub.Counts[i].Status < resScrub.Counts[j].Status
+	})
+
+	return resScrub, nil
+}
@@ -0,0 +1,36 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *apiServer) SearchFiles(
+	ctx context.Context,
+	req *scproto.SearchFilesRequest,
+) (*scproto.SearchFilesResponse, error) {
+	as.logger.Debug("searching files for '%s'", req.GetQuery())
+	hits, err := as.api.SearchFiles(req.GetQuery())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.SearchFilesResponse{
+		Hits: []*scproto.SearchHit{},
+	}
+
+	for _, hit := range hits {
+		h, err := protoutil.SearchHitToProto(hit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Hits = append(res.Hits, h)
+	}
+
+	return res, nil
+}
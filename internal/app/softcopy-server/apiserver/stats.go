@@ -0,0 +1,25 @@
+package apiserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func (as *apiServer) GetStats(
+	ctx context.Context,
+	req *scproto.GetStatsRequest,
+) (*scproto.GetStatsResponse, error) {
+	stats, err := as.api.GetStats()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.GetStatsResponse{
+		Stats: protoutil.StatsToProto(stats),
+	}, nil
+}
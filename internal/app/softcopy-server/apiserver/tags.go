@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/aphistic/softcopy/internal/pkg/api"
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
 	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 	scproto "github.com/aphistic/softcopy/pkg/proto"
@@ -15,11 +16,22 @@ import (
 )
 
 func tagToGrpc(tag *records.Tag) (*scproto.Tag, error) {
-	return &scproto.Tag{
+	res := &scproto.Tag{
 		Id:     tag.ID.String(),
 		Name:   tag.Name,
 		System: tag.System,
-	}, nil
+	}
+
+	if tag.ParentID != uuid.Nil {
+		res.ParentId = tag.ParentID.String()
+	}
+
+	if tag.Category != nil {
+		res.Category = tag.Category.Name
+		res.TagCategory = categoryToGrpc(tag.Category)
+	}
+
+	return res, nil
 }
 
 func (as *apiServer) GetAllTags(ctx context.Context, req *scproto.GetAllTagsRequest) (*scproto.GetAllTagsResponse, error) {
@@ -42,11 +54,11 @@ tagLoop:
 				return nil, grpc.Errorf(codes.Internal, tagItem.Error.Error())
 			}
 
-			tags = append(tags, &scproto.Tag{
-				Id:     tagItem.Tag.ID.String(),
-				Name:   tagItem.Tag.Name,
-				System: tagItem.Tag.System,
-			})
+			tag, err := tagToGrpc(tagItem.Tag)
+			if err != nil {
+				return nil, grpc.Errorf(codes.Internal, err.Error())
+			}
+			tags = append(tags, tag)
 		case <-ctx.Done():
 			return nil, grpc.Errorf(codes.Canceled, "context finished")
 		}
@@ -68,12 +80,13 @@ func (as *apiServer) FindTagByName(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	res, err := tagToGrpc(tag)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &scproto.FindTagByNameResponse{
-		Tag: &scproto.Tag{
-			Id:     tag.ID.String(),
-			Name:   tag.Name,
-			System: tag.System,
-		},
+		Tag: res,
 	}, nil
 }
 
@@ -88,18 +101,23 @@ func (as *apiServer) GetTagsForFile(
 	defer tags.Close()
 
 	var res []*scproto.Tag
+tagLoop:
 	for {
 		select {
-		case tagItem := <-tags.Tags():
+		case tagItem, ok := <-tags.Tags():
+			if !ok {
+				break tagLoop
+			}
+
 			if tagItem.Error != nil {
-				return nil, status.Error(codes.Internal, err.Error())
+				return nil, status.Error(codes.Internal, tagItem.Error.Error())
 			}
 
-			res = append(res, &scproto.Tag{
-				Id:     tagItem.Tag.ID.String(),
-				Name:   tagItem.Tag.Name,
-				System: tagItem.Tag.System,
-			})
+			tag, err := tagToGrpc(tagItem.Tag)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			res = append(res, tag)
 		case <-ctx.Done():
 			return nil, status.Error(codes.Internal, "request cancelled")
 		}
@@ -121,12 +139,14 @@ func (as *apiServer) UpdateFileTags(
 
 	// First make sure all the added tags are created before we try to
 	// assign them.
-	_, err = as.api.CreateTags(req.GetAddedTags())
-	if err != nil {
+	_, err = as.actorAPI(ctx).CreateTags(req.GetAddedTags())
+	if err == api.ErrInvalidTag {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	err = as.api.UpdateFileTags(
+	err = as.actorAPI(ctx).UpdateFileTags(
 		id,
 		req.GetAddedTags(),
 		req.GetRemovedTags(),
@@ -142,21 +162,82 @@ func (as *apiServer) CreateTags(
 	ctx context.Context,
 	req *scproto.CreateTagsRequest,
 ) (*scproto.CreateTagsResponse, error) {
-	tags, err := as.api.CreateTags(req.GetNames())
-	if err != nil {
+	tags, err := as.actorAPI(ctx).CreateTags(req.GetNames())
+	if err == api.ErrInvalidTag {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	var res []*scproto.Tag
 	for _, tag := range tags {
-		res = append(res, &scproto.Tag{
-			Id:     tag.ID.String(),
-			Name:   tag.Name,
-			System: tag.System,
-		})
+		resTag, err := tagToGrpc(tag)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res = append(res, resTag)
 	}
 
 	return &scproto.CreateTagsResponse{
 		Tags: res,
 	}, nil
 }
+
+// tagErrorToGrpc converts errors from modifying tags to the matching
+// grpc status.
+func tagErrorToGrpc(err error) error {
+	switch err {
+	case scerrors.ErrNotFound:
+		return status.Error(codes.NotFound, "tag not found")
+	case scerrors.ErrExists:
+		return status.Error(codes.AlreadyExists, "tag already exists")
+	case scerrors.ErrNotPermitted:
+		return status.Error(codes.PermissionDenied, "system tags cannot be modified")
+	case scerrors.ErrInUse:
+		return status.Error(codes.FailedPrecondition, "tag is in use")
+	case api.ErrInvalidTag:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (as *apiServer) RenameTag(
+	ctx context.Context,
+	req *scproto.RenameTagRequest,
+) (*scproto.RenameTagResponse, error) {
+	if req.GetNewName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "new name is required")
+	}
+
+	err := as.actorAPI(ctx).RenameTag(req.GetOldName(), req.GetNewName())
+	if err != nil {
+		return nil, tagErrorToGrpc(err)
+	}
+
+	return &scproto.RenameTagResponse{}, nil
+}
+
+func (as *apiServer) MergeTags(
+	ctx context.Context,
+	req *scproto.MergeTagsRequest,
+) (*scproto.MergeTagsResponse, error) {
+	err := as.actorAPI(ctx).MergeTags(req.GetSourceName(), req.GetTargetName())
+	if err != nil {
+		return nil, tagErrorToGrpc(err)
+	}
+
+	return &scproto.MergeTagsResponse{}, nil
+}
+
+func (as *apiServer) DeleteTag(
+	ctx context.Context,
+	req *scproto.DeleteTagRequest,
+) (*scproto.DeleteTagResponse, error) {
+	err := as.actorAPI(ctx).DeleteTag(req.GetName())
+	if err != nil {
+		return nil, tagErrorToGrpc(err)
+	}
+
+	return &scproto.DeleteTagResponse{}, nil
+}
@@ -0,0 +1,87 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func trashedFileToGrpc(trashed *records.TrashedFile) (*scproto.TrashedFile, error) {
+	file, err := protoutil.FileToProto(trashed.File)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := types.TimestampProto(trashed.Deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scproto.TrashedFile{
+		File:    file,
+		Deleted: deleted,
+	}, nil
+}
+
+func (as *apiServer) ListTrash(
+	ctx context.Context,
+	req *scproto.ListTrashRequest,
+) (*scproto.ListTrashResponse, error) {
+	trash, err := as.api.ListTrash()
+	if err != nil {
+		as.logger.Error("Could not list trash: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.ListTrashResponse{
+		Files: []*scproto.TrashedFile{},
+	}
+	for _, trashed := range trash {
+		resFile, err := trashedFileToGrpc(trashed)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Files = append(res.Files, resFile)
+	}
+
+	return res, nil
+}
+
+func (as *apiServer) RestoreFile(
+	ctx context.Context,
+	req *scproto.RestoreFileRequest,
+) (*scproto.RestoreFileResponse, error) {
+	err := as.actorAPI(ctx).RestoreFile(req.GetId())
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found in trash")
+	} else if err == scerrors.ErrExists {
+		return nil, status.Error(codes.AlreadyExists, "a file with the same name and date exists")
+	} else if err != nil {
+		as.logger.Error("Could not restore file: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.RestoreFileResponse{}, nil
+}
+
+func (as *apiServer) PurgeFile(
+	ctx context.Context,
+	req *scproto.PurgeFileRequest,
+) (*scproto.PurgeFileResponse, error) {
+	err := as.actorAPI(ctx).PurgeFile(req.GetId())
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found in trash")
+	} else if err != nil {
+		as.logger.Error("Could not purge file: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.PurgeFileResponse{}, nil
+}
@@ -0,0 +1,71 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func versionToGrpc(version *records.FileVersion) (*scproto.FileVersion, error) {
+	created, err := types.TimestampProto(version.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scproto.FileVersion{
+		Version:     int32(version.Version),
+		Hash:        version.Hash,
+		ContentSize: version.Size,
+		Created:     created,
+		Source:      version.Source,
+	}, nil
+}
+
+func (as *apiServer) GetFileVersions(
+	ctx context.Context,
+	req *scproto.GetFileVersionsRequest,
+) (*scproto.GetFileVersionsResponse, error) {
+	versions, err := as.api.GetFileVersions(req.GetFileId())
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &scproto.GetFileVersionsResponse{
+		Versions: []*scproto.FileVersion{},
+	}
+	for _, version := range versions {
+		resVersion, err := versionToGrpc(version)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Versions = append(res.Versions, resVersion)
+	}
+
+	return res, nil
+}
+
+func (as *apiServer) RestoreFileVersion(
+	ctx context.Context,
+	req *scproto.RestoreFileVersionRequest,
+) (*scproto.RestoreFileVersionResponse, error) {
+	version, err := as.actorAPI(ctx).RestoreFileVersion(req.GetFileId(), int(req.GetVersion()))
+	if err == scerrors.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "file version not found")
+	} else if err == scerrors.ErrAlreadyOpen {
+		return nil, status.Error(codes.FailedPrecondition, "file is open")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scproto.RestoreFileVersionResponse{
+		Version: int32(version),
+	}, nil
+}
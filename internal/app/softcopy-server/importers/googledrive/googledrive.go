@@ -21,6 +21,7 @@ import (
 
 	"github.com/efritz/nacelle"
 
+	"github.com/aphistic/softcopy/internal/app/softcopy-server/importers"
 	"github.com/aphistic/softcopy/internal/pkg/api"
 	"github.com/aphistic/softcopy/internal/pkg/config"
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
@@ -79,6 +80,7 @@ func (gdi *GoogleDriveImporter) Start(ctx context.Context) error {
 	gdi.Logger = gdi.Logger.WithFields(nacelle.LogFields{
 		"importer": gdi.Name(),
 	})
+	gdi.API = gdi.API.WithActor(importers.Actor(gdi.Name()))
 
 	gdi.Logger.Debug("starting google drive importer")
 
@@ -177,7 +179,10 @@ func (gdi *GoogleDriveImporter) Start(ctx context.Context) error {
 					continue
 				}
 
-				id, err := gdi.API.CreateFile(file.Name, docDate)
+				id, err := gdi.API.CreateFileWithSource(
+					file.Name, docDate,
+					gdi.Name(), path.Join(gdi.importPath, file.Name),
+				)
 				if err != nil {
 					gdi.Logger.Error("could not create file: %s", err)
 					fileRes.Body.Close()
@@ -9,6 +9,12 @@ import (
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
 )
 
+// Actor returns the actor changes made by an importer are recorded as in
+// the audit log.
+func Actor(importerName string) string {
+	return "importer:" + importerName
+}
+
 type Importer interface {
 	Name() string
 
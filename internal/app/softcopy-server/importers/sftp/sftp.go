@@ -14,6 +14,7 @@ import (
 	sftpclient "github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 
+	"github.com/aphistic/softcopy/internal/app/softcopy-server/importers"
 	"github.com/aphistic/softcopy/internal/pkg/api"
 	"github.com/aphistic/softcopy/internal/pkg/config"
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
@@ -81,6 +82,7 @@ func (si *SFTPImporter) Start(ctx context.Context) error {
 	si.Logger = si.Logger.WithFields(nacelle.LogFields{
 		"importer": si.Name(),
 	})
+	si.API = si.API.WithActor(importers.Actor(si.Name()))
 
 	si.Logger.Debug("starting sftp ssh connection to %s:%d", si.host, si.port)
 	conn, err := ssh.Dial(
@@ -179,7 +181,10 @@ func (si *SFTPImporter) Start(ctx context.Context) error {
 				}
 
 				// File doesn't exist yet, create it
-				id, err := si.API.CreateFile(file.Name(), docDate)
+				id, err := si.API.CreateFileWithSource(
+					file.Name(), docDate,
+					si.Name(), filePath,
+				)
 				if err != nil {
 					si.Logger.Error("could not create file: %s", err)
 					sftpFile.Close()
@@ -0,0 +1,42 @@
+package scrubserver
+
+import (
+	"time"
+)
+
+const (
+	defaultIntervalDays = 7
+)
+
+type scrubConfig struct {
+	Scrub *intervalConfig `file:"scrub"`
+}
+
+type intervalConfig struct {
+	// IntervalDays is how often every stored file is checked against its
+	// hash. Zero only checks them when asked to with softcopy-admin scrub.
+	IntervalDays *int `yaml:"interval_days"`
+	// Repair restores damaged files from the backup in the
+	// scrub_backup section when they're found.
+	Repair bool `yaml:"repair"`
+}
+
+// Interval returns how often stored files are checked, or zero if
+// they're never checked automatically.
+func (sc *scrubConfig) Interval() time.Duration {
+	days := defaultIntervalDays
+	if sc.Scrub != nil && sc.Scrub.IntervalDays != nil {
+		days = *sc.Scrub.IntervalDays
+	}
+
+	if days <= 0 {
+		return 0
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// Repair returns whether damaged files are repaired when they're found.
+func (sc *scrubConfig) Repair() bool {
+	return sc.Scrub != nil && sc.Scrub.Repair
+}
@@ -0,0 +1,78 @@
+package scrubserver
+
+import (
+	"time"
+
+	"github.com/efritz/nacelle"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+)
+
+type scrubProcess struct {
+	Logger nacelle.Logger `service:"logger"`
+	API    *api.Client    `service:"api"`
+
+	interval time.Duration
+	repair   bool
+
+	stopChan chan struct{}
+}
+
+func NewProcess() nacelle.Process {
+	return &scrubProcess{
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (sp *scrubProcess) Init(config nacelle.Config) error {
+	scrubCfg := &scrubConfig{}
+	err := config.Load(scrubCfg)
+	if err != nil {
+		return err
+	}
+	sp.interval = scrubCfg.Interval()
+	sp.repair = scrubCfg.Repair()
+
+	return nil
+}
+
+func (sp *scrubProcess) Start() error {
+	if sp.interval == 0 {
+		sp.Logger.Info("Scrubbing is disabled, files will not be checked")
+		<-sp.stopChan
+		return nil
+	}
+
+	// Reading every file is slow, so the first check waits for an
+	// interval instead of running every time the server starts.
+	ticker := time.NewTicker(sp.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sp.scrub()
+		case <-sp.stopChan:
+			return nil
+		}
+	}
+}
+
+func (sp *scrubProcess) scrub() {
+	res, err := sp.API.ScrubFiles(sp.repair)
+	if err != nil {
+		sp.Logger.Error("Could not scrub files: %s", err)
+		return
+	}
+
+	if len(res.Problems) > 0 {
+		sp.Logger.Error("Scrubbed %d stored files, %d had problems", res.Checked, len(res.Problems))
+	} else {
+		sp.Logger.Info("Scrubbed %d stored files", res.Checked)
+	}
+}
+
+func (sp *scrubProcess) Stop() error {
+	close(sp.stopChan)
+	return nil
+}
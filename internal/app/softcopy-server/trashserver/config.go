@@ -0,0 +1,35 @@
+package trashserver
+
+import (
+	"time"
+)
+
+const (
+	defaultRetentionDays = 30
+	purgeInterval        = time.Hour
+)
+
+type trashConfig struct {
+	Trash *retentionConfig `file:"trash"`
+}
+
+type retentionConfig struct {
+	// RetentionDays is how long removed files stay in the trash before
+	// they're purged. Zero keeps them until they're purged by hand.
+	RetentionDays *int `yaml:"retention_days"`
+}
+
+// Retention returns how long files are kept in the trash, or zero if
+// they're never purged automatically.
+func (tc *trashConfig) Retention() time.Duration {
+	days := defaultRetentionDays
+	if tc.Trash != nil && tc.Trash.RetentionDays != nil {
+		days = *tc.Trash.RetentionDays
+	}
+
+	if days <= 0 {
+		return 0
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
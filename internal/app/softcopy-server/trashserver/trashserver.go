@@ -0,0 +1,71 @@
+package trashserver
+
+import (
+	"time"
+
+	"github.com/efritz/nacelle"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+)
+
+type trashProcess struct {
+	Logger nacelle.Logger `service:"logger"`
+	API    *api.Client    `service:"api"`
+
+	retention time.Duration
+
+	stopChan chan struct{}
+}
+
+func NewProcess() nacelle.Process {
+	return &trashProcess{
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (tp *trashProcess) Init(config nacelle.Config) error {
+	trashCfg := &trashConfig{}
+	err := config.Load(trashCfg)
+	if err != nil {
+		return err
+	}
+	tp.retention = trashCfg.Retention()
+
+	return nil
+}
+
+func (tp *trashProcess) Start() error {
+	if tp.retention == 0 {
+		tp.Logger.Info("Trash retention is disabled, files will not be purged")
+		<-tp.stopChan
+		return nil
+	}
+
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		tp.purge()
+
+		select {
+		case <-ticker.C:
+		case <-tp.stopChan:
+			return nil
+		}
+	}
+}
+
+func (tp *trashProcess) purge() {
+	purged, err := tp.API.PurgeTrash(time.Now().Add(-tp.retention))
+	if err != nil {
+		tp.Logger.Error("Could not purge trash: %s", err)
+	}
+	if purged > 0 {
+		tp.Logger.Info("Purged %d files from the trash", purged)
+	}
+}
+
+func (tp *trashProcess) Stop() error {
+	close(tp.stopChan)
+	return nil
+}
@@ -0,0 +1,85 @@
+package uiserver
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aphistic/softcopy/internal/pkg/api"
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+)
+
+// statsCollector publishes the API's stats as Prometheus metrics. The
+// stats are read each time the metrics are scraped.
+type statsCollector struct {
+	api *api.Client
+
+	files          *prometheus.Desc
+	trashedFiles   *prometheus.Desc
+	inboxFiles     *prometheus.Desc
+	tags           *prometheus.Desc
+	logicalBytes   *prometheus.Desc
+	storedContents *prometheus.Desc
+	storedBytes    *prometheus.Desc
+	dedupSavings   *prometheus.Desc
+	yearFiles      *prometheus.Desc
+}
+
+func newStatsCollector(client *api.Client) *statsCollector {
+	desc := func(name string, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(consts.ProcessName, "", name),
+			help, labels, nil,
+		)
+	}
+
+	return &statsCollector{
+		api: client,
+
+		files:          desc("documents", "Number of documents, not including the trash"),
+		trashedFiles:   desc("documents_trashed", "Number of documents in the trash"),
+		inboxFiles:     desc("documents_inbox", "Number of documents that are still unfiled"),
+		tags:           desc("tags", "Number of tags"),
+		logicalBytes:   desc("logical_bytes", "Size of every document's contents, counting shared contents for each document"),
+		storedContents: desc("stored_contents", "Number of stored contents, including earlier versions and the trash"),
+		storedBytes:    desc("stored_bytes", "Size of the stored contents, including earlier versions and the trash"),
+		dedupSavings:   desc("dedup_savings_bytes", "Bytes saved by storing identical contents only once"),
+		yearFiles:      desc("documents_by_year", "Number of documents by document year", "year"),
+	}
+}
+
+func (sc *statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sc.files
+	ch <- sc.trashedFiles
+	ch <- sc.inboxFiles
+	ch <- sc.tags
+	ch <- sc.logicalBytes
+	ch <- sc.storedContents
+	ch <- sc.storedBytes
+	ch <- sc.dedupSavings
+	ch <- sc.yearFiles
+}
+
+func (sc *statsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := sc.api.GetStats()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(sc.files, err)
+		return
+	}
+
+	gauge := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+	}
+
+	gauge(sc.files, float64(stats.Files))
+	gauge(sc.trashedFiles, float64(stats.TrashedFiles))
+	gauge(sc.inboxFiles, float64(stats.InboxFiles))
+	gauge(sc.tags, float64(stats.Tags))
+	gauge(sc.logicalBytes, float64(stats.LogicalBytes))
+	gauge(sc.storedContents, float64(stats.StoredContents))
+	gauge(sc.storedBytes, float64(stats.StoredBytes))
+	gauge(sc.dedupSavings, float64(stats.DedupSavings()))
+	for _, year := range stats.Years {
+		gauge(sc.yearFiles, float64(year.Files), strconv.Itoa(year.Year))
+	}
+}
@@ -7,6 +7,8 @@ import (
 	basehttp "github.com/efritz/nacelle/base/http"
 	"github.com/efritz/nacelle/logging"
 	"github.com/go-chi/chi"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/aphistic/softcopy/internal/app/softcopy-server/importers"
 	"github.com/aphistic/softcopy/internal/app/softcopy-server/uiserver/backend"
@@ -55,6 +57,14 @@ func (us *uiServer) Init(config nacelle.Config, server *http.Server) error {
 	sc := newStaticController(frontendLoader, us.Logger)
 	r.Mount("/static", sc.Router())
 
+	// Prometheus metrics
+	registry := prometheus.NewRegistry()
+	err = registry.Register(newStatsCollector(us.API))
+	if err != nil {
+		return err
+	}
+	r.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
 	r.HandleFunc("/importers", us.GetImporters)
 	for _, importer := range us.Importers.Runners() {
 		webImporter, ok := importer.(importers.ImporterWebHandler)
@@ -20,9 +20,14 @@ func NewCommander(client scproto.SoftcopyClient) *Commander {
 		client: client,
 		writer: w,
 		parser: newParser(map[string]ParserCmd{
-			"inbox": newCmdInbox(w, client),
-			"show":  newCmdShow(w, client),
-			"exit":  newCmdExit(),
+			"inbox":   newCmdInbox(w, client),
+			"find":    newCmdFind(w, client),
+			"list":    newCmdList(w, client),
+			"show":    newCmdShow(w, client),
+			"search":  newCmdSearch(w, client),
+			"history": newCmdHistory(w, client),
+			"stats":   newCmdStats(w, client),
+			"exit":    newCmdExit(),
 		}),
 	}
 }
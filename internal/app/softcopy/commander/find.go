@@ -0,0 +1,87 @@
+package commander
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/dustin/go-humanize"
+	"github.com/gogo/protobuf/types"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type cmdFind struct {
+	w      Writer
+	client scproto.SoftcopyClient
+}
+
+func newCmdFind(w Writer, client scproto.SoftcopyClient) *cmdFind {
+	return &cmdFind{
+		w:      w,
+		client: client,
+	}
+}
+
+func (c *cmdFind) SubCommands() map[string]ParserCmd {
+	return map[string]ParserCmd{}
+}
+
+func (c *cmdFind) Description() string {
+	return "Find documents matching a query, such as: taxes AND date:2020 NOT draft"
+}
+
+func (c *cmdFind) Suggestions(d prompt.Document) []prompt.Suggest {
+	return []prompt.Suggest{}
+}
+
+func (c *cmdFind) Execute(s string) error {
+	q := strings.TrimSpace(s)
+	if q == "" {
+		return ErrMissingArgument
+	}
+
+	res, err := c.client.QueryFiles(context.Background(), &scproto.QueryFilesRequest{
+		Query: q,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(res.Files) < 1 {
+		c.w.Printf("No documents found.\n")
+		return nil
+	}
+
+	writeFileTable(c.w, res.Files)
+
+	return nil
+}
+
+func writeFileTable(w Writer, files []*scproto.File) {
+	t := tablewriter.NewWriter(w)
+	t.SetBorder(false)
+	t.SetHeader([]string{
+		"ID",
+		"Filename",
+		"Date",
+		"Size",
+	})
+	for _, file := range files {
+		docDate, err := types.TimestampFromProto(file.DocumentDate)
+		if err != nil {
+			continue
+		}
+		docDate = docDate.Local()
+
+		t.Append([]string{
+			file.Id,
+			file.Filename,
+			docDate.Format(time.RFC1123),
+			humanize.Bytes(file.GetContentSize()),
+		})
+	}
+	t.Render()
+}
@@ -0,0 +1,109 @@
+package commander
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/gogo/protobuf/types"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+const historyLimit = 50
+
+type cmdHistory struct {
+	w      Writer
+	client scproto.SoftcopyClient
+}
+
+func newCmdHistory(w Writer, client scproto.SoftcopyClient) *cmdHistory {
+	return &cmdHistory{
+		w:      w,
+		client: client,
+	}
+}
+
+func (c *cmdHistory) SubCommands() map[string]ParserCmd {
+	return map[string]ParserCmd{}
+}
+
+func (c *cmdHistory) Description() string {
+	return "Show recent changes to all documents, or to a single document"
+}
+
+func (c *cmdHistory) Suggestions(d prompt.Document) []prompt.Suggest {
+	idPrefix := strings.TrimSpace(d.GetWordBeforeCursor())
+
+	if len(idPrefix) < 1 {
+		// Don't show suggestions if less than one character is entered
+		return []prompt.Suggest{}
+	}
+
+	res, err := c.client.FindFilesWithIdPrefix(context.Background(), &scproto.FindFilesWithIdPrefixRequest{
+		IdPrefix: idPrefix,
+	})
+	if err != nil {
+		c.w.Printf("err: %s\n", err)
+		return []prompt.Suggest{}
+	}
+
+	suggestions := []prompt.Suggest{}
+	for _, f := range res.Files {
+		suggestions = append(suggestions, prompt.Suggest{
+			Text:        f.Id,
+			Description: f.Filename,
+		})
+	}
+
+	return suggestions
+}
+
+func (c *cmdHistory) Execute(s string) error {
+	id := strings.TrimSpace(s)
+
+	res, err := c.client.GetAuditLog(context.Background(), &scproto.GetAuditLogRequest{
+		FileId: id,
+		Limit:  historyLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(res.Entries) < 1 {
+		c.w.Printf("No changes found.\n")
+		return nil
+	}
+
+	t := tablewriter.NewWriter(c.w)
+	t.SetBorder(false)
+	t.SetHeader([]string{
+		"When",
+		"Who",
+		"Action",
+		"Document",
+		"Before",
+		"After",
+	})
+	for _, entry := range res.Entries {
+		created, err := types.TimestampFromProto(entry.Created)
+		if err != nil {
+			continue
+		}
+		created = created.Local()
+
+		t.Append([]string{
+			created.Format(time.RFC1123),
+			entry.Actor,
+			strings.Replace(entry.Action, "_", " ", -1),
+			entry.FileId,
+			entry.Before,
+			entry.After,
+		})
+	}
+	t.Render()
+
+	return nil
+}
@@ -2,14 +2,12 @@ package commander
 
 import (
 	"context"
-	"time"
+	"strings"
 
 	"github.com/c-bata/go-prompt"
-	"github.com/dustin/go-humanize"
-	"github.com/gogo/protobuf/types"
-	"github.com/olekukonko/tablewriter"
 
 	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/query"
 	"github.com/aphistic/softcopy/pkg/proto"
 )
 
@@ -30,7 +28,7 @@ func (c *cmdInbox) SubCommands() map[string]ParserCmd {
 }
 
 func (c *cmdInbox) Description() string {
-	return "Show documents in the Inbox"
+	return "Show documents in the Inbox, optionally filtered by a query"
 }
 
 func (c *cmdInbox) Suggestions(d prompt.Document) []prompt.Suggest {
@@ -38,38 +36,19 @@ func (c *cmdInbox) Suggestions(d prompt.Document) []prompt.Suggest {
 }
 
 func (c *cmdInbox) Execute(s string) error {
-	res, err := c.client.FindFilesWithTags(context.Background(), &scproto.FindFilesWithTagsRequest{
-		TagNames: []string{
-			consts.TagUnfiled,
-		},
+	q := query.Quote(consts.TagUnfiled)
+	if filter := strings.TrimSpace(s); filter != "" {
+		q = q + " AND (" + filter + ")"
+	}
+
+	res, err := c.client.QueryFiles(context.Background(), &scproto.QueryFilesRequest{
+		Query: q,
 	})
 	if err != nil {
 		return err
 	}
 
-	t := tablewriter.NewWriter(c.w)
-	t.SetBorder(false)
-	t.SetHeader([]string{
-		"ID",
-		"Filename",
-		"Date",
-		"Size",
-	})
-	for _, file := range res.Files {
-		docDate, err := types.TimestampFromProto(file.DocumentDate)
-		if err != nil {
-			continue
-		}
-		docDate = docDate.Local()
-
-		t.Append([]string{
-			file.Id,
-			file.Filename,
-			docDate.Format(time.RFC1123),
-			humanize.Bytes(file.GetContentSize()),
-		})
-	}
-	t.Render()
+	writeFileTable(c.w, res.Files)
 
 	return nil
 }
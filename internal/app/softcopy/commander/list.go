@@ -0,0 +1,133 @@
+package commander
+
+import (
+	"context"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+const listLimit = 25
+
+type cmdList struct {
+	w      Writer
+	client scproto.SoftcopyClient
+
+	// last is the previous listing, with the cursor of its next page.
+	last *scproto.ListFilesRequest
+	// shown is how many files of the previous listing have been shown.
+	shown int
+}
+
+func newCmdList(w Writer, client scproto.SoftcopyClient) *cmdList {
+	return &cmdList{
+		w:      w,
+		client: client,
+	}
+}
+
+func (c *cmdList) SubCommands() map[string]ParserCmd {
+	return map[string]ParserCmd{
+		"more": &cmdListMore{list: c},
+	}
+}
+
+func (c *cmdList) Description() string {
+	return "List documents, optionally with --sort=date|filename|size|created, --desc and a query"
+}
+
+func (c *cmdList) Suggestions(d prompt.Document) []prompt.Suggest {
+	return []prompt.Suggest{
+		{Text: "--sort=date", Description: "Sort by document date"},
+		{Text: "--sort=filename", Description: "Sort by filename"},
+		{Text: "--sort=size", Description: "Sort by size"},
+		{Text: "--sort=created", Description: "Sort by when documents were added"},
+		{Text: "--desc", Description: "Sort in descending order"},
+		{Text: "more", Description: "Show the next page of the last listing"},
+	}
+}
+
+func (c *cmdList) Execute(s string) error {
+	req := &scproto.ListFilesRequest{
+		Limit: listLimit,
+	}
+
+	query := []string{}
+	for _, part := range strings.Fields(s) {
+		if strings.HasPrefix(part, "--sort=") {
+			sort, err := records.ParseFileSort(strings.TrimPrefix(part, "--sort="))
+			if err != nil {
+				return err
+			}
+			req.Sort = protoutil.FileSortToProto(sort)
+		} else if part == "--desc" {
+			req.Descending = true
+		} else {
+			query = append(query, part)
+		}
+	}
+	req.Query = strings.Join(query, " ")
+
+	c.shown = 0
+	return c.list(req)
+}
+
+func (c *cmdList) list(req *scproto.ListFilesRequest) error {
+	res, err := c.client.ListFiles(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	if res.Total < 1 {
+		c.w.Printf("No documents found.\n")
+		c.last = nil
+		return nil
+	}
+
+	writeFileTable(c.w, res.Files)
+
+	first := c.shown + 1
+	c.shown += len(res.Files)
+	c.w.Printf("Showing %d-%d of %d documents.\n", first, c.shown, res.Total)
+
+	if res.NextCursor == "" {
+		c.last = nil
+		return nil
+	}
+
+	next := *req
+	next.Cursor = res.NextCursor
+	c.last = &next
+	c.w.Printf("Use `list more` to show the next page.\n")
+
+	return nil
+}
+
+type cmdListMore struct {
+	list *cmdList
+}
+
+func (c *cmdListMore) SubCommands() map[string]ParserCmd {
+	return map[string]ParserCmd{}
+}
+
+func (c *cmdListMore) Description() string {
+	return "Show the next page of the last listing"
+}
+
+func (c *cmdListMore) Suggestions(d prompt.Document) []prompt.Suggest {
+	return []prompt.Suggest{}
+}
+
+func (c *cmdListMore) Execute(s string) error {
+	if c.list.last == nil {
+		c.list.w.Printf("No more documents to show.\n")
+		return nil
+	}
+
+	return c.list.list(c.list.last)
+}
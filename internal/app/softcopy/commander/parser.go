@@ -76,12 +76,7 @@ func (p *parser) findCommand(s string) (ParserCmd, string, error) {
 	for idx, part := range parts {
 		sub, ok := curCmd.SubCommands()[part]
 		if !ok {
-			extras := []string{}
-			for extraIdx := idx; idx < len(parts); idx++ {
-				extras = append(extras, parts[extraIdx])
-			}
-
-			return curCmd, strings.Join(extras, " "), nil
+			return curCmd, strings.Join(parts[idx:], " "), nil
 		}
 		curCmd = sub
 	}
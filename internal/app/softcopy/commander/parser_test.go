@@ -40,3 +40,15 @@ func (s *ParserSuite) TestFindCommand(t sweet.T) {
 	Expect(err).To(BeNil())
 	Expect(extra).To(Equal("1234"))
 }
+
+func (s *ParserSuite) TestFindCommandMultipleArguments(t sweet.T) {
+	p := newParser(map[string]ParserCmd{
+		"find": &testCmd{
+			commands: map[string]ParserCmd{},
+		},
+	})
+
+	_, extra, err := p.findCommand("find taxes AND date:2020")
+	Expect(err).To(BeNil())
+	Expect(extra).To(Equal("taxes AND date:2020"))
+}
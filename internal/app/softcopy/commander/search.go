@@ -0,0 +1,82 @@
+package commander
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/gogo/protobuf/types"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type cmdSearch struct {
+	w      Writer
+	client scproto.SoftcopyClient
+}
+
+func newCmdSearch(w Writer, client scproto.SoftcopyClient) *cmdSearch {
+	return &cmdSearch{
+		w:      w,
+		client: client,
+	}
+}
+
+func (c *cmdSearch) SubCommands() map[string]ParserCmd {
+	return map[string]ParserCmd{}
+}
+
+func (c *cmdSearch) Description() string {
+	return "Search the contents of documents"
+}
+
+func (c *cmdSearch) Suggestions(d prompt.Document) []prompt.Suggest {
+	return []prompt.Suggest{}
+}
+
+func (c *cmdSearch) Execute(s string) error {
+	query := strings.TrimSpace(s)
+	if query == "" {
+		return ErrMissingArgument
+	}
+
+	res, err := c.client.SearchFiles(context.Background(), &scproto.SearchFilesRequest{
+		Query: query,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(res.Hits) < 1 {
+		c.w.Printf("No documents found.\n")
+		return nil
+	}
+
+	t := tablewriter.NewWriter(c.w)
+	t.SetBorder(false)
+	t.SetHeader([]string{
+		"ID",
+		"Filename",
+		"Date",
+		"Match",
+	})
+	for _, hit := range res.Hits {
+		docDate, err := types.TimestampFromProto(hit.File.DocumentDate)
+		if err != nil {
+			continue
+		}
+		docDate = docDate.Local()
+
+		t.Append([]string{
+			hit.File.Id,
+			hit.File.Filename,
+			docDate.Format(time.RFC1123),
+			hit.Snippet,
+		})
+	}
+	t.Render()
+
+	return nil
+}
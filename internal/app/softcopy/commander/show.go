@@ -9,6 +9,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/gogo/protobuf/types"
 
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
 	"github.com/aphistic/softcopy/pkg/proto"
 )
 
@@ -82,5 +83,44 @@ func (c *cmdShow) Execute(s string) error {
 	)
 	c.w.Printf("Size: %s\n", humanize.Bytes(file.File.File.GetContentSize()))
 
+	if created := file.File.File.GetCreated(); created != nil {
+		createdAt, err := types.TimestampFromProto(created)
+		if err != nil {
+			return err
+		}
+		c.w.Printf(
+			"Added: %s (%s)\n",
+			humanize.Time(createdAt), createdAt.Local().Format(time.RFC850),
+		)
+	}
+	if updated := file.File.File.GetUpdated(); updated != nil {
+		updatedAt, err := types.TimestampFromProto(updated)
+		if err != nil {
+			return err
+		}
+		c.w.Printf(
+			"Updated: %s (%s)\n",
+			humanize.Time(updatedAt), updatedAt.Local().Format(time.RFC850),
+		)
+	}
+	if source := file.File.File.GetSource(); source != "" {
+		c.w.Printf("Source: %s\n", source)
+	}
+	if originalPath := file.File.File.GetOriginalPath(); originalPath != "" {
+		c.w.Printf("Original Path: %s\n", originalPath)
+	}
+
+	if links := file.File.GetLinks(); len(links) > 0 {
+		c.w.Printf("Links:\n")
+		for _, link := range links {
+			linkType := protoutil.ProtoToLinkType(link.GetType())
+			if link.GetSourceId() == file.File.File.Id {
+				c.w.Printf("  this %s %s\n", linkType, link.GetTargetId())
+			} else {
+				c.w.Printf("  %s %s this\n", link.GetSourceId(), linkType)
+			}
+		}
+	}
+
 	return nil
 }
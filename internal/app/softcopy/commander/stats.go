@@ -0,0 +1,76 @@
+package commander
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/dustin/go-humanize"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/aphistic/softcopy/pkg/proto"
+)
+
+type cmdStats struct {
+	w      Writer
+	client scproto.SoftcopyClient
+}
+
+func newCmdStats(w Writer, client scproto.SoftcopyClient) *cmdStats {
+	return &cmdStats{
+		w:      w,
+		client: client,
+	}
+}
+
+func (c *cmdStats) SubCommands() map[string]ParserCmd {
+	return map[string]ParserCmd{}
+}
+
+func (c *cmdStats) Description() string {
+	return "Show how many documents and tags there are and how much space they use"
+}
+
+func (c *cmdStats) Suggestions(d prompt.Document) []prompt.Suggest {
+	return []prompt.Suggest{}
+}
+
+func (c *cmdStats) Execute(s string) error {
+	res, err := c.client.GetStats(context.Background(), &scproto.GetStatsRequest{})
+	if err != nil {
+		return err
+	}
+	stats := res.GetStats()
+
+	c.w.Printf("Documents: %d\n", stats.GetFiles())
+	c.w.Printf("Inbox: %d\n", stats.GetInboxFiles())
+	c.w.Printf("Trash: %d\n", stats.GetTrashedFiles())
+	c.w.Printf("Tags: %d\n", stats.GetTags())
+	c.w.Printf("Size: %s\n", humanize.Bytes(stats.GetLogicalBytes()))
+	c.w.Printf(
+		"Stored: %s in %d contents\n",
+		humanize.Bytes(stats.GetStoredBytes()), stats.GetStoredContents(),
+	)
+	c.w.Printf("Saved by deduplication: %s\n", humanize.Bytes(stats.GetDedupSavings()))
+
+	if len(stats.GetYears()) < 1 {
+		return nil
+	}
+
+	c.w.Printf("\n")
+	t := tablewriter.NewWriter(c.w)
+	t.SetBorder(false)
+	t.SetHeader([]string{
+		"Year",
+		"Documents",
+	})
+	for _, year := range stats.GetYears() {
+		t.Append([]string{
+			fmt.Sprintf("%d", year.GetYear()),
+			fmt.Sprintf("%d", year.GetFiles()),
+		})
+	}
+	t.Render()
+
+	return nil
+}
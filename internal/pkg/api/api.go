@@ -1,13 +1,10 @@
 package api
 
 import (
-	"path"
-
 	"github.com/efritz/nacelle"
 
+	"github.com/aphistic/softcopy/internal/pkg/logging"
 	"github.com/aphistic/softcopy/internal/pkg/storage"
-	dataSqlite "github.com/aphistic/softcopy/internal/pkg/storage/data/sqlite"
-	fileLocal "github.com/aphistic/softcopy/internal/pkg/storage/file/local"
 )
 
 type Initializer struct {
@@ -25,35 +22,25 @@ func (i *Initializer) Init(config nacelle.Config) error {
 		return err
 	}
 
-	fs, err := fileLocal.NewFileLocal(
-		cfg.StorageRoot,
-		fileLocal.WithLogger(i.Logger),
-	)
+	fs, err := newFileEngine(cfg, i.Logger)
 	if err != nil {
 		return err
 	}
 
-	ds, err := dataSqlite.NewClient(path.Join(cfg.StorageRoot, "softcopy.db"))
-	if err != nil {
-		return err
-	}
-	err = ds.Migrate()
+	ds, err := newDataEngine(cfg, i.Logger)
 	if err != nil {
 		return err
 	}
 
-	err = i.Container.Set("api", &Client{
-		cfg:    cfg,
-		logger: i.Logger,
+	opts := []ClientOption{WithLogger(i.Logger)}
+	if cfg.ScrubBackup != nil && cfg.ScrubBackup.Path != "" {
+		opts = append(opts, WithScrubBackup(cfg.ScrubBackup.Path))
+	}
 
-		openManager: newOpenFileManager(
-			fs, ds,
-			withLogger(i.Logger),
-		),
+	c := NewClient(fs, ds, opts...)
+	c.cfg = cfg
 
-		fileStorage: fs,
-		dataStorage: ds,
-	})
+	err = i.Container.Set("api", c)
 	if err != nil {
 		return err
 	}
@@ -63,10 +50,59 @@ func (i *Initializer) Init(config nacelle.Config) error {
 
 type Client struct {
 	cfg    *Config
-	logger nacelle.Logger
+	logger logging.Logger
+	// actor is who changes are recorded as in the audit log.
+	actor string
+	// scrubBackupPath is the backup damaged contents are repaired from.
+	scrubBackupPath string
 
 	openManager *openFileManager
 
 	fileStorage storage.File
 	dataStorage storage.Data
 }
+
+type ClientOption func(*Client)
+
+func WithLogger(logger logging.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithScrubBackup sets the directory of a backup made with softcopy-admin
+// backup that ScrubFiles repairs damaged contents from.
+func WithScrubBackup(backupPath string) ClientOption {
+	return func(c *Client) {
+		c.scrubBackupPath = backupPath
+	}
+}
+
+// NewClient creates a client using the given storage engines directly,
+// without loading them from config. This is mostly useful for tests using
+// the memory engines.
+func NewClient(
+	fileStorage storage.File,
+	dataStorage storage.Data,
+	opts ...ClientOption,
+) *Client {
+	c := &Client{
+		cfg:    &Config{},
+		logger: logging.NewNilLogger(),
+		actor:  ActorSystem,
+
+		fileStorage: fileStorage,
+		dataStorage: dataStorage,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.openManager = newOpenFileManager(
+		fileStorage, dataStorage,
+		withLogger(c.logger),
+	)
+
+	return c
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// ActorSystem is the actor recorded in the audit log for changes made by
+// the server itself.
+const ActorSystem = "system"
+
+// WithActor returns a client that records changes in the audit log as
+// made by the given actor.
+func (c *Client) WithActor(actor string) *Client {
+	actorClient := *c
+	actorClient.actor = actor
+
+	return &actorClient
+}
+
+// Actor returns who changes made with the client are recorded as.
+func (c *Client) Actor() string {
+	return c.actor
+}
+
+// GetAuditLog returns audit entries newest first. If id is empty the
+// entries for all files are returned.
+func (c *Client) GetAuditLog(id string, limit int) ([]*records.AuditEntry, error) {
+	fileID := uuid.Nil
+	if id != "" {
+		var err error
+		fileID, err = uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.dataStorage.GetAuditLog(fileID, limit)
+}
+
+func (c *Client) audit(action string, fileID uuid.UUID, before string, after string) {
+	addAuditEntry(c.dataStorage, c.logger, &records.AuditEntry{
+		Created: time.Now(),
+		Actor:   c.actor,
+		Action:  action,
+		FileID:  fileID,
+		Before:  before,
+		After:   after,
+	})
+}
+
+// addAuditEntry adds an entry to the audit log. The change being audited
+// has already been made, so failing to record it is logged instead of
+// being returned.
+func addAuditEntry(dataStorage storage.Data, logger logging.Logger, entry *records.AuditEntry) {
+	err := dataStorage.AddAuditEntry(entry)
+	if err != nil {
+		logger.Error("could not add %s audit entry for %s: %s", entry.Action, entry.FileID, err)
+	}
+}
+
+// auditFile describes a file in the audit log by its name and date.
+func auditFile(filename string, documentDate time.Time) string {
+	return fmt.Sprintf("%s %s", filename, documentDate.UTC().Format("2006-01-02"))
+}
+
+// auditFileAttributes describes the attributes of a file changed by
+// UpdateFile in the audit log.
+func auditFileAttributes(file *records.File, fields []*records.Field) string {
+	return fmt.Sprintf(
+		"%s title=%q notes=%q fields=[%s]",
+		auditFile(file.Filename, file.DocumentDate),
+		file.Title, file.Notes, auditFields(fields),
+	)
+}
+
+// auditLink describes a link between files in the audit log.
+func auditLink(linkType records.LinkType, targetID uuid.UUID) string {
+	return fmt.Sprintf("%s %s", linkType, targetID)
+}
+
+// auditNames describes a set of names, such as tags, in the audit log.
+func auditNames(names []string) string {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ", ")
+}
+
+// auditFileTags returns the names of a file's tags for the audit log.
+func (c *Client) auditFileTags(id uuid.UUID) string {
+	tags, err := c.dataStorage.GetTagsForFile(id)
+	if err != nil {
+		c.logger.Error("could not get tags of %s for audit: %s", id, err)
+		return ""
+	}
+	defer tags.Close()
+
+	names := []string{}
+	for item := range tags.Tags() {
+		if item.Error != nil {
+			c.logger.Error("could not get tag of %s for audit: %s", id, item.Error)
+			continue
+		}
+		names = append(names, item.Tag.Name)
+	}
+
+	return auditNames(names)
+}
+
+// auditFields describes custom fields in the audit log.
+func auditFields(fields []*records.Field) string {
+	values := []string{}
+	for _, field := range fields {
+		values = append(values, fmt.Sprintf("%s=%s", field.Name, field.Value()))
+	}
+
+	return auditNames(values)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestAuditLog(t *testing.T) {
+	docDate := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("records changes with actor", func(t *testing.T) {
+		c := newTestClient()
+		alice := c.WithActor("alice")
+		assert.Equal(t, ActorSystem, c.Actor())
+		assert.Equal(t, "alice", alice.Actor())
+
+		id, err := c.CreateFile("a.pdf", docDate)
+		require.NoError(t, err)
+
+		require.NoError(t, alice.UpdateFileDate(id, "b.pdf", docDate.AddDate(0, 0, 1)))
+		_, err = alice.CreateTags([]string{"receipts"})
+		require.NoError(t, err)
+		require.NoError(t, alice.UpdateFileTags(id, []string{"receipts"}, []string{consts.TagUnfiled}))
+		require.NoError(t, alice.RemoveFile(id.String()))
+
+		entries, err := c.GetAuditLog(id.String(), 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+
+		assert.Equal(t, records.AuditActionRemoveFile, entries[0].Action)
+		assert.Equal(t, "b.pdf 2020-03-05", entries[0].Before)
+
+		assert.Equal(t, records.AuditActionUpdateFileTags, entries[1].Action)
+		assert.Equal(t, "alice", entries[1].Actor)
+		assert.Equal(t, consts.TagUnfiled, entries[1].Before)
+		assert.Equal(t, "receipts", entries[1].After)
+
+		assert.Equal(t, records.AuditActionUpdateFileDate, entries[2].Action)
+		assert.Equal(t, "a.pdf 2020-03-04", entries[2].Before)
+		assert.Equal(t, "b.pdf 2020-03-05", entries[2].After)
+
+		assert.Equal(t, records.AuditActionCreateFile, entries[3].Action)
+		assert.Equal(t, ActorSystem, entries[3].Actor)
+		assert.Equal(t, "", entries[3].Before)
+		assert.Equal(t, "a.pdf 2020-03-04", entries[3].After)
+
+		entries, err = c.GetAuditLog("", 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 5)
+		assert.Equal(t, records.AuditActionCreateTags, entries[2].Action)
+		assert.Equal(t, uuid.Nil, entries[2].FileID)
+		assert.Equal(t, "receipts", entries[2].After)
+	})
+	t.Run("records content changes", func(t *testing.T) {
+		c := newTestClient().WithActor("bob")
+
+		id, err := c.CreateFile("a.txt", docDate)
+		require.NoError(t, err)
+		of, err := c.OpenFile(id, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello"))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		entries, err := c.GetAuditLog(id.String(), 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, records.AuditActionUpdateFileHash, entries[0].Action)
+		assert.Equal(t, "bob", entries[0].Actor)
+		assert.Equal(t, "", entries[0].Before)
+		assert.Equal(t, of.WrittenHash(), entries[0].After)
+	})
+	t.Run("failed changes aren't recorded", func(t *testing.T) {
+		c := newTestClient()
+
+		assert.Error(t, c.RemoveFile(uuid.New().String()))
+		_, err := c.CreateTags([]string{"not valid/"})
+		assert.Error(t, err)
+
+		entries, err := c.GetAuditLog("", 0)
+		require.NoError(t, err)
+		assert.Len(t, entries, 0)
+	})
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// normalizeColor lowercases the color and makes sure it's in the form
+// #rrggbb. An empty color is allowed and uses the default tag color.
+func normalizeColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if color != "" && !colorPattern.MatchString(color) {
+		return "", ErrInvalidColor
+	}
+
+	return color, nil
+}
+
+func (c *Client) AllTagCategories() ([]*records.TagCategory, error) {
+	return c.dataStorage.AllTagCategories()
+}
+
+func (c *Client) GetTagCategory(id uuid.UUID) (*records.TagCategory, error) {
+	return c.dataStorage.GetTagCategory(id)
+}
+
+func (c *Client) CreateTagCategory(name string, color string) (*records.TagCategory, error) {
+	color, err := normalizeColor(color)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.dataStorage.CreateTagCategory(name, color)
+	if err != nil {
+		return nil, err
+	}
+
+	return &records.TagCategory{
+		ID:    id,
+		Name:  name,
+		Color: color,
+	}, nil
+}
+
+func (c *Client) UpdateTagCategory(category *records.TagCategory) error {
+	color, err := normalizeColor(category.Color)
+	if err != nil {
+		return err
+	}
+
+	return c.dataStorage.UpdateTagCategory(&records.TagCategory{
+		ID:    category.ID,
+		Name:  category.Name,
+		Color: color,
+	})
+}
+
+func (c *Client) RemoveTagCategory(id uuid.UUID) error {
+	return c.dataStorage.RemoveTagCategory(id)
+}
+
+func (c *Client) SetTagCategory(tagName string, categoryID uuid.UUID) error {
+	return c.dataStorage.SetTagCategory(tagName, categoryID)
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestTagCategories(t *testing.T) {
+	t.Run("colors are normalized", func(t *testing.T) {
+		c := newTestClient()
+
+		category, err := c.CreateTagCategory("Business", " #00FF00 ")
+		require.NoError(t, err)
+		assert.Equal(t, "#00ff00", category.Color)
+
+		stored, err := c.GetTagCategory(category.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "#00ff00", stored.Color)
+
+		category, err = c.CreateTagCategory("Personal", "")
+		require.NoError(t, err)
+		assert.Equal(t, "", category.Color)
+	})
+	t.Run("invalid colors", func(t *testing.T) {
+		c := newTestClient()
+
+		for _, color := range []string{"green", "#0f0", "00ff00", "#00ff0g"} {
+			_, err := c.CreateTagCategory("Business", color)
+			assert.Equal(t, ErrInvalidColor, err, color)
+		}
+
+		category, err := c.CreateTagCategory("Business", "#00ff00")
+		require.NoError(t, err)
+
+		err = c.UpdateTagCategory(&records.TagCategory{
+			ID:    category.ID,
+			Name:  "Business",
+			Color: "green",
+		})
+		assert.Equal(t, ErrInvalidColor, err)
+	})
+}
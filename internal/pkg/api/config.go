@@ -1,7 +1,56 @@
 package api
 
+import (
+	"github.com/aphistic/softcopy/internal/pkg/config"
+)
+
 type Config struct {
 	StorageRoot string `env:"STORAGE_ROOT" default:"./data"`
+
+	Metadata    *engineConfig      `file:"metadata"`
+	Files       *filesConfig       `file:"files"`
+	ScrubBackup *scrubBackupConfig `file:"scrub_backup"`
+}
+
+type engineConfig struct {
+	Engine  string           `yaml:"engine"`
+	Options []*config.Option `yaml:"options"`
+}
+
+type filesConfig struct {
+	Engine  string           `yaml:"engine"`
+	Options []*config.Option `yaml:"options"`
+
+	// Encryption encrypts files before they're kept by the engine,
+	// they're kept as they are without it.
+	Encryption *encryptionConfig `yaml:"encryption"`
+	// Compression compresses files before they're encrypted and kept by
+	// the engine, they're kept as they are without it.
+	Compression *compressionConfig `yaml:"compression"`
+}
+
+type encryptionConfig struct {
+	// KeyID is the ID of the key new files are encrypted with.
+	KeyID string `yaml:"key_id"`
+	// Keys are base64 encoded AES keys named by their IDs. Earlier keys
+	// need to be kept until every file has been encrypted again with the
+	// current key.
+	Keys []*config.Option `yaml:"keys"`
+}
+
+type compressionConfig struct {
+	// Level is how hard new files are compressed, one of fastest,
+	// default, better or best.
+	Level string `yaml:"level"`
+	// ChunkSize is how much of a file is compressed together, which is
+	// how much needs to be decompressed to read any part of it.
+	ChunkSize int `yaml:"chunk_size"`
+}
+
+type scrubBackupConfig struct {
+	// Path is the directory of a backup made with softcopy-admin
+	// backup to repair damaged contents from.
+	Path string `yaml:"path"`
 }
 
 type configToken struct{}
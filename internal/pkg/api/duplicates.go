@@ -0,0 +1,200 @@
+package api
+
+import (
+	"sort"
+
+	"github.com/aphistic/softcopy/internal/pkg/extract"
+	"github.com/aphistic/softcopy/internal/pkg/imagehash"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// DefaultNearDistance is the largest distance between the image hashes of
+// two documents for them to be near-duplicates.
+const DefaultNearDistance = 8
+
+type FindDuplicatesOptions struct {
+	// Near also finds documents with different contents that look the
+	// same, such as a page scanned twice at different resolutions. Only
+	// images and scanned PDFs are compared, and every one of them is
+	// read, so it's much slower.
+	Near bool
+	// MaxDistance is the largest distance between image hashes for near
+	// duplicates, zero uses DefaultNearDistance.
+	MaxDistance int
+}
+
+// FindDuplicates returns groups of files with the same contents, followed
+// by groups of near-duplicates if opts.Near is set.
+func (c *Client) FindDuplicates(opts *FindDuplicatesOptions) ([]*records.DuplicateGroup, error) {
+	groups, err := c.dataStorage.FindDuplicates()
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.Near {
+		return groups, nil
+	}
+
+	maxDistance := opts.MaxDistance
+	if maxDistance <= 0 {
+		maxDistance = DefaultNearDistance
+	}
+
+	nearGroups, err := c.findNearDuplicates(maxDistance)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(groups, nearGroups...), nil
+}
+
+// imageContents is a set of files with the same contents and the hash of
+// how those contents look.
+type imageContents struct {
+	files     []*records.File
+	imageHash imagehash.Hash
+}
+
+func (c *Client) findNearDuplicates(maxDistance int) ([]*records.DuplicateGroup, error) {
+	allFiles, err := c.allFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	byHash := map[string]*imageContents{}
+	// skipped holds contents that can't be compared
+	skipped := map[string]bool{}
+	for _, file := range allFiles {
+		if file.Hash == "" || skipped[file.Hash] {
+			continue
+		}
+
+		if contents, ok := byHash[file.Hash]; ok {
+			contents.files = append(contents.files, file)
+			continue
+		}
+
+		imageHash, ok := c.hashFileImage(file)
+		if !ok {
+			skipped[file.Hash] = true
+			continue
+		}
+		byHash[file.Hash] = &imageContents{
+			files:     []*records.File{file},
+			imageHash: imageHash,
+		}
+	}
+
+	hashes := []string{}
+	for hash := range byHash {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	// Join contents that look alike into sets, where each set is
+	// identified by its first contents in hashes.
+	parents := make([]int, len(hashes))
+	for idx := range parents {
+		parents[idx] = idx
+	}
+	var root func(int) int
+	root = func(idx int) int {
+		if parents[idx] != idx {
+			parents[idx] = root(parents[idx])
+		}
+		return parents[idx]
+	}
+	for i := range hashes {
+		for j := i + 1; j < len(hashes); j++ {
+			distance := imagehash.Distance(byHash[hashes[i]].imageHash, byHash[hashes[j]].imageHash)
+			if distance <= maxDistance {
+				parents[root(j)] = root(i)
+			}
+		}
+	}
+
+	sets := map[int][]*imageContents{}
+	for idx, hash := range hashes {
+		sets[root(idx)] = append(sets[root(idx)], byHash[hash])
+	}
+
+	res := []*records.DuplicateGroup{}
+	for idx := range hashes {
+		set := sets[idx]
+		if len(set) < 2 {
+			// Contents that only look like themselves are either
+			// unique or exact duplicates, which are already found.
+			continue
+		}
+
+		group := &records.DuplicateGroup{}
+		for i, contents := range set {
+			group.Files = append(group.Files, contents.files...)
+			for _, other := range set[i+1:] {
+				distance := imagehash.Distance(contents.imageHash, other.imageHash)
+				if distance > group.Distance {
+					group.Distance = distance
+				}
+			}
+		}
+		sort.Slice(group.Files, func(i, j int) bool {
+			a, b := group.Files[i], group.Files[j]
+			if a.Created.Equal(b.Created) {
+				return a.ID.String() < b.ID.String()
+			}
+			return a.Created.Before(b.Created)
+		})
+
+		res = append(res, group)
+	}
+
+	return res, nil
+}
+
+// allFiles reads all of the files up front so contents can be read
+// without holding the iterator open.
+func (c *Client) allFiles() ([]*records.File, error) {
+	files, err := c.dataStorage.AllFiles()
+	if err != nil {
+		return nil, err
+	}
+	defer files.Close()
+
+	res := []*records.File{}
+	for item := range files.Files() {
+		if item.Error != nil {
+			return nil, item.Error
+		}
+		res = append(res, item.File)
+	}
+
+	return res, nil
+}
+
+// hashFileImage computes the image hash of a file's contents. Files that
+// can't be compared, or fail to be read, are left out of near-duplicate
+// matching so one bad document doesn't stop the rest from being compared.
+func (c *Client) hashFileImage(file *records.File) (imagehash.Hash, bool) {
+	md, err := c.dataStorage.FindMetadataByHash(file.Hash)
+	if err != nil {
+		c.logger.Error("could not find contents of %s for duplicates: %s", file.ID, err)
+		return 0, false
+	}
+
+	f, err := c.fileStorage.OpenFile(md.ID)
+	if err != nil {
+		c.logger.Error("could not open contents of %s for duplicates: %s", file.ID, err)
+		return 0, false
+	}
+	defer f.Close()
+
+	img, err := extract.Image(file.Filename, f)
+	if err == extract.ErrUnsupported {
+		return 0, false
+	} else if err != nil {
+		c.logger.Error("could not read image of %s for duplicates: %s", file.ID, err)
+		return 0, false
+	}
+
+	return imagehash.Difference(img), true
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	docDate := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	writeFile := func(t *testing.T, c *Client, filename string, data []byte) uuid.UUID {
+		id, err := c.CreateFile(filename, docDate)
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(id, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		return id
+	}
+	// scan encodes a horizontal gradient, which is the same image at any
+	// size, getting darker to the right unless it's reversed.
+	scan := func(t *testing.T, width int, height int, reversed bool) []byte {
+		img := image.NewGray(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				shade := uint8(255 - x*255/width)
+				if reversed {
+					shade = 255 - shade
+				}
+				img.SetGray(x, y, color.Gray{Y: shade})
+			}
+		}
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, png.Encode(buf, img))
+		return buf.Bytes()
+	}
+	groupIDs := func(group *records.DuplicateGroup) []uuid.UUID {
+		ids := []uuid.UUID{}
+		for _, f := range group.Files {
+			ids = append(ids, f.ID)
+		}
+		return ids
+	}
+
+	c := newTestClient()
+	first := writeFile(t, c, "a.txt", []byte("same contents"))
+	second := writeFile(t, c, "b.txt", []byte("same contents"))
+	writeFile(t, c, "c.txt", []byte("other contents"))
+	lowRes := writeFile(t, c, "scan-150.png", scan(t, 85, 110, false))
+	highRes := writeFile(t, c, "scan-600.png", scan(t, 340, 440, false))
+	writeFile(t, c, "other-scan.png", scan(t, 340, 440, true))
+
+	t.Run("exact duplicates", func(t *testing.T) {
+		groups, err := c.FindDuplicates(&FindDuplicatesOptions{})
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		assert.NotEmpty(t, groups[0].Hash)
+		assert.Equal(t, 0, groups[0].Distance)
+		assert.ElementsMatch(t, []uuid.UUID{first, second}, groupIDs(groups[0]))
+	})
+	t.Run("near duplicates", func(t *testing.T) {
+		groups, err := c.FindDuplicates(&FindDuplicatesOptions{
+			Near: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, groups, 2)
+		assert.ElementsMatch(t, []uuid.UUID{first, second}, groupIDs(groups[0]))
+
+		assert.Empty(t, groups[1].Hash)
+		assert.True(t, groups[1].Distance <= DefaultNearDistance)
+		assert.ElementsMatch(t, []uuid.UUID{lowRes, highRes}, groupIDs(groups[1]))
+	})
+}
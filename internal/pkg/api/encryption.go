@@ -0,0 +1,98 @@
+package api
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+)
+
+// reencrypter is a files engine that encrypts files and can encrypt them
+// again with its current key.
+type reencrypter interface {
+	KeyID() string
+	BlobKeyID(uuid.UUID) (string, error)
+	Reencrypt(uuid.UUID) error
+}
+
+// ReencryptResult describes the stored contents that weren't encrypted
+// with the current key, which were encrypted again unless it was a dry run.
+type ReencryptResult struct {
+	DryRun bool
+
+	// KeyID is the ID of the current key.
+	KeyID string
+	// Blobs are the stored contents that were encrypted with an earlier
+	// key or weren't encrypted.
+	Blobs []uuid.UUID
+}
+
+// ReencryptFiles encrypts every stored file that isn't encrypted with the
+// current key again with it, so earlier keys can be removed afterwards.
+// If dryRun is set nothing is changed. It returns ErrNotEncrypted if the
+// files engine doesn't encrypt files.
+func (c *Client) ReencryptFiles(dryRun bool) (*ReencryptResult, error) {
+	return c.openManager.reencryptFiles(dryRun)
+}
+
+// findReencrypter finds the engine that encrypts files, which can be
+// wrapped by others such as to compress files before they're encrypted.
+func findReencrypter(fs storage.File) (reencrypter, bool) {
+	for {
+		if re, ok := fs.(reencrypter); ok {
+			return re, true
+		}
+
+		wrapper, ok := fs.(storage.FileWrapper)
+		if !ok {
+			return nil, false
+		}
+		fs = wrapper.Unwrap()
+	}
+}
+
+func (ofm *openFileManager) reencryptFiles(dryRun bool) (*ReencryptResult, error) {
+	re, ok := findReencrypter(ofm.fileStorage)
+	if !ok {
+		return nil, ErrNotEncrypted
+	}
+
+	// Hold the lock like writes do so garbage collection doesn't remove
+	// contents while they're encrypted again.
+	ofm.gcLock.RLock()
+	defer ofm.gcLock.RUnlock()
+
+	res := &ReencryptResult{
+		DryRun: dryRun,
+		KeyID:  re.KeyID(),
+		Blobs:  []uuid.UUID{},
+	}
+
+	blobs, err := ofm.fileStorage.ListBlobs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, blobID := range blobs {
+		keyID, err := re.BlobKeyID(blobID)
+		if err != nil {
+			return nil, err
+		}
+		if keyID == res.KeyID {
+			continue
+		}
+
+		res.Blobs = append(res.Blobs, blobID)
+		if dryRun {
+			continue
+		}
+
+		ofm.logger.Debug("encrypting %s again, it was encrypted with '%s'", blobID, keyID)
+
+		err = re.Reencrypt(blobID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dataMemory "github.com/aphistic/softcopy/internal/pkg/storage/data/memory"
+	fileCompressed "github.com/aphistic/softcopy/internal/pkg/storage/file/compressed"
+	fileEncrypted "github.com/aphistic/softcopy/internal/pkg/storage/file/encrypted"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestReencryptFiles(t *testing.T) {
+	t.Run("not encrypted", func(t *testing.T) {
+		c := NewClient(fileMemory.NewFileMemory(), dataMemory.NewClient())
+
+		_, err := c.ReencryptFiles(true)
+		assert.Equal(t, ErrNotEncrypted, err)
+	})
+	t.Run("encrypts with the current key", func(t *testing.T) {
+		fm := fileMemory.NewFileMemory()
+		ds := dataMemory.NewClient()
+		keys := map[string][]byte{
+			"old": bytes.Repeat([]byte{1}, 32),
+			"new": bytes.Repeat([]byte{2}, 32),
+		}
+
+		oldFE, err := fileEncrypted.NewFileEncrypted(fm, keys, "old")
+		require.NoError(t, err)
+		c := NewClient(oldFE, ds)
+
+		id, err := c.CreateFile("a.txt", time.Now())
+		require.NoError(t, err)
+		of, err := c.OpenFile(id, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello world"))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		newFE, err := fileEncrypted.NewFileEncrypted(fm, keys, "new")
+		require.NoError(t, err)
+		c = NewClient(newFE, ds)
+
+		res, err := c.ReencryptFiles(true)
+		require.NoError(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, "new", res.KeyID)
+		require.Len(t, res.Blobs, 1)
+
+		res, err = c.ReencryptFiles(false)
+		require.NoError(t, err)
+		require.Len(t, res.Blobs, 1)
+		keyID, err := newFE.BlobKeyID(res.Blobs[0])
+		require.NoError(t, err)
+		assert.Equal(t, "new", keyID)
+
+		res, err = c.ReencryptFiles(false)
+		require.NoError(t, err)
+		assert.Len(t, res.Blobs, 0)
+
+		of, err = c.OpenFile(id, records.FILE_MODE_READ)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+		assert.Equal(t, "hello world", string(data))
+	})
+	t.Run("finds encryption under compression", func(t *testing.T) {
+		fe, err := fileEncrypted.NewFileEncrypted(
+			fileMemory.NewFileMemory(),
+			map[string][]byte{"a": bytes.Repeat([]byte{1}, 32)},
+			"a",
+		)
+		require.NoError(t, err)
+		fc, err := fileCompressed.NewFileCompressed(fe)
+		require.NoError(t, err)
+		c := NewClient(fc, dataMemory.NewClient())
+
+		res, err := c.ReencryptFiles(true)
+		require.NoError(t, err)
+		assert.Equal(t, "a", res.KeyID)
+	})
+}
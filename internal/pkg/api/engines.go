@@ -0,0 +1,279 @@
+package api
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/aphistic/softcopy/internal/pkg/config"
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	dataMemory "github.com/aphistic/softcopy/internal/pkg/storage/data/memory"
+	dataPostgres "github.com/aphistic/softcopy/internal/pkg/storage/data/postgres"
+	dataSqlite "github.com/aphistic/softcopy/internal/pkg/storage/data/sqlite"
+	fileCompressed "github.com/aphistic/softcopy/internal/pkg/storage/file/compressed"
+	fileEncrypted "github.com/aphistic/softcopy/internal/pkg/storage/file/encrypted"
+	fileLocal "github.com/aphistic/softcopy/internal/pkg/storage/file/local"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	fileS3 "github.com/aphistic/softcopy/internal/pkg/storage/file/s3"
+)
+
+const (
+	defaultDataEngine = "sqlite"
+	defaultFileEngine = "local"
+)
+
+var dataEngineCreators = map[string]dataEngineCreator{
+	"sqlite": func(
+		cfg *Config,
+		loader *config.OptionLoader,
+		logger logging.Logger,
+	) (storage.Data, error) {
+		dbPath, err := loader.GetStringOrDefault(
+			"path",
+			path.Join(cfg.StorageRoot, "softcopy.db"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not get path: %s", err)
+		}
+
+		ds, err := dataSqlite.NewClient(dbPath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ds.Migrate()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ds.FullTextSearch() {
+			logger.Info(
+				"sqlite was built without FTS5, searches will scan the contents of every file. " +
+					"Build with -tags sqlite_fts5 to use a full-text index",
+			)
+		}
+
+		return ds, nil
+	},
+	"postgres": func(
+		cfg *Config,
+		loader *config.OptionLoader,
+		logger logging.Logger,
+	) (storage.Data, error) {
+		dsn, err := loader.GetString("dsn")
+		if err != nil {
+			return nil, fmt.Errorf("could not get dsn: %s", err)
+		}
+
+		ds, err := dataPostgres.NewClient(dsn)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ds.Migrate()
+		if err != nil {
+			return nil, err
+		}
+
+		return ds, nil
+	},
+	"memory": func(
+		cfg *Config,
+		loader *config.OptionLoader,
+		logger logging.Logger,
+	) (storage.Data, error) {
+		return dataMemory.NewClient(), nil
+	},
+}
+
+var fileEngineCreators = map[string]fileEngineCreator{
+	"local": func(
+		cfg *Config,
+		loader *config.OptionLoader,
+		logger logging.Logger,
+	) (storage.File, error) {
+		basePath, err := loader.GetStringOrDefault("path", cfg.StorageRoot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get path: %s", err)
+		}
+		layoutName, err := loader.GetStringOrDefault("layout", string(fileLocal.LayoutID))
+		if err != nil {
+			return nil, fmt.Errorf("could not get layout: %s", err)
+		}
+		layout, err := fileLocal.ParseLayout(layoutName)
+		if err != nil {
+			return nil, err
+		}
+
+		return fileLocal.NewFileLocal(
+			basePath,
+			fileLocal.WithLogger(logger),
+			fileLocal.WithLayout(layout),
+		)
+	},
+	"memory": func(
+		cfg *Config,
+		loader *config.OptionLoader,
+		logger logging.Logger,
+	) (storage.File, error) {
+		return fileMemory.NewFileMemory(), nil
+	},
+	"s3": func(
+		cfg *Config,
+		loader *config.OptionLoader,
+		logger logging.Logger,
+	) (storage.File, error) {
+		endpoint, err := loader.GetString("endpoint")
+		if err != nil {
+			return nil, fmt.Errorf("could not get endpoint: %s", err)
+		}
+		bucket, err := loader.GetString("bucket")
+		if err != nil {
+			return nil, fmt.Errorf("could not get bucket: %s", err)
+		}
+		accessKey, err := loader.GetStringOrDefault("access_key", "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get access_key: %s", err)
+		}
+		secretKey, err := loader.GetStringOrDefault("secret_key", "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get secret_key: %s", err)
+		}
+		region, err := loader.GetStringOrDefault("region", "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get region: %s", err)
+		}
+		secure, err := loader.GetBoolOrDefault("secure", true)
+		if err != nil {
+			return nil, fmt.Errorf("could not get secure: %s", err)
+		}
+		prefix, err := loader.GetStringOrDefault("prefix", "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get prefix: %s", err)
+		}
+
+		opts := []fileS3.FileS3Option{
+			fileS3.WithLogger(logger),
+			fileS3.WithCredentials(accessKey, secretKey),
+			fileS3.WithPrefix(prefix),
+		}
+		if region != "" {
+			opts = append(opts, fileS3.WithRegion(region))
+		}
+		if !secure {
+			opts = append(opts, fileS3.WithInsecure())
+		}
+
+		return fileS3.NewFileS3(endpoint, bucket, opts...)
+	},
+}
+
+type dataEngineCreator func(*Config, *config.OptionLoader, logging.Logger) (storage.Data, error)
+type fileEngineCreator func(*Config, *config.OptionLoader, logging.Logger) (storage.File, error)
+
+func newDataEngine(cfg *Config, logger logging.Logger) (storage.Data, error) {
+	engineName := defaultDataEngine
+	var options config.Options
+	if cfg.Metadata != nil {
+		if cfg.Metadata.Engine != "" {
+			engineName = cfg.Metadata.Engine
+		}
+		options = cfg.Metadata.Options
+	}
+
+	engineCreate, ok := dataEngineCreators[engineName]
+	if !ok {
+		return nil, fmt.Errorf("unknown metadata engine '%s'", engineName)
+	}
+
+	ds, err := engineCreate(cfg, config.NewOptionLoader(options), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not create metadata engine '%s': %s", engineName, err)
+	}
+
+	return ds, nil
+}
+
+func newFileEngine(cfg *Config, logger logging.Logger) (storage.File, error) {
+	engineName := defaultFileEngine
+	var options config.Options
+	if cfg.Files != nil {
+		if cfg.Files.Engine != "" {
+			engineName = cfg.Files.Engine
+		}
+		options = cfg.Files.Options
+	}
+
+	engineCreate, ok := fileEngineCreators[engineName]
+	if !ok {
+		return nil, fmt.Errorf("unknown files engine '%s'", engineName)
+	}
+
+	fs, err := engineCreate(cfg, config.NewOptionLoader(options), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not create files engine '%s': %s", engineName, err)
+	}
+
+	if cfg.Files != nil && cfg.Files.Encryption != nil {
+		fs, err = newEncryptedFileEngine(fs, cfg.Files.Encryption, logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not set up encryption: %s", err)
+		}
+	}
+
+	if cfg.Files != nil && cfg.Files.Compression != nil {
+		fs, err = newCompressedFileEngine(fs, cfg.Files.Compression, logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not set up compression: %s", err)
+		}
+	}
+
+	return fs, nil
+}
+
+func newCompressedFileEngine(
+	fs storage.File,
+	cfg *compressionConfig,
+	logger logging.Logger,
+) (storage.File, error) {
+	opts := []fileCompressed.FileCompressedOption{
+		fileCompressed.WithLogger(logger),
+	}
+	if cfg.Level != "" {
+		opts = append(opts, fileCompressed.WithLevel(cfg.Level))
+	}
+	if cfg.ChunkSize != 0 {
+		opts = append(opts, fileCompressed.WithChunkSize(cfg.ChunkSize))
+	}
+
+	return fileCompressed.NewFileCompressed(fs, opts...)
+}
+
+func newEncryptedFileEngine(
+	fs storage.File,
+	cfg *encryptionConfig,
+	logger logging.Logger,
+) (storage.File, error) {
+	loader := config.NewOptionLoader(cfg.Keys)
+
+	keys := map[string][]byte{}
+	for _, opt := range cfg.Keys {
+		encoded, err := loader.GetString(opt.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get key %s: %s", opt.Name, err)
+		}
+
+		key, err := fileEncrypted.ParseKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %s", opt.Name, err)
+		}
+		keys[opt.Name] = key
+	}
+
+	return fileEncrypted.NewFileEncrypted(
+		fs,
+		keys,
+		cfg.KeyID,
+		fileEncrypted.WithLogger(logger),
+	)
+}
@@ -5,6 +5,15 @@ import (
 )
 
 var (
-	ErrNotFound      = errors.New("not found")
-	ErrHashCollision = errors.New("hash collision")
+	ErrNotFound        = errors.New("not found")
+	ErrHashCollision   = errors.New("hash collision")
+	ErrInvalidColor    = errors.New("invalid color, expected #rrggbb")
+	ErrInvalidTag      = errors.New("invalid tag name")
+	ErrInvalidField    = errors.New("invalid field")
+	ErrInvalidFilename = errors.New("invalid filename")
+	ErrInvalidSort     = errors.New("invalid sort key")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrInvalidLink     = errors.New("invalid link")
+	ErrNotEncrypted    = errors.New("files aren't encrypted")
+	ErrNoBackup        = errors.New("no backup to repair from is configured")
 )
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) GetFileFields(id string) ([]*records.Field, error) {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.dataStorage.GetFileFields(fileID)
+}
+
+func (c *Client) SetFileFields(id string, fields []*records.Field, removedNames []string) error {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	err = validateFields(fields)
+	if err != nil {
+		return err
+	}
+
+	before, err := c.dataStorage.GetFileFields(fileID)
+	if err != nil {
+		return err
+	}
+
+	err = c.dataStorage.SetFileFields(fileID, fields, removedNames)
+	if err != nil {
+		return err
+	}
+
+	after, err := c.dataStorage.GetFileFields(fileID)
+	if err != nil {
+		return err
+	}
+
+	c.audit(
+		records.AuditActionSetFileFields, fileID,
+		auditFields(before), auditFields(after),
+	)
+
+	return nil
+}
+
+// validateFields returns ErrInvalidField if any of the fields has an
+// invalid name or an unknown type.
+func validateFields(fields []*records.Field) error {
+	for _, field := range fields {
+		if !records.ValidFieldName(field.Name) {
+			return ErrInvalidField
+		}
+
+		switch field.Type {
+		case records.FIELD_TYPE_STRING, records.FIELD_TYPE_NUMBER,
+			records.FIELD_TYPE_DATE, records.FIELD_TYPE_MONEY:
+		default:
+			return ErrInvalidField
+		}
+	}
+
+	return nil
+}
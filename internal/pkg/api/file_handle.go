@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"hash"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -20,6 +21,9 @@ type openFile struct {
 	mode     records.FileMode
 	handleID uuid.UUID
 	fileID   uuid.UUID
+	// actor is who opened the file, changes to the contents are
+	// recorded in the audit log as made by them.
+	actor string
 
 	hasher      hash.Hash
 	writtenSize uint64
@@ -136,6 +140,11 @@ type openFileManager struct {
 	openFilesLock sync.RWMutex
 	openHandleIDs map[uuid.UUID]*openFile
 	openFileIDs   map[uuid.UUID]*openFile
+
+	// gcLock is held for reading while written contents are being
+	// stored, so garbage collection never sees a claimed file before
+	// its metadata and version are added.
+	gcLock sync.RWMutex
 }
 
 func newOpenFileManager(
@@ -161,12 +170,12 @@ func newOpenFileManager(
 	return ofm
 }
 
-func (ofm *openFileManager) OpenFile(id uuid.UUID, mode records.FileMode) (*openFile, error) {
+func (ofm *openFileManager) OpenFile(id uuid.UUID, mode records.FileMode, actor string) (*openFile, error) {
 	switch mode {
 	case records.FILE_MODE_READ:
 		return ofm.openFileRead(id)
 	case records.FILE_MODE_WRITE:
-		return ofm.openFileWrite(id)
+		return ofm.openFileWrite(id, actor)
 	default:
 		return nil, fmt.Errorf("unknown file mode")
 	}
@@ -178,7 +187,24 @@ func (ofm *openFileManager) openFileRead(id uuid.UUID) (*openFile, error) {
 		return nil, err
 	}
 
-	md, err := ofm.dataStorage.FindMetadataByHash(dataFile.Hash)
+	return ofm.openHashRead(id, dataFile.Hash)
+}
+
+// OpenFileVersion opens the contents of an earlier version of the file
+// for reading.
+func (ofm *openFileManager) OpenFileVersion(id uuid.UUID, version int) (*openFile, error) {
+	fileVersion, err := ofm.dataStorage.GetFileVersion(id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return ofm.openHashRead(id, fileVersion.Hash)
+}
+
+// openHashRead opens the contents with the given hash for reading as
+// the file with the given id.
+func (ofm *openFileManager) openHashRead(id uuid.UUID, hash string) (*openFile, error) {
+	md, err := ofm.dataStorage.FindMetadataByHash(hash)
 	if err != nil {
 		ofm.logger.Error("could not find md: %s", err)
 		return nil, err
@@ -217,7 +243,7 @@ func (ofm *openFileManager) openFileRead(id uuid.UUID) (*openFile, error) {
 	return of, nil
 }
 
-func (ofm *openFileManager) openFileWrite(id uuid.UUID) (*openFile, error) {
+func (ofm *openFileManager) openFileWrite(id uuid.UUID, actor string) (*openFile, error) {
 	fsOpenFile, err := ofm.fileStorage.OpenTempFile(id)
 	if err != nil {
 		ofm.logger.Error("could not open file storage: %s", err)
@@ -230,6 +256,7 @@ func (ofm *openFileManager) openFileWrite(id uuid.UUID) (*openFile, error) {
 	}
 
 	of := newOpenFile(handleID, id, records.FILE_MODE_WRITE, fsOpenFile, ofm)
+	of.actor = actor
 
 	ofm.openFilesLock.Lock()
 	_, ok := ofm.openFileIDs[id]
@@ -266,6 +293,8 @@ func (ofm *openFileManager) closeFile(handleID uuid.UUID) error {
 	case records.FILE_MODE_WRITE:
 		// If we're open in write mode we need to handle claiming or
 		// dropping the temporary file.
+		ofm.gcLock.RLock()
+		defer ofm.gcLock.RUnlock()
 
 		claimed := false
 		hash := of.WrittenHash()
@@ -284,10 +313,21 @@ func (ofm *openFileManager) closeFile(handleID uuid.UUID) error {
 			}
 			claimed = true
 
-			err = ofm.dataStorage.CreateMetadataWithID(hash, of.WrittenSize(), id)
+			// Engines that compress files keep them in less space than
+			// they were written with.
+			storedSize := of.WrittenSize()
+			if sizer, ok := of.storageFile.(storage.StoredSizer); ok {
+				storedSize = sizer.StoredSize()
+			}
+
+			err = ofm.dataStorage.CreateMetadataWithStoredSize(
+				hash, of.WrittenSize(), storedSize, id,
+			)
 			if err != nil {
 				return err
 			}
+
+			ofm.indexContents(id, hash, of.fileID)
 		} else if err != nil {
 			return err
 		}
@@ -299,10 +339,29 @@ func (ofm *openFileManager) closeFile(handleID uuid.UUID) error {
 			}
 		}
 
-		err = ofm.dataStorage.UpdateFileHash(of.fileID, of.WrittenHash())
+		dataFile, err := ofm.dataStorage.GetFile(of.fileID)
 		if err != nil {
 			return err
 		}
+
+		// Saving the contents the file already has doesn't change it, so
+		// there's nothing to record.
+		if hash == dataFile.Hash {
+			break
+		}
+
+		// Record the new contents as a version so the earlier contents
+		// can still be restored if the write needs to be undone.
+		_, err = ofm.dataStorage.AddFileVersion(
+			of.fileID,
+			hash,
+			records.VersionSourceWrite,
+		)
+		if err != nil {
+			return err
+		}
+
+		ofm.auditHash(of.actor, of.fileID, dataFile.Hash, hash)
 	}
 
 	ofm.openFilesLock.Lock()
@@ -317,6 +376,53 @@ func (ofm *openFileManager) closeFile(handleID uuid.UUID) error {
 	return nil
 }
 
+// RestoreFileVersion makes the contents of an earlier version the file's
+// current contents by adding them as a new version, so nothing is lost.
+func (ofm *openFileManager) RestoreFileVersion(id uuid.UUID, version int, actor string) (int, error) {
+	// Hold the lock so the file can't be opened for writing while
+	// it's being restored.
+	ofm.openFilesLock.Lock()
+	defer ofm.openFilesLock.Unlock()
+
+	if _, ok := ofm.openFileIDs[id]; ok {
+		return 0, scerrors.ErrAlreadyOpen
+	}
+
+	fileVersion, err := ofm.dataStorage.GetFileVersion(id, version)
+	if err != nil {
+		return 0, err
+	}
+
+	dataFile, err := ofm.dataStorage.GetFile(id)
+	if err != nil {
+		return 0, err
+	}
+
+	newVersion, err := ofm.dataStorage.AddFileVersion(
+		id,
+		fileVersion.Hash,
+		records.VersionSourceRestore,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	ofm.auditHash(actor, id, dataFile.Hash, fileVersion.Hash)
+
+	return newVersion, nil
+}
+
+func (ofm *openFileManager) auditHash(actor string, id uuid.UUID, before string, after string) {
+	addAuditEntry(ofm.dataStorage, ofm.logger, &records.AuditEntry{
+		Created: time.Now(),
+		Actor:   actor,
+		Action:  records.AuditActionUpdateFileHash,
+		FileID:  id,
+		Before:  before,
+		After:   after,
+	})
+}
+
 func (ofm *openFileManager) FileByHandle(handleID uuid.UUID) (*openFile, error) {
 	ofm.openFilesLock.RLock()
 	defer ofm.openFilesLock.RUnlock()
@@ -330,7 +436,12 @@ func (ofm *openFileManager) FileByHandle(handleID uuid.UUID) (*openFile, error)
 }
 
 func (c *Client) OpenFile(fileID uuid.UUID, mode records.FileMode) (records.OpenFile, error) {
-	return c.openManager.OpenFile(fileID, mode)
+	return c.openManager.OpenFile(fileID, mode, c.actor)
+}
+
+// OpenFileVersion opens an earlier version of the file for reading.
+func (c *Client) OpenFileVersion(fileID uuid.UUID, version int) (records.OpenFile, error) {
+	return c.openManager.OpenFileVersion(fileID, version)
 }
 
 func (c *Client) FileByHandle(handleID uuid.UUID) (records.OpenFile, error) {
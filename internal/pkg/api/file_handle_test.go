@@ -0,0 +1,211 @@
+package api
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	dataMemory "github.com/aphistic/softcopy/internal/pkg/storage/data/memory"
+	fileCompressed "github.com/aphistic/softcopy/internal/pkg/storage/file/compressed"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func newTestClient() *Client {
+	return NewClient(fileMemory.NewFileMemory(), dataMemory.NewClient())
+}
+
+func TestOpenFile(t *testing.T) {
+	writeFile := func(t *testing.T, c *Client, filename string, data string) string {
+		id, err := c.CreateFile(filename, time.Now())
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(id, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		return id.String()
+	}
+
+	t.Run("write then read", func(t *testing.T) {
+		c := newTestClient()
+		id := writeFile(t, c, "a.txt", "hello world")
+
+		f, err := c.GetFile(id)
+		require.NoError(t, err)
+		assert.EqualValues(t, 11, f.Size)
+
+		r, err := c.ReadFile(id)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+	})
+	t.Run("same content is stored once", func(t *testing.T) {
+		c := newTestClient()
+		idA := writeFile(t, c, "a.txt", "hello world")
+		idB := writeFile(t, c, "b.txt", "hello world")
+
+		fileA, err := c.GetFile(idA)
+		require.NoError(t, err)
+		fileB, err := c.GetFile(idB)
+		require.NoError(t, err)
+		assert.Equal(t, fileA.Hash, fileB.Hash)
+
+		r, err := c.ReadFile(idB)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+	})
+	t.Run("compressed contents record their stored size", func(t *testing.T) {
+		fc, err := fileCompressed.NewFileCompressed(fileMemory.NewFileMemory())
+		require.NoError(t, err)
+		ds := dataMemory.NewClient()
+		c := NewClient(fc, ds)
+
+		contents := strings.Repeat("hello world ", 1000)
+		id := writeFile(t, c, "a.txt", contents)
+
+		f, err := c.GetFile(id)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(contents), f.Size)
+
+		md, err := ds.FindMetadataByHash(f.Hash)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(contents), md.FileSize)
+		assert.Less(t, md.StoredSize, md.FileSize/10)
+
+		r, err := c.ReadFileFromOffset(id, 6)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, contents[6:], string(data))
+	})
+	t.Run("file already open", func(t *testing.T) {
+		c := newTestClient()
+		id := writeFile(t, c, "a.txt", "hello world")
+		f, err := c.GetFile(id)
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(f.ID, records.FILE_MODE_READ)
+		require.NoError(t, err)
+		defer of.Close()
+
+		_, err = c.OpenFile(f.ID, records.FILE_MODE_READ)
+		assert.Error(t, err)
+	})
+	t.Run("new contents are indexed", func(t *testing.T) {
+		c := newTestClient()
+		id := writeFile(t, c, "a.txt", "property tax statement")
+
+		hits, err := c.SearchFiles("tax")
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, id, hits[0].File.ID.String())
+	})
+	t.Run("writes add versions", func(t *testing.T) {
+		c := newTestClient()
+		id := writeFile(t, c, "a.txt", "first")
+
+		fileID, err := uuid.Parse(id)
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(fileID, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("second"))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		versions, err := c.GetFileVersions(id)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.EqualValues(t, 5, versions[0].Size)
+		assert.EqualValues(t, 6, versions[1].Size)
+		assert.Equal(t, records.VersionSourceWrite, versions[1].Source)
+
+		of, err = c.OpenFileVersion(fileID, 1)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+		assert.Equal(t, "first", string(data))
+	})
+	t.Run("writing the same contents adds no version", func(t *testing.T) {
+		c := newTestClient()
+		id := writeFile(t, c, "a.txt", "first")
+
+		fileID, err := uuid.Parse(id)
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(fileID, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("first"))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		versions, err := c.GetFileVersions(id)
+		require.NoError(t, err)
+		assert.Len(t, versions, 1)
+
+		entries, err := c.GetAuditLog(id, 0)
+		require.NoError(t, err)
+		hashEntries := 0
+		for _, entry := range entries {
+			if entry.Action == records.AuditActionUpdateFileHash {
+				hashEntries++
+			}
+		}
+		assert.Equal(t, 1, hashEntries)
+	})
+	t.Run("restore version", func(t *testing.T) {
+		c := newTestClient()
+		id := writeFile(t, c, "a.txt", "first")
+
+		fileID, err := uuid.Parse(id)
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(fileID, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("second"))
+		require.NoError(t, err)
+
+		_, err = c.RestoreFileVersion(id, 1)
+		assert.Equal(t, scerrors.ErrAlreadyOpen, err)
+		require.NoError(t, of.Close())
+
+		version, err := c.RestoreFileVersion(id, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, version)
+
+		r, err := c.ReadFile(id)
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "first", string(data))
+
+		versions, err := c.GetFileVersions(id)
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, records.VersionSourceRestore, versions[2].Source)
+
+		_, err = c.RestoreFileVersion(id, 4)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+}
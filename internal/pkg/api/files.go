@@ -3,12 +3,12 @@ package api
 import (
 	"fmt"
 	"io"
-	"path"
 	"time"
 
 	"github.com/google/uuid"
 
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
 	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 )
 
@@ -34,6 +34,17 @@ func (c *Client) GetFileDays(year int, month int) ([]int, error) {
 }
 
 func (c *Client) CreateFile(filename string, documentDate time.Time) (uuid.UUID, error) {
+	return c.CreateFileWithSource(filename, documentDate, "", "")
+}
+
+// CreateFileWithSource creates a file recording what added it, such as
+// records.FileSourceFuse or an importer's name, and the path it had there.
+func (c *Client) CreateFileWithSource(
+	filename string,
+	documentDate time.Time,
+	source string,
+	originalPath string,
+) (uuid.UUID, error) {
 	file, err := c.dataStorage.GetFileWithDate(filename, documentDate)
 	if err != nil && err != scerrors.ErrNotFound {
 		return uuid.Nil, err
@@ -41,7 +52,14 @@ func (c *Client) CreateFile(filename string, documentDate time.Time) (uuid.UUID,
 		return uuid.Nil, scerrors.ErrExists
 	}
 
-	return c.dataStorage.CreateFile(filename, documentDate)
+	id, err := c.dataStorage.CreateFileWithSource(filename, documentDate, source, originalPath)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	c.audit(records.AuditActionCreateFile, id, "", auditFile(filename, documentDate))
+
+	return id, nil
 }
 
 func (c *Client) GetFile(id string) (*records.File, error) {
@@ -78,13 +96,7 @@ func (c *Client) ReadFileFromOffset(id string, offset uint64) (io.ReadCloser, er
 		return nil, err
 	}
 
-	filePath := path.Join(
-		md.ID.String()[0:1],
-		md.ID.String()[1:2],
-		md.ID.String()+".dat",
-	)
-
-	return c.fileStorage.ReadFileFromOffset(filePath, offset)
+	return c.fileStorage.ReadFileFromOffset(md.ID, offset)
 }
 
 func (c *Client) RemoveFile(id string) error {
@@ -93,7 +105,22 @@ func (c *Client) RemoveFile(id string) error {
 		return fmt.Errorf("invalid file id")
 	}
 
-	return c.dataStorage.RemoveFile(fileID)
+	file, err := c.dataStorage.GetFile(fileID)
+	if err != nil {
+		return err
+	}
+
+	err = c.dataStorage.RemoveFile(fileID)
+	if err != nil {
+		return err
+	}
+
+	c.audit(
+		records.AuditActionRemoveFile, fileID,
+		auditFile(file.Filename, file.DocumentDate), "",
+	)
+
+	return nil
 }
 
 func (c *Client) UpdateFileTags(
@@ -107,11 +134,15 @@ func (c *Client) UpdateFileTags(
 		return err
 	}
 
+	before := c.auditFileTags(id)
+
 	err = c.dataStorage.UpdateFileTags(id, addedTags, removedTags)
 	if err != nil {
 		return err
 	}
 
+	c.audit(records.AuditActionUpdateFileTags, id, before, c.auditFileTags(id))
+
 	return nil
 }
 func (c *Client) UpdateFileDate(
@@ -120,7 +151,7 @@ func (c *Client) UpdateFileDate(
 	newDate time.Time,
 ) error {
 	// Make sure the current file exists
-	_, err := c.dataStorage.GetFile(id)
+	file, err := c.dataStorage.GetFile(id)
 	if err != nil {
 		return err
 	}
@@ -136,9 +167,65 @@ func (c *Client) UpdateFileDate(
 		return err
 	}
 
+	c.audit(
+		records.AuditActionUpdateFileDate, id,
+		auditFile(file.Filename, file.DocumentDate),
+		auditFile(newFilename, newDate),
+	)
+
 	return nil
 }
 
+// UpdateFile atomically changes the filename, document date, title, notes
+// and fields of a file. The file's Revision must be the revision it was
+// read at, otherwise scerrors.ErrStaleRevision is returned. The updated
+// file is returned.
+func (c *Client) UpdateFile(
+	file *records.File,
+	fields []*records.Field,
+	removedFields []string,
+) (*records.File, error) {
+	if file.Filename == "" {
+		return nil, ErrInvalidFilename
+	}
+
+	err := validateFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := c.dataStorage.GetFile(file.ID)
+	if err != nil {
+		return nil, err
+	}
+	beforeFields, err := c.dataStorage.GetFileFields(file.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.dataStorage.UpdateFile(file, fields, removedFields)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := c.dataStorage.GetFile(file.ID)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := c.dataStorage.GetFileFields(file.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.audit(
+		records.AuditActionUpdateFile, file.ID,
+		auditFileAttributes(before, beforeFields),
+		auditFileAttributes(after, afterFields),
+	)
+
+	return after, nil
+}
+
 func (c *Client) GetFileWithDate(filename string, date time.Time) (*records.File, error) {
 	return c.dataStorage.GetFileWithDate(filename, date)
 }
@@ -151,6 +238,17 @@ func (c *Client) FindFilesWithTags(tagNames []string) ([]*records.File, error) {
 	return c.dataStorage.FindFilesWithTags(tagNames)
 }
 
+// QueryFiles finds files matching a query string. See the query package
+// for the syntax. Invalid queries return a *query.SyntaxError.
+func (c *Client) QueryFiles(q string) ([]*records.File, error) {
+	node, err := query.Parse(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.dataStorage.QueryFiles(node)
+}
+
 func (c *Client) FindFilesWithIdPrefix(idPrefix string) ([]*records.File, error) {
 	return c.dataStorage.FindFilesWithIdPrefix(idPrefix)
 }
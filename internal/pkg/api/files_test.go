@@ -0,0 +1,67 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestUpdateFile(t *testing.T) {
+	docDate := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("updates attributes and fields", func(t *testing.T) {
+		c := newTestClient().WithActor("alice")
+
+		id, err := c.CreateFile("a.pdf", docDate)
+		require.NoError(t, err)
+
+		file, err := c.GetFile(id.String())
+		require.NoError(t, err)
+
+		file.Filename = "b.pdf"
+		file.Title = "Electric bill"
+		file.Notes = "Paid"
+		amount, err := records.ParseField("amount", records.FIELD_TYPE_MONEY, "12.00 USD")
+		require.NoError(t, err)
+
+		updated, err := c.UpdateFile(file, []*records.Field{amount}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "b.pdf", updated.Filename)
+		assert.Equal(t, "Electric bill", updated.Title)
+		assert.Equal(t, "Paid", updated.Notes)
+		assert.Equal(t, file.Revision+1, updated.Revision)
+
+		entries, err := c.GetAuditLog(id.String(), 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, records.AuditActionUpdateFile, entries[0].Action)
+		assert.Equal(t, "alice", entries[0].Actor)
+		assert.Equal(t, `a.pdf 2020-03-04 title="" notes="" fields=[]`, entries[0].Before)
+		assert.Equal(t, `b.pdf 2020-03-04 title="Electric bill" notes="Paid" fields=[amount=12.00 USD]`, entries[0].After)
+
+		_, err = c.UpdateFile(file, nil, nil)
+		assert.Equal(t, scerrors.ErrStaleRevision, err)
+	})
+	t.Run("rejects invalid input", func(t *testing.T) {
+		c := newTestClient()
+
+		id, err := c.CreateFile("a.pdf", docDate)
+		require.NoError(t, err)
+
+		file, err := c.GetFile(id.String())
+		require.NoError(t, err)
+
+		file.Filename = ""
+		_, err = c.UpdateFile(file, nil, nil)
+		assert.Equal(t, ErrInvalidFilename, err)
+
+		file.Filename = "a.pdf"
+		_, err = c.UpdateFile(file, []*records.Field{{Name: "bad name", Type: records.FIELD_TYPE_STRING}}, nil)
+		assert.Equal(t, ErrInvalidField, err)
+	})
+}
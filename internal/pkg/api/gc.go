@@ -0,0 +1,130 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// DefaultTempFileAge is how old a temporary file needs to be before
+// garbage collection considers it abandoned.
+const DefaultTempFileAge = 24 * time.Hour
+
+// GCResult describes what garbage collection found, and removed unless
+// it was a dry run.
+type GCResult struct {
+	DryRun bool
+
+	// Metadata is metadata that isn't used by any file or file version.
+	// The stored contents for the metadata are removed along with it.
+	Metadata []*records.FileMetadata
+	// Blobs are stored contents that don't have any metadata.
+	Blobs []uuid.UUID
+	// TempFiles are files that were opened for writing and abandoned.
+	TempFiles []*records.TempFile
+}
+
+// CollectGarbage finds and removes data that's no longer used: metadata
+// and stored contents for removed files, contents that were stored
+// without metadata, and temporary files older than tempAge that aren't
+// open. If dryRun is set nothing is removed.
+func (c *Client) CollectGarbage(dryRun bool, tempAge time.Duration) (*GCResult, error) {
+	return c.openManager.collectGarbage(dryRun, tempAge)
+}
+
+func (ofm *openFileManager) collectGarbage(dryRun bool, tempAge time.Duration) (*GCResult, error) {
+	// Block writes from being stored while collecting so contents can't
+	// be claimed between listing the metadata and the stored contents.
+	ofm.gcLock.Lock()
+	defer ofm.gcLock.Unlock()
+
+	res := &GCResult{
+		DryRun: dryRun,
+	}
+
+	allMetadata, err := ofm.dataStorage.AllMetadata()
+	if err != nil {
+		return nil, err
+	}
+	metadataIDs := map[uuid.UUID]struct{}{}
+	for _, md := range allMetadata {
+		metadataIDs[md.ID] = struct{}{}
+	}
+
+	res.Metadata, err = ofm.dataStorage.FindOrphanedMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := ofm.fileStorage.ListBlobs()
+	if err != nil {
+		return nil, err
+	}
+	res.Blobs = []uuid.UUID{}
+	for _, blobID := range blobs {
+		if _, ok := metadataIDs[blobID]; !ok {
+			res.Blobs = append(res.Blobs, blobID)
+		}
+	}
+
+	tempFiles, err := ofm.fileStorage.ListTempFiles()
+	if err != nil {
+		return nil, err
+	}
+	res.TempFiles = []*records.TempFile{}
+	cutoff := time.Now().Add(-tempAge)
+	ofm.openFilesLock.RLock()
+	for _, tempFile := range tempFiles {
+		if _, ok := ofm.openFileIDs[tempFile.ID]; ok {
+			continue
+		}
+		if tempFile.Modified.After(cutoff) {
+			continue
+		}
+		res.TempFiles = append(res.TempFiles, tempFile)
+	}
+	ofm.openFilesLock.RUnlock()
+
+	if dryRun {
+		return res, nil
+	}
+
+	for _, md := range res.Metadata {
+		ofm.logger.Debug("removing orphaned metadata %s for %s", md.ID, md.Hash)
+
+		// Remove the metadata first so if removing the contents fails
+		// they'll be found without metadata on the next run.
+		err = ofm.dataStorage.RemoveMetadata(md.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ofm.fileStorage.RemoveBlob(md.ID)
+		if err != nil && err != scerrors.ErrNotFound {
+			return nil, err
+		}
+	}
+
+	for _, blobID := range res.Blobs {
+		ofm.logger.Debug("removing contents %s without metadata", blobID)
+
+		err = ofm.fileStorage.RemoveBlob(blobID)
+		if err != nil && err != scerrors.ErrNotFound {
+			return nil, err
+		}
+	}
+
+	for _, tempFile := range res.TempFiles {
+		ofm.logger.Debug("removing abandoned temp file %s", tempFile.ID)
+
+		err = ofm.fileStorage.RemoveTempFile(tempFile.ID)
+		if err != nil && err != scerrors.ErrNotFound {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	dataMemory "github.com/aphistic/softcopy/internal/pkg/storage/data/memory"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	newGCClient := func() (*Client, *fileMemory.FileMemory) {
+		fm := fileMemory.NewFileMemory()
+		return NewClient(fm, dataMemory.NewClient()), fm
+	}
+	writeFile := func(t *testing.T, c *Client, filename string, data string) uuid.UUID {
+		id, err := c.CreateFile(filename, time.Now())
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(id, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		return id
+	}
+
+	t.Run("removes contents of purged files", func(t *testing.T) {
+		c, fm := newGCClient()
+		kept := writeFile(t, c, "a.txt", "hello")
+		purged := writeFile(t, c, "b.txt", "world")
+		require.NoError(t, c.RemoveFile(purged.String()))
+
+		res, err := c.CollectGarbage(false, DefaultTempFileAge)
+		require.NoError(t, err)
+		assert.Len(t, res.Metadata, 0)
+
+		require.NoError(t, c.PurgeFile(purged.String()))
+
+		res, err = c.CollectGarbage(true, DefaultTempFileAge)
+		require.NoError(t, err)
+		assert.True(t, res.DryRun)
+		require.Len(t, res.Metadata, 1)
+		blobs, err := fm.ListBlobs()
+		require.NoError(t, err)
+		assert.Len(t, blobs, 2)
+
+		res, err = c.CollectGarbage(false, DefaultTempFileAge)
+		require.NoError(t, err)
+		assert.False(t, res.DryRun)
+		require.Len(t, res.Metadata, 1)
+		assert.EqualValues(t, 5, res.Metadata[0].FileSize)
+
+		blobs, err = fm.ListBlobs()
+		require.NoError(t, err)
+		assert.Len(t, blobs, 1)
+
+		of, err := c.OpenFile(kept, records.FILE_MODE_READ)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+		assert.Equal(t, "hello", string(data))
+
+		// The same contents can be written again once collected.
+		writeFile(t, c, "c.txt", "world")
+	})
+	t.Run("removes contents without metadata", func(t *testing.T) {
+		c, fm := newGCClient()
+		writeFile(t, c, "a.txt", "hello")
+
+		orphanID := uuid.New()
+		of, err := fm.OpenTempFile(uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, of.Claim(orphanID))
+
+		res, err := c.CollectGarbage(false, DefaultTempFileAge)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{orphanID}, res.Blobs)
+
+		_, err = fm.OpenFile(orphanID)
+		assert.Error(t, err)
+		assert.Equal(t, scerrors.ErrNotFound, fm.RemoveBlob(orphanID))
+	})
+	t.Run("removes abandoned temp files", func(t *testing.T) {
+		c, fm := newGCClient()
+
+		abandonedID := uuid.New()
+		_, err := fm.OpenTempFile(abandonedID)
+		require.NoError(t, err)
+
+		openID, err := c.CreateFile("a.txt", time.Now())
+		require.NoError(t, err)
+		of, err := c.OpenFile(openID, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		defer of.Close()
+
+		res, err := c.CollectGarbage(false, DefaultTempFileAge)
+		require.NoError(t, err)
+		assert.Len(t, res.TempFiles, 0)
+
+		res, err = c.CollectGarbage(false, 0)
+		require.NoError(t, err)
+		require.Len(t, res.TempFiles, 1)
+		assert.Equal(t, abandonedID, res.TempFiles[0].ID)
+
+		temps, err := fm.ListTempFiles()
+		require.NoError(t, err)
+		require.Len(t, temps, 1)
+		assert.Equal(t, openID, temps[0].ID)
+	})
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// LinkFiles links the source file to the target file, such as a receipt
+// being an attachment-of an invoice. It returns ErrInvalidLink for
+// unknown link types or links from a file to itself.
+func (c *Client) LinkFiles(sourceID string, targetID string, linkType records.LinkType) error {
+	source, target, err := parseLink(sourceID, targetID, linkType)
+	if err != nil {
+		return err
+	}
+
+	err = c.dataStorage.CreateFileLink(source, target, linkType)
+	if err != nil {
+		return err
+	}
+
+	c.audit(records.AuditActionLinkFiles, source, "", auditLink(linkType, target))
+
+	return nil
+}
+
+func (c *Client) UnlinkFiles(sourceID string, targetID string, linkType records.LinkType) error {
+	source, target, err := parseLink(sourceID, targetID, linkType)
+	if err != nil {
+		return err
+	}
+
+	err = c.dataStorage.RemoveFileLink(source, target, linkType)
+	if err != nil {
+		return err
+	}
+
+	c.audit(records.AuditActionUnlinkFiles, source, auditLink(linkType, target), "")
+
+	return nil
+}
+
+func (c *Client) GetFileLinks(id string) ([]*records.FileLink, error) {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.dataStorage.GetFileLinks(fileID)
+}
+
+// parseLink parses the ids of a link's files and checks the link is
+// valid.
+func parseLink(sourceID string, targetID string, linkType records.LinkType) (uuid.UUID, uuid.UUID, error) {
+	source, err := uuid.Parse(sourceID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	target, err := uuid.Parse(targetID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	if source == target {
+		return uuid.Nil, uuid.Nil, ErrInvalidLink
+	}
+
+	switch linkType {
+	case records.LINK_TYPE_ATTACHMENT_OF, records.LINK_TYPE_SUPERSEDES,
+		records.LINK_TYPE_RELATED_TO:
+	default:
+		return uuid.Nil, uuid.Nil, ErrInvalidLink
+	}
+
+	return source, target, nil
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestLinkFiles(t *testing.T) {
+	docDate := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("links and unlinks files", func(t *testing.T) {
+		c := newTestClient().WithActor("alice")
+
+		invoiceID, err := c.CreateFile("invoice.pdf", docDate)
+		require.NoError(t, err)
+		receiptID, err := c.CreateFile("receipt.pdf", docDate)
+		require.NoError(t, err)
+
+		err = c.LinkFiles(receiptID.String(), invoiceID.String(), records.LINK_TYPE_ATTACHMENT_OF)
+		require.NoError(t, err)
+
+		links, err := c.GetFileLinks(invoiceID.String())
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, receiptID, links[0].SourceID)
+
+		entries, err := c.GetAuditLog(receiptID.String(), 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, records.AuditActionLinkFiles, entries[0].Action)
+		assert.Equal(t, "alice", entries[0].Actor)
+		assert.Equal(t, "attachment-of "+invoiceID.String(), entries[0].After)
+
+		err = c.UnlinkFiles(receiptID.String(), invoiceID.String(), records.LINK_TYPE_ATTACHMENT_OF)
+		require.NoError(t, err)
+
+		links, err = c.GetFileLinks(invoiceID.String())
+		require.NoError(t, err)
+		assert.Len(t, links, 0)
+
+		entries, err = c.GetAuditLog(receiptID.String(), 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, records.AuditActionUnlinkFiles, entries[0].Action)
+		assert.Equal(t, "attachment-of "+invoiceID.String(), entries[0].Before)
+	})
+	t.Run("rejects invalid links", func(t *testing.T) {
+		c := newTestClient()
+
+		invoiceID, err := c.CreateFile("invoice.pdf", docDate)
+		require.NoError(t, err)
+		receiptID, err := c.CreateFile("receipt.pdf", docDate)
+		require.NoError(t, err)
+
+		err = c.LinkFiles(invoiceID.String(), invoiceID.String(), records.LINK_TYPE_RELATED_TO)
+		assert.Equal(t, ErrInvalidLink, err)
+
+		err = c.LinkFiles(receiptID.String(), invoiceID.String(), records.LINK_TYPE_UNKNOWN)
+		assert.Equal(t, ErrInvalidLink, err)
+	})
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const (
+	// DefaultListLimit is the number of files listed per page when no
+	// limit is given.
+	DefaultListLimit = 50
+	// MaxListLimit is the most files that can be listed in one page.
+	MaxListLimit = 1000
+)
+
+// ListFilesOptions describe a page of files to list.
+type ListFilesOptions struct {
+	// Query limits the files to those matching it, see the query package
+	// for the syntax. An empty query lists all files.
+	Query      string
+	Sort       records.FileSort
+	Descending bool
+	// Cursor is the NextCursor of the previous page, or empty for the
+	// first page.
+	Cursor string
+	// Limit is the most files to return. It defaults to DefaultListLimit
+	// and is capped at MaxListLimit.
+	Limit int
+}
+
+// FileList is a page of listed files.
+type FileList struct {
+	Files []*records.File
+	// Total is the number of files matching the query across all pages.
+	Total int
+	// NextCursor is used to get the next page, it's empty on the last page.
+	NextCursor string
+}
+
+// fileCursor is the position after the last file of a page. It's encoded
+// into an opaque string for clients. The query, sort and direction are kept
+// so a cursor can't be used with a different listing.
+type fileCursor struct {
+	Query      string           `json:"q,omitempty"`
+	Sort       records.FileSort `json:"s"`
+	Descending bool             `json:"d,omitempty"`
+
+	ID           uuid.UUID `json:"id"`
+	Filename     string    `json:"fn,omitempty"`
+	DocumentDate time.Time `json:"dd"`
+	Size         uint64    `json:"sz,omitempty"`
+	Created      time.Time `json:"cr"`
+}
+
+func encodeFileCursor(opts *ListFilesOptions, file *records.File) (string, error) {
+	data, err := json.Marshal(&fileCursor{
+		Query:        opts.Query,
+		Sort:         opts.Sort,
+		Descending:   opts.Descending,
+		ID:           file.ID,
+		Filename:     file.Filename,
+		DocumentDate: file.DocumentDate,
+		Size:         file.Size,
+		Created:      file.Created,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeFileCursor returns the last file of the previous page from the
+// cursor, with only the values used for paging set.
+func decodeFileCursor(opts *ListFilesOptions) (*records.File, error) {
+	data, err := base64.RawURLEncoding.DecodeString(opts.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := &fileCursor{}
+	err = json.Unmarshal(data, cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.Query != opts.Query ||
+		cursor.Sort != opts.Sort ||
+		cursor.Descending != opts.Descending {
+		return nil, ErrInvalidCursor
+	}
+
+	return &records.File{
+		ID:           cursor.ID,
+		Filename:     cursor.Filename,
+		DocumentDate: cursor.DocumentDate,
+		Size:         cursor.Size,
+		Created:      cursor.Created,
+	}, nil
+}
+
+// ListFiles returns a sorted page of files. Invalid queries return a
+// *query.SyntaxError and cursors from a different listing return
+// ErrInvalidCursor.
+func (c *Client) ListFiles(opts *ListFilesOptions) (*FileList, error) {
+	switch opts.Sort {
+	case records.FILE_SORT_DOCUMENT_DATE, records.FILE_SORT_FILENAME,
+		records.FILE_SORT_SIZE, records.FILE_SORT_CREATED:
+	default:
+		return nil, ErrInvalidSort
+	}
+
+	dataOpts := &storage.ListFilesOptions{
+		Sort:       opts.Sort,
+		Descending: opts.Descending,
+		Limit:      opts.Limit,
+	}
+	if dataOpts.Limit < 1 {
+		dataOpts.Limit = DefaultListLimit
+	} else if dataOpts.Limit > MaxListLimit {
+		dataOpts.Limit = MaxListLimit
+	}
+
+	if strings.TrimSpace(opts.Query) != "" {
+		node, err := query.Parse(opts.Query)
+		if err != nil {
+			return nil, err
+		}
+		dataOpts.Query = node
+	}
+
+	if opts.Cursor != "" {
+		after, err := decodeFileCursor(opts)
+		if err != nil {
+			return nil, err
+		}
+		dataOpts.After = after
+	}
+
+	page, err := c.dataStorage.ListFiles(dataOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &FileList{
+		Files: page.Files,
+		Total: page.Total,
+	}
+	if page.More && len(page.Files) > 0 {
+		res.NextCursor, err = encodeFileCursor(opts, page.Files[len(page.Files)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func TestListFiles(t *testing.T) {
+	docDate := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	newListClient := func(t *testing.T) *Client {
+		c := newTestClient()
+		for idx, name := range []string{"c.pdf", "a.pdf", "b.pdf"} {
+			_, err := c.CreateFile(name, docDate.AddDate(0, 0, idx))
+			require.NoError(t, err)
+		}
+		return c
+	}
+
+	t.Run("pages with cursors", func(t *testing.T) {
+		c := newListClient(t)
+
+		opts := &ListFilesOptions{
+			Sort:  records.FILE_SORT_FILENAME,
+			Limit: 2,
+		}
+		list, err := c.ListFiles(opts)
+		require.NoError(t, err)
+		assert.Equal(t, 3, list.Total)
+		require.Len(t, list.Files, 2)
+		assert.Equal(t, "a.pdf", list.Files[0].Filename)
+		assert.Equal(t, "b.pdf", list.Files[1].Filename)
+		require.NotEmpty(t, list.NextCursor)
+
+		opts.Cursor = list.NextCursor
+		list, err = c.ListFiles(opts)
+		require.NoError(t, err)
+		assert.Equal(t, 3, list.Total)
+		require.Len(t, list.Files, 1)
+		assert.Equal(t, "c.pdf", list.Files[0].Filename)
+		assert.Empty(t, list.NextCursor)
+	})
+	t.Run("filters with query", func(t *testing.T) {
+		c := newListClient(t)
+
+		list, err := c.ListFiles(&ListFilesOptions{
+			Query:      "date:2020-03-05..2020-03-06",
+			Sort:       records.FILE_SORT_DOCUMENT_DATE,
+			Descending: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, list.Total)
+		require.Len(t, list.Files, 2)
+		assert.Equal(t, "b.pdf", list.Files[0].Filename)
+		assert.Equal(t, "a.pdf", list.Files[1].Filename)
+	})
+	t.Run("rejects invalid options", func(t *testing.T) {
+		c := newListClient(t)
+
+		_, err := c.ListFiles(&ListFilesOptions{
+			Sort: records.FileSort(99),
+		})
+		assert.Equal(t, ErrInvalidSort, err)
+
+		_, err = c.ListFiles(&ListFilesOptions{
+			Cursor: "not a cursor",
+		})
+		assert.Equal(t, ErrInvalidCursor, err)
+
+		list, err := c.ListFiles(&ListFilesOptions{
+			Sort:  records.FILE_SORT_FILENAME,
+			Limit: 1,
+		})
+		require.NoError(t, err)
+
+		_, err = c.ListFiles(&ListFilesOptions{
+			Sort:       records.FILE_SORT_FILENAME,
+			Descending: true,
+			Cursor:     list.NextCursor,
+		})
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/backup"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// ScrubResult describes what checking the stored contents against their
+// hashes found.
+type ScrubResult struct {
+	Repair bool
+
+	// Checked is the number of stored contents that were checked.
+	Checked int
+	// Problems are the stored contents that were corrupt or missing, with
+	// the status they were left in after trying to repair them.
+	Problems []*records.FileMetadata
+}
+
+// ScrubSummary is what the latest checks of the stored contents found.
+type ScrubSummary struct {
+	// Statuses are the number of stored contents by the status of their
+	// latest check, which is SCRUB_STATUS_UNKNOWN if they haven't been
+	// checked yet.
+	Statuses map[records.ScrubStatus]int
+	// Problems are the stored contents whose latest check found them
+	// corrupt or missing, or that were repaired.
+	Problems []*records.FileMetadata
+}
+
+// ScrubFiles reads the contents of every stored file and compares them to
+// their hash, recording what it found on their metadata. If repair is set,
+// corrupt or missing contents are restored from the backup set with
+// WithScrubBackup. It returns ErrNoBackup if repair is set without one.
+func (c *Client) ScrubFiles(repair bool) (*ScrubResult, error) {
+	var b *backup.Backup
+	if repair {
+		if c.scrubBackupPath == "" {
+			return nil, ErrNoBackup
+		}
+
+		var err error
+		b, err = backup.LoadBackup(c.scrubBackupPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not load backup: %s", err)
+		}
+	}
+
+	return c.openManager.scrubFiles(b)
+}
+
+// GetScrubResults summarizes what the latest checks of the stored contents
+// found.
+func (c *Client) GetScrubResults() (*ScrubSummary, error) {
+	allMetadata, err := c.dataStorage.AllMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ScrubSummary{
+		Statuses: map[records.ScrubStatus]int{},
+		Problems: []*records.FileMetadata{},
+	}
+	for _, md := range allMetadata {
+		res.Statuses[md.ScrubStatus]++
+
+		if md.ScrubStatus.Damaged() || md.ScrubStatus == records.SCRUB_STATUS_REPAIRED {
+			res.Problems = append(res.Problems, md)
+		}
+	}
+
+	return res, nil
+}
+
+func (ofm *openFileManager) scrubFiles(b *backup.Backup) (*ScrubResult, error) {
+	res := &ScrubResult{
+		Repair:   b != nil,
+		Problems: []*records.FileMetadata{},
+	}
+
+	// List the metadata first, its contents are always stored before
+	// it's added so they'll be in the list of stored contents.
+	allMetadata, err := ofm.dataStorage.AllMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := ofm.fileStorage.ListBlobs()
+	if err != nil {
+		return nil, err
+	}
+	stored := map[uuid.UUID]struct{}{}
+	for _, blobID := range blobs {
+		stored[blobID] = struct{}{}
+	}
+
+	for _, md := range allMetadata {
+		_, ok := stored[md.ID]
+
+		scrubbed, err := ofm.scrubMetadata(md, ok, b)
+		if err == scerrors.ErrNotFound {
+			// Removed by garbage collection since it was listed
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		res.Checked++
+		if scrubbed.ScrubStatus != records.SCRUB_STATUS_OK {
+			res.Problems = append(res.Problems, scrubbed)
+		}
+	}
+
+	return res, nil
+}
+
+// scrubMetadata checks the contents with the metadata, repairing them
+// from the backup if they're damaged and there is one, and records the
+// result. It returns errors.ErrNotFound if the metadata no longer exists.
+func (ofm *openFileManager) scrubMetadata(
+	md *records.FileMetadata,
+	stored bool,
+	b *backup.Backup,
+) (*records.FileMetadata, error) {
+	// Hold the lock like writes do so garbage collection doesn't remove
+	// the contents while they're checked. It's only held for one file at
+	// a time so writes aren't blocked behind garbage collection for long.
+	ofm.gcLock.RLock()
+	defer ofm.gcLock.RUnlock()
+
+	md, err := ofm.dataStorage.FindMetadataByHash(md.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	md.ScrubStatus = records.SCRUB_STATUS_MISSING
+	if stored {
+		md.ScrubStatus = ofm.checkContents(md)
+	}
+
+	if md.ScrubStatus.Damaged() && b != nil {
+		err = ofm.repairContents(md, b)
+		if err == nil {
+			ofm.logger.Info("repaired contents %s from the backup", md.ID)
+			md.ScrubStatus = records.SCRUB_STATUS_REPAIRED
+		} else if err == scerrors.ErrNotFound {
+			ofm.logger.Error("could not repair contents %s, they aren't in the backup", md.ID)
+		} else {
+			ofm.logger.Error("could not repair contents %s: %s", md.ID, err)
+		}
+	}
+
+	md.Scrubbed = time.Now()
+	err = ofm.dataStorage.SetMetadataScrubResult(md.ID, md.ScrubStatus, md.Scrubbed)
+	if err != nil {
+		return nil, err
+	}
+
+	return md, nil
+}
+
+// checkContents reads the stored contents with the metadata and compares
+// them to its hash. Contents that can't be read, such as when they can't
+// be decrypted, are corrupt.
+func (ofm *openFileManager) checkContents(md *records.FileMetadata) records.ScrubStatus {
+	of, err := ofm.fileStorage.OpenFile(md.ID)
+	if err != nil {
+		ofm.logger.Error("could not open contents %s: %s", md.ID, err)
+		return records.SCRUB_STATUS_CORRUPT
+	}
+	defer of.Close()
+
+	hasher := sha256.New()
+	_, err = io.Copy(hasher, of)
+	if err != nil {
+		ofm.logger.Error("could not read contents %s: %s", md.ID, err)
+		return records.SCRUB_STATUS_CORRUPT
+	}
+
+	hash := fmt.Sprintf("%x", hasher.Sum(nil))
+	if hash != md.Hash {
+		ofm.logger.Error("contents %s have hash %s instead of %s", md.ID, hash, md.Hash)
+		return records.SCRUB_STATUS_CORRUPT
+	}
+
+	return records.SCRUB_STATUS_OK
+}
+
+// repairContents stores the contents with the metadata's hash from the
+// backup again. The backed up contents are checked against the hash
+// before they replace the damaged contents.
+func (ofm *openFileManager) repairContents(md *records.FileMetadata, b *backup.Backup) error {
+	r, err := b.FindData(md.Hash)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	handleID, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	of, err := ofm.fileStorage.OpenTempFile(handleID)
+	if err != nil {
+		return err
+	}
+
+	hasher := sha256.New()
+	_, err = io.Copy(io.MultiWriter(of, hasher), r)
+	if err != nil {
+		of.Drop()
+		return err
+	}
+
+	hash := fmt.Sprintf("%x", hasher.Sum(nil))
+	if hash != md.Hash {
+		of.Drop()
+		return fmt.Errorf("backed up contents have hash %s instead of %s", hash, md.Hash)
+	}
+
+	return of.Claim(md.ID)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
+	"github.com/aphistic/softcopy/internal/pkg/storage/backup"
+	dataMemory "github.com/aphistic/softcopy/internal/pkg/storage/data/memory"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func TestScrubFiles(t *testing.T) {
+	writeFile := func(t *testing.T, c *Client, filename string, data string) *records.File {
+		id, err := c.CreateFile(filename, time.Now())
+		require.NoError(t, err)
+
+		of, err := c.OpenFile(id, records.FILE_MODE_WRITE)
+		require.NoError(t, err)
+		_, err = of.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		f, err := c.GetFile(id.String())
+		require.NoError(t, err)
+
+		return f
+	}
+	metadataID := func(t *testing.T, c *Client, f *records.File) uuid.UUID {
+		md, err := c.dataStorage.FindMetadataByHash(f.Hash)
+		require.NoError(t, err)
+
+		return md.ID
+	}
+	// damage replaces stored contents without going through the client
+	damage := func(t *testing.T, fm *fileMemory.FileMemory, id uuid.UUID, data string) {
+		of, err := fm.OpenTempFile(uuid.New())
+		require.NoError(t, err)
+		_, err = of.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, of.Claim(id))
+	}
+	readFile := func(t *testing.T, c *Client, f *records.File) string {
+		r, err := c.ReadFile(f.ID.String())
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		return string(data)
+	}
+
+	t.Run("records results", func(t *testing.T) {
+		fm := fileMemory.NewFileMemory()
+		c := NewClient(fm, dataMemory.NewClient())
+
+		writeFile(t, c, "a.txt", "hello")
+		corrupt := writeFile(t, c, "b.txt", "world")
+		missing := writeFile(t, c, "c.txt", "again")
+
+		summary, err := c.GetScrubResults()
+		require.NoError(t, err)
+		assert.Equal(t, 3, summary.Statuses[records.SCRUB_STATUS_UNKNOWN])
+		assert.Len(t, summary.Problems, 0)
+
+		damage(t, fm, metadataID(t, c, corrupt), "w0rld")
+		require.NoError(t, fm.RemoveBlob(metadataID(t, c, missing)))
+
+		res, err := c.ScrubFiles(false)
+		require.NoError(t, err)
+		assert.False(t, res.Repair)
+		assert.Equal(t, 3, res.Checked)
+		require.Len(t, res.Problems, 2)
+		statuses := map[string]records.ScrubStatus{}
+		for _, md := range res.Problems {
+			statuses[md.Hash] = md.ScrubStatus
+			assert.False(t, md.Scrubbed.IsZero())
+		}
+		assert.Equal(t, map[string]records.ScrubStatus{
+			corrupt.Hash: records.SCRUB_STATUS_CORRUPT,
+			missing.Hash: records.SCRUB_STATUS_MISSING,
+		}, statuses)
+
+		summary, err = c.GetScrubResults()
+		require.NoError(t, err)
+		assert.Equal(t, map[records.ScrubStatus]int{
+			records.SCRUB_STATUS_OK:      1,
+			records.SCRUB_STATUS_CORRUPT: 1,
+			records.SCRUB_STATUS_MISSING: 1,
+		}, summary.Statuses)
+		assert.Len(t, summary.Problems, 2)
+	})
+	t.Run("repair needs a backup", func(t *testing.T) {
+		c := newTestClient()
+
+		_, err := c.ScrubFiles(true)
+		assert.Equal(t, ErrNoBackup, err)
+	})
+	t.Run("repairs from a backup", func(t *testing.T) {
+		backupPath := path.Join(t.TempDir(), "backup")
+		fm := fileMemory.NewFileMemory()
+		c := NewClient(fm, dataMemory.NewClient(), WithScrubBackup(backupPath))
+
+		corrupt := writeFile(t, c, "a.txt", "hello")
+		missing := writeFile(t, c, "b.txt", "world")
+		notBackedUp := writeFile(t, c, "c.txt", "again")
+
+		b, err := backup.CreateBackup(backupPath)
+		require.NoError(t, err)
+		for _, f := range []*records.File{corrupt, missing} {
+			protoFile, err := protoutil.FileToProto(f)
+			require.NoError(t, err)
+			require.NoError(t, b.WriteFile(&scproto.TaggedFile{File: protoFile}))
+			require.NoError(t, b.WriteData(f.ID.String(), strings.NewReader(readFile(t, c, f))))
+		}
+
+		damage(t, fm, metadataID(t, c, corrupt), "h3llo")
+		require.NoError(t, fm.RemoveBlob(metadataID(t, c, missing)))
+		require.NoError(t, fm.RemoveBlob(metadataID(t, c, notBackedUp)))
+
+		res, err := c.ScrubFiles(true)
+		require.NoError(t, err)
+		assert.True(t, res.Repair)
+		require.Len(t, res.Problems, 3)
+		statuses := map[string]records.ScrubStatus{}
+		for _, md := range res.Problems {
+			statuses[md.Hash] = md.ScrubStatus
+		}
+		assert.Equal(t, map[string]records.ScrubStatus{
+			corrupt.Hash:     records.SCRUB_STATUS_REPAIRED,
+			missing.Hash:     records.SCRUB_STATUS_REPAIRED,
+			notBackedUp.Hash: records.SCRUB_STATUS_MISSING,
+		}, statuses)
+
+		assert.Equal(t, "hello", readFile(t, c, corrupt))
+		assert.Equal(t, "world", readFile(t, c, missing))
+
+		res, err = c.ScrubFiles(false)
+		require.NoError(t, err)
+		require.Len(t, res.Problems, 1)
+		assert.Equal(t, notBackedUp.Hash, res.Problems[0].Hash)
+	})
+}
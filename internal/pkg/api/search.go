@@ -0,0 +1,51 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/extract"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) SearchFiles(query string) ([]*records.SearchHit, error) {
+	return c.dataStorage.SearchFiles(query)
+}
+
+// indexContents extracts the text of a newly stored blob and stores it
+// for searching. Failures are only logged since the file itself has
+// already been stored.
+func (ofm *openFileManager) indexContents(blobID uuid.UUID, hash string, fileID uuid.UUID) {
+	err := ofm.extractContents(blobID, hash, fileID)
+	if err == extract.ErrUnsupported {
+		ofm.logger.Debug("not indexing contents of %s: %s", fileID, err)
+	} else if err != nil {
+		ofm.logger.Error("could not index contents of %s: %s", fileID, err)
+	}
+}
+
+func (ofm *openFileManager) extractContents(blobID uuid.UUID, hash string, fileID uuid.UUID) error {
+	file, err := ofm.dataStorage.GetFile(fileID)
+	if err != nil {
+		return err
+	}
+
+	f, err := ofm.fileStorage.OpenFile(blobID)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	text, err := extract.Extract(file.Filename, f)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(text) == "" {
+		// Nothing to index, most likely a scan without a text layer
+		return nil
+	}
+
+	return ofm.dataStorage.SetFileContents(hash, text)
+}
@@ -0,0 +1,10 @@
+package api
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// GetStats counts the files, tags and stored contents.
+func (c *Client) GetStats() (*records.Stats, error) {
+	return c.dataStorage.GetStats()
+}
@@ -33,11 +33,19 @@ func (c *Client) FindTagByName(name string) (*records.Tag, error) {
 }
 
 func (c *Client) CreateTags(names []string) ([]*records.Tag, error) {
+	for _, name := range names {
+		if !records.ValidTagName(name) {
+			return nil, ErrInvalidTag
+		}
+	}
+
 	ids, err := c.dataStorage.CreateTags(names)
 	if err != nil {
 		return nil, err
 	}
 
+	c.audit(records.AuditActionCreateTags, uuid.Nil, "", auditNames(names))
+
 	var res []*records.Tag
 	for idx, id := range ids {
 		name := names[idx]
@@ -49,3 +57,40 @@ func (c *Client) CreateTags(names []string) ([]*records.Tag, error) {
 
 	return res, nil
 }
+
+func (c *Client) RenameTag(oldName string, newName string) error {
+	if !records.ValidTagName(newName) {
+		return ErrInvalidTag
+	}
+
+	err := c.dataStorage.RenameTag(oldName, newName)
+	if err != nil {
+		return err
+	}
+
+	c.audit(records.AuditActionRenameTag, uuid.Nil, oldName, newName)
+
+	return nil
+}
+
+func (c *Client) MergeTags(sourceName string, targetName string) error {
+	err := c.dataStorage.MergeTags(sourceName, targetName)
+	if err != nil {
+		return err
+	}
+
+	c.audit(records.AuditActionMergeTags, uuid.Nil, sourceName, targetName)
+
+	return nil
+}
+
+func (c *Client) DeleteTag(name string) error {
+	err := c.dataStorage.DeleteTag(name)
+	if err != nil {
+		return err
+	}
+
+	c.audit(records.AuditActionDeleteTag, uuid.Nil, name, "")
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) ListTrash() ([]*records.TrashedFile, error) {
+	return c.dataStorage.ListTrash()
+}
+
+// RestoreFile moves a removed file out of the trash.
+func (c *Client) RestoreFile(id string) error {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	err = c.dataStorage.RestoreFile(fileID)
+	if err != nil {
+		return err
+	}
+
+	file, err := c.dataStorage.GetFile(fileID)
+	if err != nil {
+		return err
+	}
+
+	c.audit(
+		records.AuditActionRestoreFile, fileID,
+		"", auditFile(file.Filename, file.DocumentDate),
+	)
+
+	return nil
+}
+
+// PurgeFile permanently removes a file that's in the trash.
+func (c *Client) PurgeFile(id string) error {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	trash, err := c.dataStorage.ListTrash()
+	if err != nil {
+		return err
+	}
+
+	for _, trashed := range trash {
+		if trashed.File.ID == fileID {
+			return c.purgeFile(trashed)
+		}
+	}
+
+	return scerrors.ErrNotFound
+}
+
+func (c *Client) purgeFile(trashed *records.TrashedFile) error {
+	err := c.dataStorage.PurgeFile(trashed.File.ID)
+	if err != nil {
+		return err
+	}
+
+	c.audit(
+		records.AuditActionPurgeFile, trashed.File.ID,
+		auditFile(trashed.File.Filename, trashed.File.DocumentDate), "",
+	)
+
+	return nil
+}
+
+// PurgeTrash permanently removes files that were moved to the trash
+// before the given time, returning how many were purged.
+func (c *Client) PurgeTrash(before time.Time) (int, error) {
+	trash, err := c.dataStorage.ListTrash()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, trashed := range trash {
+		if !trashed.Deleted.Before(before) {
+			continue
+		}
+
+		err := c.purgeFile(trashed)
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
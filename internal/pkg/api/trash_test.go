@@ -0,0 +1,35 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	t.Run("purges files removed before", func(t *testing.T) {
+		c := newTestClient()
+
+		id, err := c.CreateFile("a.txt", time.Now())
+		require.NoError(t, err)
+		require.NoError(t, c.RemoveFile(id.String()))
+
+		purged, err := c.PurgeTrash(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+
+		trash, err := c.ListTrash()
+		require.NoError(t, err)
+		assert.Len(t, trash, 1)
+
+		purged, err = c.PurgeTrash(time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		trash, err = c.ListTrash()
+		require.NoError(t, err)
+		assert.Len(t, trash, 0)
+	})
+}
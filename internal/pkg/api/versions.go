@@ -0,0 +1,27 @@
+package api
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) GetFileVersions(id string) ([]*records.FileVersion, error) {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.dataStorage.GetFileVersions(fileID)
+}
+
+// RestoreFileVersion restores the contents of an earlier version of the
+// file, returning the number of the new version that was created.
+func (c *Client) RestoreFileVersion(id string, version int) (int, error) {
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.openManager.RestoreFileVersion(fileID, version, c.actor)
+}
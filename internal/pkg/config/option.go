@@ -102,6 +102,35 @@ func (ol *OptionLoader) GetIntOrDefault(name string, defaultVal int) (int, error
 	return val, err
 }
 
+func (ol *OptionLoader) GetBool(name string) (bool, error) {
+	val, err := ol.getValue(name)
+	if err != nil {
+		return false, err
+	}
+
+	switch v := val.(type) {
+	case string:
+		val, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, err
+		}
+		return val, nil
+	default:
+		return false, fmt.Errorf("unhandled type: %s", reflect.TypeOf(val))
+	}
+}
+
+func (ol *OptionLoader) GetBoolOrDefault(name string, defaultVal bool) (bool, error) {
+	val, err := ol.GetBool(name)
+	if err == scerrors.ErrNotFound {
+		return defaultVal, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return val, err
+}
+
 type Options []*Option
 
 func (o Options) Option(name string) (*Option, bool) {
@@ -9,4 +9,6 @@ var (
 
 	ErrInvalidModeAction = fmt.Errorf("invalid mode action")
 	ErrNotPermitted      = fmt.Errorf("not permitted")
+	ErrInUse             = fmt.Errorf("in use")
+	ErrStaleRevision     = fmt.Errorf("stale revision")
 )
@@ -0,0 +1,141 @@
+// Package extract pulls the plain text out of stored documents so their
+// contents can be indexed for searching, and the images out of scanned
+// documents so they can be compared.
+package extract
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// MaxTextSize is the most text that will be extracted from a single
+// document. Anything past it is dropped.
+const MaxTextSize = 4 << 20
+
+var ErrUnsupported = fmt.Errorf("unsupported file type")
+
+type extractor func(r io.ReaderAt, size int64) (string, error)
+
+var extractors = map[string]extractor{
+	"text/plain":      extractText,
+	"application/pdf": extractPDF,
+
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   extractDocx,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         extractXlsx,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": extractPptx,
+	"application/vnd.oasis.opendocument.text":                                   extractOpenDocument,
+	"application/vnd.oasis.opendocument.spreadsheet":                            extractOpenDocument,
+	"application/vnd.oasis.opendocument.presentation":                           extractOpenDocument,
+}
+
+var extensionTypes = map[string]string{
+	".txt":  "text/plain",
+	".text": "text/plain",
+	".md":   "text/plain",
+	".csv":  "text/plain",
+	".log":  "text/plain",
+	".pdf":  "application/pdf",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
+}
+
+// zipTypes identifies office documents, which are all zip files, by an
+// entry that's only present in that type of document.
+var zipTypes = map[string]string{
+	"word/document.xml":    "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"xl/workbook.xml":      "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"ppt/presentation.xml": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+// DetectType returns the mime type of a document, using the file extension
+// if it's known and falling back to the contents of the file.
+func DetectType(filename string, r io.ReaderAt, size int64) string {
+	if mimeType, ok := extensionTypes[strings.ToLower(path.Ext(filename))]; ok {
+		return mimeType
+	}
+
+	head := make([]byte, 512)
+	n, _ := r.ReadAt(head, 0)
+	mimeType := http.DetectContentType(head[:n])
+	mimeType = strings.TrimSpace(strings.Split(mimeType, ";")[0])
+
+	if mimeType == "application/zip" {
+		zr, err := zip.NewReader(r, size)
+		if err != nil {
+			return mimeType
+		}
+
+		for _, f := range zr.File {
+			if f.Name == "mimetype" {
+				if odType, err := readZipFile(f); err == nil {
+					return strings.TrimSpace(odType)
+				}
+			}
+			if zipType, ok := zipTypes[f.Name]; ok {
+				return zipType
+			}
+		}
+	}
+
+	return mimeType
+}
+
+// Extract returns the plain text contents of a document, or ErrUnsupported
+// if text can't be extracted from that type of document.
+func Extract(filename string, rs io.ReadSeeker) (string, error) {
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", err
+	}
+
+	r := &readerAt{rs: rs}
+
+	extract, ok := extractors[DetectType(filename, r, size)]
+	if !ok {
+		return "", ErrUnsupported
+	}
+
+	text, err := extract(r, size)
+	if err != nil {
+		return "", err
+	}
+
+	if len(text) > MaxTextSize {
+		text = text[:MaxTextSize]
+	}
+
+	return strings.ToValidUTF8(text, ""), nil
+}
+
+// readerAt adapts a ReadSeeker, which is what storage engines provide,
+// to a ReaderAt for the zip and pdf readers.
+type readerAt struct {
+	lock sync.Mutex
+	rs   io.ReadSeeker
+}
+
+func (ra *readerAt) ReadAt(b []byte, off int64) (int, error) {
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+
+	_, err := ra.rs.Seek(off, io.SeekStart)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.ReadFull(ra.rs, b)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+
+	return n, err
+}
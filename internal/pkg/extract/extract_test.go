@@ -0,0 +1,157 @@
+package extract
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zipFile(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, contents := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+// simplePDF builds a single page PDF with the given line of text.
+func simplePDF(text string) []byte {
+	stream := fmt.Sprintf("BT /F1 12 Tf 72 712 Td (%s) Tj ET", text)
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] " +
+			"/Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(stream), stream),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	}
+
+	return buildPDF(objects)
+}
+
+// buildPDF writes the objects, numbered from 1, to a PDF with the first
+// object as the catalog.
+func buildPDF(objects []string) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := []int{}
+	for idx, obj := range objects {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", idx+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+func TestDetectType(t *testing.T) {
+	detect := func(filename string, data []byte) string {
+		return DetectType(filename, bytes.NewReader(data), int64(len(data)))
+	}
+
+	t.Run("by extension", func(t *testing.T) {
+		assert.Equal(t, "application/pdf", detect("SCAN.PDF", []byte{}))
+		assert.Equal(t, "text/plain", detect("notes.md", []byte{}))
+	})
+	t.Run("by contents", func(t *testing.T) {
+		assert.Equal(t, "text/plain", detect("notes", []byte("some notes")))
+		assert.Equal(t, "application/pdf", detect("scan", simplePDF("hello")))
+	})
+	t.Run("office documents without an extension", func(t *testing.T) {
+		docx := zipFile(t, map[string]string{"word/document.xml": "<document/>"})
+		assert.Equal(t, extensionTypes[".docx"], detect("upload", docx))
+
+		odt := zipFile(t, map[string]string{"mimetype": extensionTypes[".odt"]})
+		assert.Equal(t, extensionTypes[".odt"], detect("upload", odt))
+	})
+}
+
+func TestExtract(t *testing.T) {
+	t.Run("plain text", func(t *testing.T) {
+		text, err := Extract("notes.txt", bytes.NewReader([]byte("hello world")))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", text)
+	})
+	t.Run("large plain text", func(t *testing.T) {
+		text, err := Extract("notes.txt", strings.NewReader(strings.Repeat("a", MaxTextSize+10)))
+		require.NoError(t, err)
+		assert.Len(t, text, MaxTextSize)
+	})
+	t.Run("pdf", func(t *testing.T) {
+		text, err := Extract("scan.pdf", bytes.NewReader(simplePDF("Property tax statement")))
+		require.NoError(t, err)
+		assert.Contains(t, text, "Property tax statement")
+	})
+	t.Run("invalid pdf", func(t *testing.T) {
+		_, err := Extract("scan.pdf", bytes.NewReader([]byte("%PDF-1.4 garbage")))
+		assert.Error(t, err)
+	})
+	t.Run("docx", func(t *testing.T) {
+		docx := zipFile(t, map[string]string{
+			"word/document.xml": `<w:document xmlns:w="w"><w:body>` +
+				`<w:p><w:r><w:t>Invoice</w:t></w:r></w:p>` +
+				`<w:p><w:r><w:t>Amount due</w:t></w:r></w:p>` +
+				`</w:body></w:document>`,
+		})
+		text, err := Extract("invoice.docx", bytes.NewReader(docx))
+		require.NoError(t, err)
+		assert.Equal(t, "Invoice\nAmount due", strings.TrimSpace(text))
+	})
+	t.Run("xlsx", func(t *testing.T) {
+		xlsx := zipFile(t, map[string]string{
+			"xl/workbook.xml": "<workbook/>",
+			"xl/sharedStrings.xml": `<sst><si><t>Vendor</t></si>` +
+				`<si><t>Electric company</t></si></sst>`,
+		})
+		text, err := Extract("bills.xlsx", bytes.NewReader(xlsx))
+		require.NoError(t, err)
+		assert.Equal(t, "Vendor\nElectric company", strings.TrimSpace(text))
+	})
+	t.Run("pptx slides in order", func(t *testing.T) {
+		files := map[string]string{
+			"[Content_Types].xml":  "<Types/>",
+			"ppt/presentation.xml": "<p:presentation/>",
+		}
+		for _, n := range []int{1, 2, 10, 11} {
+			files[fmt.Sprintf("ppt/slides/slide%d.xml", n)] = fmt.Sprintf(
+				`<p:sld xmlns:p="p" xmlns:a="a"><a:p><a:t>Slide %d</a:t></a:p></p:sld>`, n,
+			)
+		}
+		text, err := Extract("deck.pptx", bytes.NewReader(zipFile(t, files)))
+		require.NoError(t, err)
+		assert.Equal(t, "Slide 1\n\nSlide 2\n\nSlide 10\n\nSlide 11", strings.TrimSpace(text))
+	})
+	t.Run("odt", func(t *testing.T) {
+		odt := zipFile(t, map[string]string{
+			"mimetype": extensionTypes[".odt"],
+			"content.xml": `<office:document-content xmlns:office="o" xmlns:text="t">` +
+				`<text:h>Lease</text:h><text:p>Term of twelve months</text:p>` +
+				`</office:document-content>`,
+		})
+		text, err := Extract("lease.odt", bytes.NewReader(odt))
+		require.NoError(t, err)
+		assert.Equal(t, "Lease\nTerm of twelve months", strings.TrimSpace(text))
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := Extract("photo.jpg", bytes.NewReader([]byte{0xff, 0xd8, 0xff, 0xe0}))
+		assert.Equal(t, ErrUnsupported, err)
+	})
+}
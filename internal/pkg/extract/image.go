@@ -0,0 +1,212 @@
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"io/ioutil"
+
+	// Register the decoders for the image types that can be extracted
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// MaxImageDocumentSize is the largest PDF that will be searched for a
+// scanned page image.
+const MaxImageDocumentSize = 64 << 20
+
+type imageExtractor func(r io.ReaderAt, size int64) (image.Image, error)
+
+var imageExtractors = map[string]imageExtractor{
+	"image/jpeg":      decodeImage,
+	"image/png":       decodeImage,
+	"image/gif":       decodeImage,
+	"application/pdf": extractPDFImage,
+}
+
+// Image returns how a document looks, either the document itself for
+// images or the scanned image of the first page of a PDF. It returns
+// ErrUnsupported for other types of documents and PDFs without a page
+// image, such as PDFs that were generated instead of scanned.
+func Image(filename string, rs io.ReadSeeker) (image.Image, error) {
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &readerAt{rs: rs}
+
+	extract, ok := imageExtractors[DetectType(filename, r, size)]
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	return extract(r, size)
+}
+
+func decodeImage(r io.ReaderAt, size int64) (image.Image, error) {
+	img, _, err := image.Decode(io.NewSectionReader(r, 0, size))
+	return img, err
+}
+
+// extractPDFImage finds the image of the first page of a scanned PDF.
+// Scanners almost always store pages as JPEG images, which the pdf reader
+// can't decode, so the first JPEG stream in the document is used. Other
+// documents fall back to the largest uncompressed or deflated image on the
+// first page.
+func extractPDFImage(r io.ReaderAt, size int64) (img image.Image, err error) {
+	if size > MaxImageDocumentSize {
+		return nil, ErrUnsupported
+	}
+
+	data, err := ioutil.ReadAll(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, err
+	}
+
+	img, err = firstPDFJPEG(data)
+	if err != ErrUnsupported {
+		return img, err
+	}
+
+	// The pdf reader panics on some malformed documents, so treat that
+	// as any other extraction error.
+	defer func() {
+		if r := recover(); r != nil {
+			img = nil
+			err = fmt.Errorf("could not read pdf: %v", r)
+		}
+	}()
+
+	pr, err := pdf.NewReader(bytes.NewReader(data), size)
+	if err != nil {
+		return nil, err
+	}
+	if pr.NumPage() < 1 {
+		return nil, ErrUnsupported
+	}
+
+	xobjects := pr.Page(1).Resources().Key("XObject")
+	for _, name := range xobjects.Keys() {
+		xobject := xobjects.Key(name)
+		if xobject.Key("Subtype").Name() != "Image" {
+			continue
+		}
+
+		pageImg, err := rawPDFImage(xobject)
+		if err == ErrUnsupported {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if img == nil || area(pageImg) > area(img) {
+			img = pageImg
+		}
+	}
+
+	if img == nil {
+		return nil, ErrUnsupported
+	}
+
+	return img, nil
+}
+
+// firstPDFJPEG decodes the first stream in the PDF that's a JPEG image.
+func firstPDFJPEG(data []byte) (image.Image, error) {
+	marker := []byte("stream")
+	for offset := 0; offset < len(data); {
+		idx := bytes.Index(data[offset:], marker)
+		if idx < 0 {
+			break
+		}
+		start := offset + idx + len(marker)
+		offset = start
+
+		// The stream keyword is followed by CRLF or LF
+		if start < len(data) && data[start] == '\r' {
+			start++
+		}
+		if start < len(data) && data[start] == '\n' {
+			start++
+		}
+
+		if !bytes.HasPrefix(data[start:], []byte{0xff, 0xd8, 0xff}) {
+			continue
+		}
+
+		img, err := jpeg.Decode(bytes.NewReader(data[start:]))
+		if err != nil {
+			return nil, err
+		}
+		return img, nil
+	}
+
+	return nil, ErrUnsupported
+}
+
+// rawPDFImage decodes an 8 bit gray or RGB image that's either stored as
+// is or deflated.
+func rawPDFImage(xobject pdf.Value) (image.Image, error) {
+	filter := xobject.Key("Filter")
+	if filter.Kind() != pdf.Null && filter.Name() != "FlateDecode" {
+		return nil, ErrUnsupported
+	}
+	if xobject.Key("BitsPerComponent").Int64() != 8 {
+		return nil, ErrUnsupported
+	}
+
+	width := int(xobject.Key("Width").Int64())
+	height := int(xobject.Key("Height").Int64())
+	if width < 1 || height < 1 {
+		return nil, ErrUnsupported
+	}
+
+	var components int
+	switch xobject.Key("ColorSpace").Name() {
+	case "DeviceGray":
+		components = 1
+	case "DeviceRGB":
+		components = 3
+	default:
+		return nil, ErrUnsupported
+	}
+
+	rc := xobject.Reader()
+	defer rc.Close()
+
+	data := make([]byte, width*height*components)
+	_, err := io.ReadFull(rc, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if components == 1 {
+		return &image.Gray{
+			Pix:    data,
+			Stride: width,
+			Rect:   image.Rect(0, 0, width, height),
+		}, nil
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for idx := 0; idx < width*height; idx++ {
+		img.Set(idx%width, idx/width, color.RGBA{
+			R: data[idx*3],
+			G: data[idx*3+1],
+			B: data[idx*3+2],
+			A: 0xff,
+		})
+	}
+
+	return img, nil
+}
+
+func area(img image.Image) int {
+	return img.Bounds().Dx() * img.Bounds().Dy()
+}
@@ -0,0 +1,90 @@
+package extract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(width int, height int) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetGray(x, y, color.Gray{Y: uint8(x * 255 / width)})
+		}
+	}
+	return img
+}
+
+// imagePDF builds a single page PDF showing an image stream with the
+// given filter.
+func imagePDF(img *image.Gray, filter string, data []byte) []byte {
+	filterEntry := ""
+	if filter != "" {
+		filterEntry = "/Filter /" + filter + " "
+	}
+
+	return buildPDF([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] " +
+			"/Resources << /XObject << /Im1 4 0 R >> >> >>",
+		fmt.Sprintf(
+			"<< /Type /XObject /Subtype /Image /Width %d /Height %d "+
+				"/ColorSpace /DeviceGray /BitsPerComponent 8 %s/Length %d >>\nstream\n%s\nendstream",
+			img.Bounds().Dx(), img.Bounds().Dy(), filterEntry, len(data), data,
+		),
+	})
+}
+
+func TestImage(t *testing.T) {
+	extractImage := func(filename string, data []byte) (image.Image, error) {
+		return Image(filename, bytes.NewReader(data))
+	}
+
+	t.Run("images", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, png.Encode(buf, testImage(40, 30)))
+
+		img, err := extractImage("scan.png", buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 40, 30), img.Bounds())
+	})
+	t.Run("pdf with a jpeg page", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, jpeg.Encode(buf, testImage(40, 30), nil))
+
+		img, err := extractImage("scan.pdf", imagePDF(testImage(40, 30), "DCTDecode", buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 40, 30), img.Bounds())
+	})
+	t.Run("pdf with a deflated page", func(t *testing.T) {
+		src := testImage(40, 30)
+		buf := &bytes.Buffer{}
+		zw := zlib.NewWriter(buf)
+		_, err := zw.Write(src.Pix)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+
+		img, err := extractImage("scan.pdf", imagePDF(src, "FlateDecode", buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 40, 30), img.Bounds())
+		assert.Equal(t, src.GrayAt(20, 10), color.GrayModel.Convert(img.At(20, 10)))
+	})
+	t.Run("pdf without a page image", func(t *testing.T) {
+		_, err := extractImage("doc.pdf", simplePDF("hello"))
+		assert.Equal(t, ErrUnsupported, err)
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := extractImage("notes.txt", []byte("hello world"))
+		assert.Equal(t, ErrUnsupported, err)
+	})
+}
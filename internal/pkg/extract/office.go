@@ -0,0 +1,168 @@
+package extract
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func readZipFile(f *zip.File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(r, MaxTextSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// xmlText returns the character data of an office XML document. Elements
+// named in breaks end a line of text, such as paragraphs or table cells.
+func xmlText(r io.Reader, breaks map[string]bool) (string, error) {
+	sb := &strings.Builder{}
+
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.CharData:
+			sb.Write(t)
+		case xml.EndElement:
+			if breaks[t.Name.Local] {
+				sb.WriteString("\n")
+			}
+		}
+
+		if sb.Len() > MaxTextSize {
+			break
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// zipNameLess orders entry names by the number at the end of their base
+// name when the rest of the name is the same, so slide2.xml comes before
+// slide10.xml.
+func zipNameLess(a string, b string) bool {
+	aPrefix, aNum := splitNameNumber(a)
+	bPrefix, bNum := splitNameNumber(b)
+	if aPrefix != bPrefix {
+		return aPrefix < bPrefix
+	}
+	if aNum != bNum {
+		return aNum < bNum
+	}
+
+	return a < b
+}
+
+// splitNameNumber splits the number at the end of a name's base name
+// from the rest of the name.
+func splitNameNumber(name string) (string, int) {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	idx := len(base)
+	for idx > 0 && base[idx-1] >= '0' && base[idx-1] <= '9' {
+		idx--
+	}
+
+	num, _ := strconv.Atoi(base[idx:])
+	return base[:idx] + ext, num
+}
+
+// zipXMLText extracts the text from every entry in the zip file matching
+// one of the patterns, in name order.
+func zipXMLText(
+	r io.ReaderAt,
+	size int64,
+	patterns []string,
+	breaks map[string]bool,
+) (string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", err
+	}
+
+	var files []*zip.File
+	for _, f := range zr.File {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, f.Name); ok {
+				files = append(files, f)
+				break
+			}
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return zipNameLess(files[i].Name, files[j].Name)
+	})
+
+	var texts []string
+	for _, f := range files {
+		fr, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+
+		text, err := xmlText(fr, breaks)
+		fr.Close()
+		if err != nil {
+			return "", err
+		}
+
+		texts = append(texts, text)
+	}
+
+	return strings.Join(texts, "\n"), nil
+}
+
+func extractDocx(r io.ReaderAt, size int64) (string, error) {
+	return zipXMLText(
+		r, size,
+		[]string{"word/document.xml", "word/header*.xml", "word/footer*.xml"},
+		map[string]bool{"p": true, "tab": true, "br": true},
+	)
+}
+
+func extractXlsx(r io.ReaderAt, size int64) (string, error) {
+	// Only the shared strings table is used since sheets refer to text
+	// cells by their index in it.
+	return zipXMLText(
+		r, size,
+		[]string{"xl/sharedStrings.xml"},
+		map[string]bool{"si": true},
+	)
+}
+
+func extractPptx(r io.ReaderAt, size int64) (string, error) {
+	return zipXMLText(
+		r, size,
+		[]string{"ppt/slides/*.xml", "ppt/notesSlides/*.xml"},
+		map[string]bool{"p": true},
+	)
+}
+
+func extractOpenDocument(r io.ReaderAt, size int64) (string, error) {
+	return zipXMLText(
+		r, size,
+		[]string{"content.xml"},
+		map[string]bool{"p": true, "h": true, "table-cell": true},
+	)
+}
@@ -0,0 +1,38 @@
+package extract
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// extractPDF returns the text layer of a PDF. Scanned documents without
+// a text layer will come back empty.
+func extractPDF(r io.ReaderAt, size int64) (text string, err error) {
+	// The pdf reader panics on some malformed documents, so treat that
+	// as any other extraction error.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not read pdf: %v", r)
+		}
+	}()
+
+	pr, err := pdf.NewReader(r, size)
+	if err != nil {
+		return "", err
+	}
+
+	tr, err := pr.GetPlainText()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(tr, MaxTextSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
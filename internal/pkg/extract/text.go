@@ -0,0 +1,15 @@
+package extract
+
+import (
+	"io"
+	"io/ioutil"
+)
+
+func extractText(r io.ReaderAt, size int64) (string, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(io.NewSectionReader(r, 0, size), MaxTextSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,77 @@
+// Package imagehash computes perceptual hashes of images, which are close
+// for images that look the same even if they were scanned at a different
+// resolution or saved with different compression.
+package imagehash
+
+import (
+	"fmt"
+	"image"
+	"math/bits"
+)
+
+const (
+	hashWidth  = 9
+	hashHeight = 8
+)
+
+// Hash is a difference hash of an image. Each bit is whether a part of
+// the image is brighter than the part to its right.
+type Hash uint64
+
+func (h Hash) String() string {
+	return fmt.Sprintf("%016x", uint64(h))
+}
+
+// Distance is the number of bits that are different between the hashes.
+// Images that look the same are usually within a distance of 10.
+func Distance(a Hash, b Hash) int {
+	return bits.OnesCount64(uint64(a ^ b))
+}
+
+// Difference computes the difference hash of the image.
+func Difference(img image.Image) Hash {
+	gray := shrink(img)
+
+	var h Hash
+	for y := 0; y < hashHeight; y++ {
+		for x := 0; x < hashWidth-1; x++ {
+			h <<= 1
+			if gray[y][x] > gray[y][x+1] {
+				h |= 1
+			}
+		}
+	}
+
+	return h
+}
+
+// shrink averages the brightness of the image into a hashWidth by
+// hashHeight grid. Averaging every pixel, instead of sampling, keeps the
+// grid the same for an image at different resolutions.
+func shrink(img image.Image) [hashHeight][hashWidth]float64 {
+	var sums [hashHeight][hashWidth]float64
+	var counts [hashHeight][hashWidth]int
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	for y := 0; y < height; y++ {
+		gy := y * hashHeight / height
+		for x := 0; x < width; x++ {
+			gx := x * hashWidth / width
+
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			sums[gy][gx] += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+			counts[gy][gx]++
+		}
+	}
+
+	for y := range sums {
+		for x := range sums[y] {
+			if counts[y][x] > 0 {
+				sums[y][x] /= float64(counts[y][x])
+			}
+		}
+	}
+
+	return sums
+}
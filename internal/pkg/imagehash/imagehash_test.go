@@ -0,0 +1,57 @@
+package imagehash
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// drawPage draws a page with a few dark blocks of "text" at the given
+// size, so the same page can be drawn at different resolutions.
+func drawPage(width int, height int, blocks []image.Rectangle) image.Image {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// Blocks are in hundredths of the page
+			px, py := x*100/width, y*100/height
+
+			shade := uint8(250)
+			for _, block := range blocks {
+				if image.Pt(px, py).In(block) {
+					shade = 20
+				}
+			}
+			img.SetGray(x, y, color.Gray{Y: shade})
+		}
+	}
+
+	return img
+}
+
+func TestDifference(t *testing.T) {
+	invoice := []image.Rectangle{
+		image.Rect(10, 5, 50, 15),
+		image.Rect(60, 30, 90, 40),
+		image.Rect(10, 50, 90, 55),
+		image.Rect(10, 70, 40, 95),
+	}
+	letter := []image.Rectangle{
+		image.Rect(55, 5, 95, 20),
+		image.Rect(5, 35, 30, 90),
+		image.Rect(40, 60, 95, 65),
+	}
+
+	low := Difference(drawPage(170, 220, invoice))
+	high := Difference(drawPage(850, 1100, invoice))
+	other := Difference(drawPage(850, 1100, letter))
+
+	assert.True(t, Distance(low, high) <= 4, "distance %d", Distance(low, high))
+	assert.True(t, Distance(high, other) > 10, "distance %d", Distance(high, other))
+	assert.Equal(t, 0, Distance(high, high))
+}
+
+func TestHashString(t *testing.T) {
+	assert.Equal(t, "00000000000000ff", Hash(0xff).String())
+}
@@ -0,0 +1,60 @@
+package protoutil
+
+import (
+	"context"
+	"os/user"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ActorMetadataKey is the gRPC metadata key clients send the name of
+// who is making a request in, which is recorded in the audit log.
+const ActorMetadataKey = "softcopy-actor"
+
+// CurrentActor returns the name of the user running the current process
+// to use as the actor for requests.
+func CurrentActor() string {
+	u, err := user.Current()
+	if err != nil || u.Username == "" {
+		return "unknown"
+	}
+
+	return u.Username
+}
+
+// ActorInterceptor returns a client interceptor that sends actor with
+// every unary request.
+func ActorInterceptor(actor string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, ActorMetadataKey, actor)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// ActorFromContext returns the actor a client sent with a request. If
+// the client didn't send one the client's address is used instead.
+func ActorFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		actors := md.Get(ActorMetadataKey)
+		if len(actors) > 0 && actors[0] != "" {
+			return actors[0]
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,54 @@
+package protoutil
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func FieldTypeToProto(typ records.FieldType) scproto.FieldType {
+	switch typ {
+	case records.FIELD_TYPE_STRING:
+		return scproto.FieldType_FIELD_TYPE_STRING
+	case records.FIELD_TYPE_NUMBER:
+		return scproto.FieldType_FIELD_TYPE_NUMBER
+	case records.FIELD_TYPE_DATE:
+		return scproto.FieldType_FIELD_TYPE_DATE
+	case records.FIELD_TYPE_MONEY:
+		return scproto.FieldType_FIELD_TYPE_MONEY
+	default:
+		return scproto.FieldType_FIELD_TYPE_UNKNOWN
+	}
+}
+
+func ProtoToFieldType(typ scproto.FieldType) records.FieldType {
+	switch typ {
+	case scproto.FieldType_FIELD_TYPE_STRING:
+		return records.FIELD_TYPE_STRING
+	case scproto.FieldType_FIELD_TYPE_NUMBER:
+		return records.FIELD_TYPE_NUMBER
+	case scproto.FieldType_FIELD_TYPE_DATE:
+		return records.FIELD_TYPE_DATE
+	case scproto.FieldType_FIELD_TYPE_MONEY:
+		return records.FIELD_TYPE_MONEY
+	default:
+		return records.FIELD_TYPE_UNKNOWN
+	}
+}
+
+func FieldToProto(field *records.Field) *scproto.Field {
+	return &scproto.Field{
+		Name:  field.Name,
+		Type:  FieldTypeToProto(field.Type),
+		Value: field.Value(),
+	}
+}
+
+// ProtoToField parses the field's value based on its type, returning an
+// error if the value isn't valid for the type.
+func ProtoToField(field *scproto.Field) (*records.Field, error) {
+	return records.ParseField(
+		field.GetName(),
+		ProtoToFieldType(field.GetType()),
+		field.GetValue(),
+	)
+}
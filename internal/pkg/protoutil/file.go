@@ -1,6 +1,8 @@
 package protoutil
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/google/uuid"
 
@@ -35,6 +37,14 @@ func FileToProto(file *records.File) (*scproto.File, error) {
 	if err != nil {
 		return nil, err
 	}
+	created, err := types.TimestampProto(file.Created)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := types.TimestampProto(file.Updated)
+	if err != nil {
+		return nil, err
+	}
 
 	return &scproto.File{
 		Id:           file.ID.String(),
@@ -42,14 +52,39 @@ func FileToProto(file *records.File) (*scproto.File, error) {
 		Filename:     file.Filename,
 		DocumentDate: ts,
 		ContentSize:  file.Size,
+		Title:        file.Title,
+		Notes:        file.Notes,
+		Revision:     int32(file.Revision),
+		Created:      created,
+		Updated:      updated,
+		Source:       file.Source,
+		OriginalPath: file.OriginalPath,
 	}, nil
 }
 
+// optionalTimestamp converts a timestamp that older servers don't send,
+// returning the zero time if it's missing.
+func optionalTimestamp(ts *types.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+
+	return types.TimestampFromProto(ts)
+}
+
 func ProtoToFile(file *scproto.File) (*records.File, error) {
 	date, err := types.TimestampFromProto(file.GetDocumentDate())
 	if err != nil {
 		return nil, err
 	}
+	created, err := optionalTimestamp(file.GetCreated())
+	if err != nil {
+		return nil, err
+	}
+	updated, err := optionalTimestamp(file.GetUpdated())
+	if err != nil {
+		return nil, err
+	}
 
 	id, err := uuid.Parse(file.GetId())
 	if err != nil {
@@ -62,5 +97,25 @@ func ProtoToFile(file *scproto.File) (*records.File, error) {
 		Filename:     file.GetFilename(),
 		DocumentDate: date,
 		Size:         file.GetContentSize(),
+		Title:        file.GetTitle(),
+		Notes:        file.GetNotes(),
+		Revision:     int(file.GetRevision()),
+		Created:      created,
+		Updated:      updated,
+		Source:       file.GetSource(),
+		OriginalPath: file.GetOriginalPath(),
+	}, nil
+}
+
+func SearchHitToProto(hit *records.SearchHit) (*scproto.SearchHit, error) {
+	f, err := FileToProto(hit.File)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scproto.SearchHit{
+		File:    f,
+		Rank:    hit.Rank,
+		Snippet: hit.Snippet,
 	}, nil
 }
@@ -0,0 +1,63 @@
+package protoutil
+
+import (
+	"github.com/gogo/protobuf/types"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func LinkTypeToProto(typ records.LinkType) scproto.LinkType {
+	switch typ {
+	case records.LINK_TYPE_ATTACHMENT_OF:
+		return scproto.LinkType_LINK_TYPE_ATTACHMENT_OF
+	case records.LINK_TYPE_SUPERSEDES:
+		return scproto.LinkType_LINK_TYPE_SUPERSEDES
+	case records.LINK_TYPE_RELATED_TO:
+		return scproto.LinkType_LINK_TYPE_RELATED_TO
+	default:
+		return scproto.LinkType_LINK_TYPE_UNKNOWN
+	}
+}
+
+func ProtoToLinkType(typ scproto.LinkType) records.LinkType {
+	switch typ {
+	case scproto.LinkType_LINK_TYPE_ATTACHMENT_OF:
+		return records.LINK_TYPE_ATTACHMENT_OF
+	case scproto.LinkType_LINK_TYPE_SUPERSEDES:
+		return records.LINK_TYPE_SUPERSEDES
+	case scproto.LinkType_LINK_TYPE_RELATED_TO:
+		return records.LINK_TYPE_RELATED_TO
+	default:
+		return records.LINK_TYPE_UNKNOWN
+	}
+}
+
+func FileLinkToProto(link *records.FileLink) (*scproto.FileLink, error) {
+	created, err := types.TimestampProto(link.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scproto.FileLink{
+		SourceId: link.SourceID.String(),
+		TargetId: link.TargetID.String(),
+		Type:     LinkTypeToProto(link.Type),
+		Created:  created,
+	}, nil
+}
+
+// FileLinksToProto converts all of the links, such as the links of a
+// TaggedFile.
+func FileLinksToProto(links []*records.FileLink) ([]*scproto.FileLink, error) {
+	res := []*scproto.FileLink{}
+	for _, link := range links {
+		resLink, err := FileLinkToProto(link)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, resLink)
+	}
+
+	return res, nil
+}
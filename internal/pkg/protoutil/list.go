@@ -0,0 +1,32 @@
+package protoutil
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func FileSortToProto(sort records.FileSort) scproto.FileSort {
+	switch sort {
+	case records.FILE_SORT_FILENAME:
+		return scproto.FileSort_FILE_SORT_FILENAME
+	case records.FILE_SORT_SIZE:
+		return scproto.FileSort_FILE_SORT_SIZE
+	case records.FILE_SORT_CREATED:
+		return scproto.FileSort_FILE_SORT_CREATED
+	default:
+		return scproto.FileSort_FILE_SORT_DOCUMENT_DATE
+	}
+}
+
+func ProtoToFileSort(sort scproto.FileSort) records.FileSort {
+	switch sort {
+	case scproto.FileSort_FILE_SORT_FILENAME:
+		return records.FILE_SORT_FILENAME
+	case scproto.FileSort_FILE_SORT_SIZE:
+		return records.FILE_SORT_SIZE
+	case scproto.FileSort_FILE_SORT_CREATED:
+		return records.FILE_SORT_CREATED
+	default:
+		return records.FILE_SORT_DOCUMENT_DATE
+	}
+}
@@ -0,0 +1,21 @@
+package protoutil
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func ScrubStatusToProto(status records.ScrubStatus) scproto.ScrubStatus {
+	switch status {
+	case records.SCRUB_STATUS_OK:
+		return scproto.ScrubStatus_SCRUB_STATUS_OK
+	case records.SCRUB_STATUS_CORRUPT:
+		return scproto.ScrubStatus_SCRUB_STATUS_CORRUPT
+	case records.SCRUB_STATUS_MISSING:
+		return scproto.ScrubStatus_SCRUB_STATUS_MISSING
+	case records.SCRUB_STATUS_REPAIRED:
+		return scproto.ScrubStatus_SCRUB_STATUS_REPAIRED
+	default:
+		return scproto.ScrubStatus_SCRUB_STATUS_UNKNOWN
+	}
+}
@@ -0,0 +1,28 @@
+package protoutil
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	scproto "github.com/aphistic/softcopy/pkg/proto"
+)
+
+func StatsToProto(stats *records.Stats) *scproto.Stats {
+	years := []*scproto.YearStats{}
+	for _, year := range stats.Years {
+		years = append(years, &scproto.YearStats{
+			Year:  int32(year.Year),
+			Files: int32(year.Files),
+		})
+	}
+
+	return &scproto.Stats{
+		Files:          int32(stats.Files),
+		TrashedFiles:   int32(stats.TrashedFiles),
+		InboxFiles:     int32(stats.InboxFiles),
+		Tags:           int32(stats.Tags),
+		LogicalBytes:   stats.LogicalBytes,
+		StoredContents: int32(stats.StoredContents),
+		StoredBytes:    stats.StoredBytes,
+		DedupSavings:   stats.DedupSavings(),
+		Years:          years,
+	}
+}
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// fieldValue is a query value parsed as each of the field types it's valid
+// for. Types the value isn't valid for are nil and never match.
+type fieldValue struct {
+	number *float64
+	date   *Date
+	money  *records.Money
+}
+
+func parseFieldValue(op string, value string) fieldValue {
+	var res fieldValue
+
+	if field, err := records.ParseField("value", records.FIELD_TYPE_NUMBER, value); err == nil {
+		res.number = &field.Number
+	}
+
+	if start, end, err := parseDatePart(value); err == nil {
+		res.date = fieldDateRange(op, start, end)
+	}
+
+	// Money values can leave out the currency to match any currency
+	if parts := strings.Fields(value); len(parts) == 1 {
+		if amount, err := records.ParseMoneyAmount(parts[0]); err == nil {
+			res.money = &records.Money{Amount: amount}
+		}
+	} else if money, err := records.ParseMoney(value); err == nil {
+		res.money = &money
+	}
+
+	return res
+}
+
+// fieldDateRange converts a comparison with a date period into the range
+// of dates that match it.
+func fieldDateRange(op string, start, end time.Time) *Date {
+	switch op {
+	case FieldLess:
+		return &Date{To: start}
+	case FieldLessEqual:
+		return &Date{To: end}
+	case FieldGreater:
+		return &Date{From: end}
+	case FieldGreaterEqual:
+		return &Date{From: start}
+	default:
+		return &Date{From: start, To: end}
+	}
+}
+
+// compareResult checks if the result of comparing a field's value with
+// the query value satisfies the operator.
+func compareResult(op string, cmp int) bool {
+	switch op {
+	case FieldLess:
+		return cmp < 0
+	case FieldLessEqual:
+		return cmp <= 0
+	case FieldGreater:
+		return cmp > 0
+	case FieldGreaterEqual:
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
+
+func compareNumbers(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Match reports whether the field has the node's name and a value that
+// satisfies the comparison, based on the field's type.
+func (n *Field) Match(field *records.Field) bool {
+	if field.Name != n.Name {
+		return false
+	}
+	if n.Op == "" {
+		return true
+	}
+
+	value := parseFieldValue(n.Op, n.Value)
+	switch field.Type {
+	case records.FIELD_TYPE_STRING:
+		return compareResult(n.Op, strings.Compare(
+			strings.ToLower(field.String),
+			strings.ToLower(n.Value),
+		))
+	case records.FIELD_TYPE_NUMBER:
+		return value.number != nil &&
+			compareResult(n.Op, compareNumbers(field.Number, *value.number))
+	case records.FIELD_TYPE_DATE:
+		return value.date != nil && value.date.Match(field.Date)
+	case records.FIELD_TYPE_MONEY:
+		if value.money == nil {
+			return false
+		}
+		if value.money.Currency != "" && value.money.Currency != field.Money.Currency {
+			return false
+		}
+		return compareResult(n.Op, compareNumbers(
+			float64(field.Money.Amount),
+			float64(value.money.Amount),
+		))
+	default:
+		return false
+	}
+}
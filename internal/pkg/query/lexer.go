@@ -0,0 +1,120 @@
+package query
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenLParen
+	tokenRParen
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenWord
+)
+
+type token struct {
+	typ tokenType
+	pos int
+	val string
+	// quoted is set when the word was given in quotes, which keeps it
+	// from being treated as a keyword or a predicate.
+	quoted bool
+}
+
+var keywords = map[string]tokenType{
+	"AND": tokenAnd,
+	"OR":  tokenOr,
+	"NOT": tokenNot,
+}
+
+func isWordEnd(r rune) bool {
+	return unicode.IsSpace(r) || r == '(' || r == ')' || r == '"'
+}
+
+// isPredicatePrefix checks if the word is the start of a predicate waiting
+// for its value.
+func isPredicatePrefix(val string) bool {
+	return strings.Contains(val, ":") && strings.ContainsAny(val[len(val)-1:], ":=<>")
+}
+
+func lex(query string) ([]token, error) {
+	runes := []rune(query)
+
+	var tokens []token
+	pos := 0
+	for pos < len(runes) {
+		r := runes[pos]
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+		case r == '(':
+			tokens = append(tokens, token{typ: tokenLParen, pos: pos, val: "("})
+			pos++
+		case r == ')':
+			tokens = append(tokens, token{typ: tokenRParen, pos: pos, val: ")"})
+			pos++
+		case r == '"':
+			val, end, err := lexQuoted(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{typ: tokenWord, pos: pos, val: val, quoted: true})
+			pos = end
+		default:
+			start := pos
+			for pos < len(runes) && !isWordEnd(runes[pos]) {
+				pos++
+			}
+			val := string(runes[start:pos])
+
+			// Allow quoted predicate values, such as name:"tax return"
+			// or field:vendor="Acme Corp"
+			if isPredicatePrefix(val) && pos < len(runes) && runes[pos] == '"' {
+				quoted, end, err := lexQuoted(runes, pos)
+				if err != nil {
+					return nil, err
+				}
+
+				val = val + quoted
+				pos = end
+			} else if typ, ok := keywords[val]; ok {
+				tokens = append(tokens, token{typ: typ, pos: start, val: val})
+				continue
+			}
+
+			tokens = append(tokens, token{typ: tokenWord, pos: start, val: val})
+		}
+	}
+
+	tokens = append(tokens, token{typ: tokenEOF, pos: len(runes)})
+
+	return tokens, nil
+}
+
+// lexQuoted reads a quoted string starting at pos, returning the unquoted
+// value and the position after the closing quote. A backslash escapes the
+// following character.
+func lexQuoted(runes []rune, pos int) (string, int, error) {
+	sb := &strings.Builder{}
+	for idx := pos + 1; idx < len(runes); idx++ {
+		switch runes[idx] {
+		case '\\':
+			idx++
+			if idx < len(runes) {
+				sb.WriteRune(runes[idx])
+			}
+		case '"':
+			return sb.String(), idx + 1, nil
+		default:
+			sb.WriteRune(runes[idx])
+		}
+	}
+
+	return "", 0, &SyntaxError{Pos: pos, Msg: "unterminated quote"}
+}
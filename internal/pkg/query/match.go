@@ -0,0 +1,42 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// Match evaluates the query against a file with the given tag names and
+// custom fields, for storage engines that can't compile the query to SQL.
+// tagNames should include the ancestors of the file's tags so a tag matches
+// files tagged with its descendants.
+func Match(node Node, file *records.File, tagNames []string, fields []*records.Field) bool {
+	switch n := node.(type) {
+	case *And:
+		return Match(n.Left, file, tagNames, fields) && Match(n.Right, file, tagNames, fields)
+	case *Or:
+		return Match(n.Left, file, tagNames, fields) || Match(n.Right, file, tagNames, fields)
+	case *Not:
+		return !Match(n.Node, file, tagNames, fields)
+	case *Tag:
+		for _, name := range tagNames {
+			if name == n.Name {
+				return true
+			}
+		}
+		return false
+	case *Date:
+		return n.Match(file.DocumentDate)
+	case *Name:
+		return strings.Contains(strings.ToLower(file.Filename), strings.ToLower(n.Contains))
+	case *Field:
+		for _, field := range fields {
+			if n.Match(field) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -0,0 +1,280 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses a query string, returning a *SyntaxError if it's invalid.
+func Parse(query string) (Node, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().typ == tokenEOF {
+		return nil, &SyntaxError{Pos: 0, Msg: "empty query"}
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected '%s'", tok.val)}
+	}
+
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseOr() (Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().typ == tokenOr {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch p.peek().typ {
+		case tokenAnd:
+			p.next()
+		case tokenNot, tokenLParen, tokenWord:
+			// Terms without an operator between them are ANDed
+		default:
+			return left, nil
+		}
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &And{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseNot() (Node, error) {
+	if p.peek().typ == tokenNot {
+		p.next()
+
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Not{Node: node}, nil
+	}
+
+	return p.parseTerm()
+}
+
+func (p *parser) parseTerm() (Node, error) {
+	tok := p.next()
+	switch tok.typ {
+	case tokenLParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		closeTok := p.next()
+		if closeTok.typ != tokenRParen {
+			return nil, &SyntaxError{Pos: closeTok.pos, Msg: "expected ')'"}
+		}
+
+		return node, nil
+	case tokenWord:
+		if tok.quoted {
+			return &Tag{Name: tok.val}, nil
+		}
+
+		return parseWord(tok)
+	case tokenEOF:
+		return nil, &SyntaxError{Pos: tok.pos, Msg: "unexpected end of query"}
+	default:
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected '%s'", tok.val)}
+	}
+}
+
+func parseWord(tok token) (Node, error) {
+	idx := strings.Index(tok.val, ":")
+	if idx < 0 {
+		return &Tag{Name: tok.val}, nil
+	}
+
+	value := tok.val[idx+1:]
+	switch tok.val[:idx] {
+	case "date":
+		node, err := parseDate(value)
+		if err != nil {
+			return nil, &SyntaxError{Pos: tok.pos, Msg: err.Error()}
+		}
+		return node, nil
+	case "name":
+		if value == "" {
+			return nil, &SyntaxError{Pos: tok.pos, Msg: "name requires a value"}
+		}
+		return &Name{Contains: value}, nil
+	case "field":
+		node, err := parseField(value)
+		if err != nil {
+			return nil, &SyntaxError{Pos: tok.pos, Msg: err.Error()}
+		}
+		return node, nil
+	default:
+		// Not a predicate we know about, so it's a tag with a colon in it
+		return &Tag{Name: tok.val}, nil
+	}
+}
+
+// parseDatePart parses a year, month or day returning the start of that
+// period and the start of the next one.
+func parseDatePart(value string) (time.Time, time.Time, error) {
+	layouts := []struct {
+		layout string
+		years  int
+		months int
+		days   int
+	}{
+		{"2006", 1, 0, 0},
+		{"2006-01", 0, 1, 0},
+		{"2006-01-02", 0, 0, 1},
+	}
+
+	for _, l := range layouts {
+		if len(value) != len(l.layout) {
+			continue
+		}
+
+		start, err := time.Parse(l.layout, value)
+		if err != nil {
+			break
+		}
+
+		return start, start.AddDate(l.years, l.months, l.days), nil
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid date '%s'", value)
+}
+
+func parseDate(value string) (*Date, error) {
+	switch {
+	case strings.HasPrefix(value, ">="):
+		start, _, err := parseDatePart(value[2:])
+		return &Date{From: start}, err
+	case strings.HasPrefix(value, ">"):
+		_, end, err := parseDatePart(value[1:])
+		return &Date{From: end}, err
+	case strings.HasPrefix(value, "<="):
+		_, end, err := parseDatePart(value[2:])
+		return &Date{To: end}, err
+	case strings.HasPrefix(value, "<"):
+		start, _, err := parseDatePart(value[1:])
+		return &Date{To: start}, err
+	}
+
+	if idx := strings.Index(value, ".."); idx >= 0 {
+		res := &Date{}
+
+		if from := value[:idx]; from != "" {
+			start, _, err := parseDatePart(from)
+			if err != nil {
+				return nil, err
+			}
+			res.From = start
+		}
+		if to := value[idx+2:]; to != "" {
+			_, end, err := parseDatePart(to)
+			if err != nil {
+				return nil, err
+			}
+			res.To = end
+		}
+
+		if res.From.IsZero() && res.To.IsZero() {
+			return nil, fmt.Errorf("date range requires a start or end")
+		}
+
+		return res, nil
+	}
+
+	start, end, err := parseDatePart(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Date{From: start, To: end}, nil
+}
+
+func parseField(value string) (*Field, error) {
+	idx := strings.IndexAny(value, "=<>")
+	if idx < 0 {
+		if !records.ValidFieldName(value) {
+			return nil, fmt.Errorf("invalid field name '%s'", value)
+		}
+		return &Field{Name: value}, nil
+	}
+
+	res := &Field{Name: value[:idx]}
+	if !records.ValidFieldName(res.Name) {
+		return nil, fmt.Errorf("invalid field name '%s'", res.Name)
+	}
+
+	for _, op := range []string{
+		FieldLessEqual, FieldGreaterEqual,
+		FieldEqual, FieldLess, FieldGreater,
+	} {
+		if strings.HasPrefix(value[idx:], op) {
+			res.Op = op
+			res.Value = value[idx+len(op):]
+			break
+		}
+	}
+
+	if res.Value == "" {
+		return nil, fmt.Errorf("field %s requires a value", res.Name)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,171 @@
+// Package query implements the boolean query language used to find files,
+// such as `taxes AND 2020 NOT draft`.
+//
+// Bare words match files with that tag. Terms can be combined with AND, OR
+// and NOT and grouped with parentheses, and terms next to each other without
+// an operator are ANDed together. Words can be quoted to include spaces or
+// to use a keyword as a tag name. The following predicates are supported:
+//
+//	date:2020                   documents dated in 2020
+//	date:2020-03                documents dated in March 2020
+//	date:2020-03-04             documents dated on March 4th, 2020
+//	date:2020-01..2020-06       documents dated from January through June 2020
+//	date:>2020-03, date:<=2020  documents dated after or before a date
+//	name:invoice                filenames containing "invoice"
+//	field:vendor                documents with a vendor field
+//	field:vendor=acme           documents with a vendor field equal to "acme"
+//	field:amount>=100           documents with an amount of at least 100
+//	field:due<2020-03           documents due before March 2020
+//
+// Field values are compared based on the type of each document's field.
+// Strings compare ignoring case, dates use the same periods as date: and
+// money can include a currency, such as field:amount<"20 USD".
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (se *SyntaxError) Error() string {
+	return fmt.Sprintf("query syntax error at %d: %s", se.Pos, se.Msg)
+}
+
+// Node is a single part of a parsed query.
+type Node interface {
+	String() string
+}
+
+type And struct {
+	Left  Node
+	Right Node
+}
+
+func (n *And) String() string {
+	return "(" + n.Left.String() + " AND " + n.Right.String() + ")"
+}
+
+type Or struct {
+	Left  Node
+	Right Node
+}
+
+func (n *Or) String() string {
+	return "(" + n.Left.String() + " OR " + n.Right.String() + ")"
+}
+
+type Not struct {
+	Node Node
+}
+
+func (n *Not) String() string {
+	return "NOT " + n.Node.String()
+}
+
+// Tag matches files with the named tag.
+type Tag struct {
+	Name string
+}
+
+func (n *Tag) String() string {
+	return Quote(n.Name)
+}
+
+// Date matches files with a document date on or after From and before To.
+// A zero From or To leaves that side of the range open.
+type Date struct {
+	From time.Time
+	To   time.Time
+}
+
+func (n *Date) String() string {
+	const layout = "2006-01-02"
+
+	switch {
+	case n.From.IsZero():
+		return "date:<" + n.To.Format(layout)
+	case n.To.IsZero():
+		return "date:>=" + n.From.Format(layout)
+	default:
+		return "date:" + n.From.Format(layout) + ".." + n.To.AddDate(0, 0, -1).Format(layout)
+	}
+}
+
+// Match reports whether the date falls within the range, compared in UTC.
+func (n *Date) Match(date time.Time) bool {
+	date = date.UTC()
+	if !n.From.IsZero() && date.Before(n.From) {
+		return false
+	}
+	if !n.To.IsZero() && !date.Before(n.To) {
+		return false
+	}
+	return true
+}
+
+// Name matches files with a filename containing Contains, ignoring case.
+type Name struct {
+	Contains string
+}
+
+func (n *Name) String() string {
+	return "name:" + Quote(n.Contains)
+}
+
+// Field operators used to compare custom field values.
+const (
+	FieldEqual        = "="
+	FieldLess         = "<"
+	FieldLessEqual    = "<="
+	FieldGreater      = ">"
+	FieldGreaterEqual = ">="
+)
+
+// Field matches files with the named custom field. If Op is empty any value
+// matches, otherwise the field's value is compared to Value using Op.
+type Field struct {
+	Name  string
+	Op    string
+	Value string
+}
+
+func (n *Field) String() string {
+	if n.Op == "" {
+		return "field:" + n.Name
+	}
+	return "field:" + n.Name + n.Op + Quote(n.Value)
+}
+
+// Quote returns the word quoted if it needs to be in order to be parsed as
+// a single tag name.
+func Quote(word string) string {
+	if word == "" || strings.ContainsAny(word, " \t\r\n()\":\\") {
+		return strconv.Quote(word)
+	}
+	if _, ok := keywords[word]; ok {
+		return strconv.Quote(word)
+	}
+	return word
+}
+
+// AllTags returns a query matching files with every one of the tags.
+func AllTags(names ...string) Node {
+	var res Node
+	for _, name := range names {
+		var tag Node = &Tag{Name: name}
+		if res == nil {
+			res = tag
+		} else {
+			res = &And{Left: res, Right: tag}
+		}
+	}
+
+	return res
+}
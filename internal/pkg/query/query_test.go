@@ -0,0 +1,214 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	t.Run("precedence", func(t *testing.T) {
+		tests := map[string]string{
+			"taxes":                           "taxes",
+			"taxes AND 2020 NOT draft":        "((taxes AND 2020) AND NOT draft)",
+			"taxes 2020":                      "(taxes AND 2020)",
+			"a OR b AND c":                    "(a OR (b AND c))",
+			"(a OR b) c":                      "((a OR b) AND c)",
+			"NOT NOT a":                       "NOT NOT a",
+			`"tax return" OR "AND"`:           `("tax return" OR "AND")`,
+			`name:"tax return"`:               `name:"tax return"`,
+			"name:invoice date:2020":          "(name:invoice AND date:2020-01-01..2020-12-31)",
+			"date:2020-02":                    "date:2020-02-01..2020-02-29",
+			"date:2020-02-03":                 "date:2020-02-03..2020-02-03",
+			"date:2020-01..2020-06":           "date:2020-01-01..2020-06-30",
+			"date:2020..":                     "date:>=2020-01-01",
+			"date:>2020-03":                   "date:>=2020-04-01",
+			"date:>=2020-03":                  "date:>=2020-03-01",
+			"date:<2020":                      "date:<2020-01-01",
+			"date:<=2020":                     "date:<2021-01-01",
+			"vendor:acme":                     `"vendor:acme"`,
+			"field:vendor":                    "field:vendor",
+			`field:vendor="Acme Corp"`:        `field:vendor="Acme Corp"`,
+			"field:amount>=100":               "field:amount>=100",
+			"field:due<2020-03 field:paid":    "(field:due<2020-03 AND field:paid)",
+			"a AND (b OR (c AND NOT d)) OR e": "((a AND (b OR (c AND NOT d))) OR e)",
+		}
+
+		for query, expected := range tests {
+			node, err := Parse(query)
+			require.NoError(t, err, query)
+			assert.Equal(t, expected, node.String(), query)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := map[string]int{
+			"":             0,
+			"   ":          0,
+			"a AND":        5,
+			"(a OR b":      7,
+			"a)":           1,
+			`"unclosed`:    0,
+			"date:2020-13": 0,
+			"date:..":      0,
+			"a name:":      2,
+			"OR a":         0,
+			"field:":       0,
+			"field:a.b=1":  0,
+			"x field:a>":   2,
+		}
+
+		for query, pos := range tests {
+			_, err := Parse(query)
+			require.Error(t, err, query)
+
+			syntaxErr, ok := err.(*SyntaxError)
+			require.True(t, ok, query)
+			assert.Equal(t, pos, syntaxErr.Pos, query)
+		}
+	})
+}
+
+func TestQuote(t *testing.T) {
+	for _, name := range []string{"taxes", "tax return", "OR", `say "hi"`, "vendor:acme", ""} {
+		node, err := Parse(Quote(name))
+		require.NoError(t, err, name)
+		assert.Equal(t, &Tag{Name: name}, node)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	file := &records.File{
+		Filename:     "Property Tax.pdf",
+		DocumentDate: date(2020, 3, 4),
+	}
+	tags := []string{"taxes", "house"}
+	fields := []*records.Field{
+		{Name: "vendor", Type: records.FIELD_TYPE_STRING, String: "Acme"},
+	}
+
+	tests := map[string]bool{
+		"taxes":                     true,
+		"taxes AND car":             false,
+		"taxes AND NOT car":         true,
+		"car OR house":              true,
+		"name:tax":                  true,
+		"name:invoice":              false,
+		"date:2020-03":              true,
+		"date:2020-03-05..":         false,
+		"date:<2020-03-05":          true,
+		"taxes AND 2020 NOT draft":  false,
+		"taxes date:2020 NOT draft": true,
+		"field:vendor=acme":         true,
+		"field:vendor=other":        false,
+		"taxes NOT field:amount":    true,
+	}
+
+	for query, expected := range tests {
+		node, err := Parse(query)
+		require.NoError(t, err, query)
+		assert.Equal(t, expected, Match(node, file, tags, fields), query)
+	}
+}
+
+func TestFieldMatch(t *testing.T) {
+	fields := []*records.Field{
+		{Name: "vendor", Type: records.FIELD_TYPE_STRING, String: "Acme"},
+		{Name: "count", Type: records.FIELD_TYPE_NUMBER, Number: 12.5},
+		{Name: "due", Type: records.FIELD_TYPE_DATE, Date: date(2020, 3, 4)},
+		{Name: "amount", Type: records.FIELD_TYPE_MONEY, Money: records.Money{Amount: 10050, Currency: "USD"}},
+	}
+
+	tests := map[string]bool{
+		"field:vendor":             true,
+		"field:missing":            false,
+		"field:vendor=ACME":        true,
+		"field:vendor>acme":        false,
+		"field:vendor<b":           true,
+		"field:count=12.5":         true,
+		"field:count>12":           true,
+		"field:count<=12":          false,
+		"field:count=abc":          false,
+		"field:due=2020-03":        true,
+		"field:due<2020-03":        false,
+		"field:due<=2020-03":       true,
+		"field:due>2020-03-03":     true,
+		"field:due>=2020-04":       false,
+		"field:amount=100.50":      true,
+		`field:amount="100.5 usd"`: true,
+		`field:amount="100.5 EUR"`: false,
+		"field:amount>100":         true,
+		"field:amount<100":         false,
+	}
+
+	for query, expected := range tests {
+		node, err := Parse(query)
+		require.NoError(t, err, query)
+		assert.Equal(t, expected, Match(node, &records.File{}, nil, fields), query)
+	}
+}
+
+type testDialect struct{}
+
+func (testDialect) Placeholder(n int) string        { return "?" }
+func (testDialect) Date(column string) string       { return "date(" + column + ")" }
+func (testDialect) DateArg(t time.Time) interface{} { return t.Format("2006-01-02") }
+func (testDialect) Like() string                    { return "LIKE" }
+
+func TestSQL(t *testing.T) {
+	node, err := Parse("(taxes OR bills) NOT date:2020 name:50%")
+	require.NoError(t, err)
+
+	where, args, err := SQL(node, testDialect{})
+	require.NoError(t, err)
+
+	tagSQL := "f.id IN (SELECT ft.file_id FROM file_tags ft WHERE ft.tag_id IN (" +
+		"WITH RECURSIVE subtags(id) AS (SELECT id FROM tags WHERE name = ? " +
+		"UNION SELECT t.id FROM tags t INNER JOIN subtags s ON t.parent_id = s.id" +
+		") SELECT id FROM subtags))"
+	assert.Equal(t,
+		"((("+tagSQL+" OR "+tagSQL+") AND NOT (date(f.document_date) >= ? AND date(f.document_date) < ?))"+
+			" AND f.filename LIKE ? ESCAPE '\\')",
+		where,
+	)
+	assert.Equal(t, []interface{}{"taxes", "bills", "2020-01-01", "2021-01-01", `%50\%%`}, args)
+}
+
+func TestFieldSQL(t *testing.T) {
+	node, err := Parse("field:paid field:due<2020-03")
+	require.NoError(t, err)
+
+	where, args, err := SQL(node, testDialect{})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"(f.id IN (SELECT ff.file_id FROM file_fields ff WHERE ff.name = ?) AND "+
+			"f.id IN (SELECT ff.file_id FROM file_fields ff WHERE ff.name = ? AND ("+
+			"(ff.field_type = 1 AND lower(ff.string_value) < lower(?)) OR "+
+			"(ff.field_type = 3 AND (date(ff.date_value) < ?)))))",
+		where,
+	)
+	assert.Equal(t, []interface{}{"paid", "due", "2020-03", "2020-03-01"}, args)
+
+	node, err = Parse(`field:amount>="20 usd"`)
+	require.NoError(t, err)
+
+	where, args, err = SQL(node, testDialect{})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"f.id IN (SELECT ff.file_id FROM file_fields ff WHERE ff.name = ? AND ("+
+			"(ff.field_type = 1 AND lower(ff.string_value) >= lower(?)) OR "+
+			"(ff.field_type = 4 AND ff.money_amount >= ? AND ff.money_currency = ?)))",
+		where,
+	)
+	assert.Equal(t, []interface{}{"amount", "20 usd", int64(2000), "USD"}, args)
+}
@@ -0,0 +1,148 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// Dialect describes the parts of a SQL query plan that differ between
+// databases. Compiled queries expect the files table to be aliased as f,
+// and the file_tags, tags and file_fields tables to be available. Tags are
+// matched along with all of their descendants through tags.parent_id.
+type Dialect interface {
+	// Placeholder returns the parameter placeholder for the nth argument,
+	// starting at 1.
+	Placeholder(n int) string
+	// Date returns an expression for a date column that can be compared
+	// with the values returned by DateArg.
+	Date(column string) string
+	DateArg(time.Time) interface{}
+	// Like returns the operator used for case insensitive LIKE matching.
+	Like() string
+}
+
+type sqlBuilder struct {
+	dialect Dialect
+	args    []interface{}
+}
+
+func (b *sqlBuilder) arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+func (b *sqlBuilder) build(node Node) (string, error) {
+	switch n := node.(type) {
+	case *And:
+		return b.buildBinary("AND", n.Left, n.Right)
+	case *Or:
+		return b.buildBinary("OR", n.Left, n.Right)
+	case *Not:
+		inner, err := b.build(n.Node)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + inner, nil
+	case *Tag:
+		// Match files with the tag or any of its descendants
+		return "f.id IN (SELECT ft.file_id FROM file_tags ft WHERE ft.tag_id IN (" +
+			"WITH RECURSIVE subtags(id) AS (" +
+			"SELECT id FROM tags WHERE name = " + b.arg(n.Name) + " " +
+			"UNION SELECT t.id FROM tags t INNER JOIN subtags s ON t.parent_id = s.id" +
+			") SELECT id FROM subtags))", nil
+	case *Date:
+		return b.buildDate("f.document_date", n), nil
+	case *Name:
+		return fmt.Sprintf(
+			"f.filename %s %s ESCAPE '\\'",
+			b.dialect.Like(), b.arg("%"+escapeLike(n.Contains)+"%"),
+		), nil
+	case *Field:
+		return b.buildField(n), nil
+	default:
+		return "", fmt.Errorf("unknown query node %T", node)
+	}
+}
+
+func (b *sqlBuilder) buildDate(column string, n *Date) string {
+	column = b.dialect.Date(column)
+
+	var conds []string
+	if !n.From.IsZero() {
+		conds = append(conds, column+" >= "+b.arg(b.dialect.DateArg(n.From)))
+	}
+	if !n.To.IsZero() {
+		conds = append(conds, column+" < "+b.arg(b.dialect.DateArg(n.To)))
+	}
+	return "(" + strings.Join(conds, " AND ") + ")"
+}
+
+func (b *sqlBuilder) buildField(n *Field) string {
+	res := "f.id IN (SELECT ff.file_id FROM file_fields ff WHERE ff.name = " + b.arg(n.Name)
+	if n.Op == "" {
+		return res + ")"
+	}
+
+	typeCond := func(typ records.FieldType) string {
+		return fmt.Sprintf("ff.field_type = %d AND ", typ)
+	}
+
+	// Only compare against the types of fields the value can be parsed
+	// as, the same way Field.Match does.
+	value := parseFieldValue(n.Op, n.Value)
+	conds := []string{
+		"(" + typeCond(records.FIELD_TYPE_STRING) +
+			"lower(ff.string_value) " + n.Op + " lower(" + b.arg(n.Value) + "))",
+	}
+	if value.number != nil {
+		conds = append(conds, "("+typeCond(records.FIELD_TYPE_NUMBER)+
+			"ff.number_value "+n.Op+" "+b.arg(*value.number)+")")
+	}
+	if value.date != nil {
+		conds = append(conds, "("+typeCond(records.FIELD_TYPE_DATE)+
+			b.buildDate("ff.date_value", value.date)+")")
+	}
+	if value.money != nil {
+		cond := "(" + typeCond(records.FIELD_TYPE_MONEY) +
+			"ff.money_amount " + n.Op + " " + b.arg(value.money.Amount)
+		if value.money.Currency != "" {
+			cond += " AND ff.money_currency = " + b.arg(value.money.Currency)
+		}
+		conds = append(conds, cond+")")
+	}
+
+	return res + " AND (" + strings.Join(conds, " OR ") + "))"
+}
+
+func (b *sqlBuilder) buildBinary(op string, left, right Node) (string, error) {
+	leftSQL, err := b.build(left)
+	if err != nil {
+		return "", err
+	}
+	rightSQL, err := b.build(right)
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + leftSQL + " " + op + " " + rightSQL + ")", nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// SQL compiles the query into a condition for a WHERE clause and the
+// arguments for its placeholders.
+func SQL(node Node, dialect Dialect) (string, []interface{}, error) {
+	b := &sqlBuilder{dialect: dialect}
+
+	where, err := b.build(node)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return where, b.args, nil
+}
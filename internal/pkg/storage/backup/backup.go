@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 
@@ -25,6 +26,10 @@ type Driver interface {
 	WriteFile(*scproto.TaggedFile) error
 	WriteData(string, io.Reader) error
 	WriteTag(*scproto.Tag) error
+
+	// FindData opens the backed up contents of a file with the SHA-256
+	// hash, returning errors.ErrNotFound if no backed up file has it.
+	FindData(hash string) (io.ReadCloser, error)
 }
 
 func NewBackup(root string) (*Backup, error) {
@@ -67,7 +72,39 @@ func CreateBackup(root string) (*Backup, error) {
 }
 
 func LoadBackup(root string) (*Backup, error) {
-	return nil, fmt.Errorf("not implemented")
+	mf, err := readManifest(path.Join(root, "softcopy.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	if mf.Version != 1 {
+		return nil, fmt.Errorf("unsupported backup version %v", mf.Version)
+	}
+
+	driver, err := v1.NewDriver(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Backup{
+		driver:  driver,
+		rootDir: root,
+	}, nil
+}
+
+func readManifest(path string) (*manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read backup manifest: %s", err)
+	}
+
+	mf := &manifest{}
+	err = json.Unmarshal(data, mf)
+	if err != nil {
+		return nil, fmt.Errorf("could not read backup manifest: %s", err)
+	}
+
+	return mf, nil
 }
 
 func writeManifest(path string) error {
@@ -109,3 +146,9 @@ func (b *Backup) WriteFile(file *scproto.TaggedFile) error {
 func (b *Backup) WriteData(id string, data io.Reader) error {
 	return b.driver.WriteData(id, data)
 }
+
+// FindData opens the backed up contents of a file with the SHA-256 hash,
+// returning errors.ErrNotFound if no backed up file has it.
+func (b *Backup) FindData(hash string) (io.ReadCloser, error) {
+	return b.driver.FindData(hash)
+}
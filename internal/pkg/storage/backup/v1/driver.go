@@ -4,12 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
 
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/protoutil"
 	scproto "github.com/aphistic/softcopy/pkg/proto"
 )
 
@@ -69,6 +73,26 @@ func (d *Driver) WriteFile(file *scproto.TaggedFile) error {
 		tags = append(tags, tag.GetName())
 	}
 
+	fields := []*fileField{}
+	for _, field := range file.Fields {
+		fields = append(fields, &fileField{
+			Name:  field.GetName(),
+			Type:  protoutil.ProtoToFieldType(field.GetType()).String(),
+			Value: field.GetValue(),
+		})
+	}
+
+	links := []*fileLink{}
+	for _, link := range file.Links {
+		if link.GetSourceId() != file.File.GetId() {
+			continue
+		}
+		links = append(links, &fileLink{
+			TargetID: link.GetTargetId(),
+			Type:     protoutil.ProtoToLinkType(link.GetType()).String(),
+		})
+	}
+
 	docDate, err := types.TimestampFromProto(file.File.GetDocumentDate())
 	if err != nil {
 		return err
@@ -84,7 +108,9 @@ func (d *Driver) WriteFile(file *scproto.TaggedFile) error {
 		DocumentDate: docDate.Format(time.RFC3339Nano),
 		Size:         float64(file.File.GetContentSize()),
 
-		Tags: tags,
+		Tags:   tags,
+		Fields: fields,
+		Links:  links,
 	}
 
 	rawFile, err := json.Marshal(fileData)
@@ -153,6 +179,53 @@ func (d *Driver) WriteData(id string, data io.Reader) error {
 	return nil
 }
 
+// FindData opens the backed up contents of the first file with the hash
+// that has them.
+func (d *Driver) FindData(hash string) (io.ReadCloser, error) {
+	fileRoot := path.Join(d.rootPath, "files")
+
+	infos, err := ioutil.ReadDir(fileRoot)
+	if os.IsNotExist(err) {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".json") {
+			continue
+		}
+
+		rawFile, err := ioutil.ReadFile(path.Join(fileRoot, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		fileData := &fileItem{}
+		err = json.Unmarshal(rawFile, fileData)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %s", info.Name(), err)
+		}
+
+		if fileData.Hash == nil ||
+			fileData.Hash.Type != "sha256" ||
+			fileData.Hash.Value != hash {
+			continue
+		}
+
+		f, err := os.Open(path.Join(d.rootPath, "data", fileData.ID+".dat"))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return f, nil
+	}
+
+	return nil, scerrors.ErrNotFound
+}
+
 func (d *Driver) WriteTag(tag *scproto.Tag) error {
 	return fmt.Errorf("not implemented")
 }
@@ -7,7 +7,22 @@ type fileItem struct {
 	DocumentDate string    `json:"document_date"`
 	Size         float64   `json:"size"`
 
-	Tags []string `json:"tags"`
+	Tags   []string     `json:"tags"`
+	Fields []*fileField `json:"fields,omitempty"`
+	// Links are the links from the file, links to it are in the backup
+	// of the file they're from.
+	Links []*fileLink `json:"links,omitempty"`
+}
+
+type fileLink struct {
+	TargetID string `json:"target_id"`
+	Type     string `json:"type"`
+}
+
+type fileField struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
 type fileHash struct {
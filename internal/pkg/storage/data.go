@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/aphistic/softcopy/internal/pkg/query"
 	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 )
 
@@ -13,12 +14,31 @@ type Data interface {
 	CreateFileWithID(string, time.Time, uuid.UUID) error
 	CreateFileWithTags(string, time.Time, []string) (uuid.UUID, error)
 	CreateFileWithIDAndTags(string, time.Time, uuid.UUID, []string) error
+	// CreateFileWithSource creates an unfiled file recording what added it,
+	// such as records.FileSourceFuse or an importer's name, and the path
+	// it had there.
+	CreateFileWithSource(filename string, documentDate time.Time, source string, originalPath string) (uuid.UUID, error)
 
+	// RemoveFile moves a file to the trash, which hides it from everything
+	// but the trash functions until it's restored or purged.
 	RemoveFile(uuid.UUID) error
+	// ListTrash returns the files in the trash, oldest removal first.
+	ListTrash() ([]*records.TrashedFile, error)
+	// RestoreFile moves a file out of the trash. It returns
+	// errors.ErrExists if another file now has the same name and date.
+	RestoreFile(uuid.UUID) error
+	// PurgeFile permanently removes a file in the trash.
+	PurgeFile(uuid.UUID) error
 
 	FindFilesWithDate(time.Time) ([]*records.File, error)
+	// FindFilesWithTags finds files with all of the tags, where files
+	// tagged with a descendant of a tag also count as having that tag.
 	FindFilesWithTags(tagNames []string) ([]*records.File, error)
 	FindFilesWithIdPrefix(idPrefix string) ([]*records.File, error)
+	QueryFiles(query.Node) ([]*records.File, error)
+	// ListFiles returns a sorted page of files and the total number of
+	// files matching the options' query.
+	ListFiles(*ListFilesOptions) (*FilePage, error)
 
 	GetFileWithDate(string, time.Time) (*records.File, error)
 
@@ -29,18 +49,98 @@ type Data interface {
 	AllFiles() (records.FileIterator, error)
 	GetFile(id uuid.UUID) (*records.File, error)
 	GetFileByHash(hash string) (*records.File, error)
-	UpdateFile(*records.File) error
+	// FindDuplicates groups files that have the same contents, ordered
+	// by hash with the oldest file in each group first. Files in the trash
+	// aren't included.
+	FindDuplicates() ([]*records.DuplicateGroup, error)
+	// UpdateFile atomically changes the filename, document date, title and
+	// notes of a file, sets the given fields and removes the fields in
+	// removedFields. The file's Revision must match the stored revision or
+	// ErrStaleRevision is returned. The new revision is returned.
+	UpdateFile(file *records.File, fields []*records.Field, removedFields []string) (int, error)
 
 	UpdateFileHash(uuid.UUID, string) error
+	// AddFileVersion updates the file's hash and records the new contents
+	// as the file's newest version, returning the version number.
+	AddFileVersion(id uuid.UUID, hash string, source string) (int, error)
+	// GetFileVersions returns the versions of a file, oldest first.
+	GetFileVersions(uuid.UUID) ([]*records.FileVersion, error)
+	GetFileVersion(id uuid.UUID, version int) (*records.FileVersion, error)
 	UpdateFileDate(uuid.UUID, string, time.Time) error
 
+	// GetFileFields returns the custom fields of a file ordered by name.
+	GetFileFields(uuid.UUID) ([]*records.Field, error)
+	// SetFileFields sets custom fields on a file, replacing the values of
+	// fields with the same names, and removes the fields in removedNames.
+	SetFileFields(id uuid.UUID, fields []*records.Field, removedNames []string) error
+
+	// CreateFileLink links the source file to the target file. It returns
+	// errors.ErrNotFound if either file doesn't exist and errors.ErrExists
+	// if the files already have a link of the same type.
+	CreateFileLink(sourceID uuid.UUID, targetID uuid.UUID, linkType records.LinkType) error
+	RemoveFileLink(sourceID uuid.UUID, targetID uuid.UUID, linkType records.LinkType) error
+	// GetFileLinks returns the links from and to a file, oldest first.
+	// Links to files in the trash are left out until they're restored.
+	GetFileLinks(uuid.UUID) ([]*records.FileLink, error)
+
 	AllTags() (records.TagIterator, error)
 	GetTags([]string) ([]*records.Tag, error)
 	GetTagsForFile(uuid.UUID) (records.TagIterator, error)
 	FindTagByName(string) (*records.Tag, error)
+	// CreateTags creates any of the tags that don't exist yet, along with
+	// their missing ancestors, and returns the ids of all the tags.
 	CreateTags([]string) ([]uuid.UUID, error)
 	UpdateFileTags(id uuid.UUID, addedTags []string, removedTags []string) error
+	// RenameTag renames a tag and all of its descendants. Renaming to a
+	// different parent moves the tag under that parent.
+	RenameTag(oldName string, newName string) error
+	// MergeTags moves all files tagged with sourceName to targetName and
+	// removes sourceName. It returns errors.ErrInUse if sourceName has
+	// child tags.
+	MergeTags(sourceName string, targetName string) error
+	// DeleteTag removes a tag, returning errors.ErrInUse if any files or
+	// child tags still use it.
+	DeleteTag(name string) error
+
+	AllTagCategories() ([]*records.TagCategory, error)
+	GetTagCategory(uuid.UUID) (*records.TagCategory, error)
+	CreateTagCategory(name string, color string) (uuid.UUID, error)
+	UpdateTagCategory(*records.TagCategory) error
+	RemoveTagCategory(uuid.UUID) error
+	// SetTagCategory sets the category of a tag, or removes it when
+	// categoryID is uuid.Nil.
+	SetTagCategory(tagName string, categoryID uuid.UUID) error
 
 	FindMetadataByHash(hash string) (*records.FileMetadata, error)
 	CreateMetadataWithID(string, uint64, uuid.UUID) error
+	// CreateMetadataWithStoredSize creates metadata for contents that take
+	// up storedSize bytes in the files engine instead of their fileSize,
+	// such as when they're compressed. CreateMetadataWithID stores them as
+	// taking up their fileSize.
+	CreateMetadataWithStoredSize(hash string, fileSize uint64, storedSize uint64, id uuid.UUID) error
+	AllMetadata() ([]*records.FileMetadata, error)
+	// FindOrphanedMetadata finds metadata whose hash isn't used by any
+	// file, including files in the trash and earlier file versions.
+	FindOrphanedMetadata() ([]*records.FileMetadata, error)
+	// SetMetadataScrubResult records what checking the contents with the
+	// metadata against their hash found. It returns errors.ErrNotFound if
+	// the metadata doesn't exist.
+	SetMetadataScrubResult(id uuid.UUID, status records.ScrubStatus, scrubbed time.Time) error
+	// RemoveMetadata removes orphaned metadata along with its indexed
+	// contents, returning errors.ErrInUse if its hash is still used.
+	RemoveMetadata(uuid.UUID) error
+
+	SetFileContents(hash string, contents string) error
+	SearchFiles(query string) ([]*records.SearchHit, error)
+
+	// AddAuditEntry appends an entry to the audit log. Entries are never
+	// changed or removed once they're added.
+	AddAuditEntry(*records.AuditEntry) error
+	// GetAuditLog returns audit entries newest first, only for the given
+	// file unless fileID is uuid.Nil. A limit less than one returns all
+	// of the entries.
+	GetAuditLog(fileID uuid.UUID, limit int) ([]*records.AuditEntry, error)
+
+	// GetStats counts the files, tags and stored contents.
+	GetStats() (*records.Stats, error)
 }
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) AddAuditEntry(entry *records.AuditEntry) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	added := *entry
+	added.ID = int64(len(c.auditLog) + 1)
+	added.Created = added.Created.UTC()
+	c.auditLog = append(c.auditLog, &added)
+
+	return nil
+}
+
+func (c *Client) GetAuditLog(fileID uuid.UUID, limit int) ([]*records.AuditEntry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	res := []*records.AuditEntry{}
+	for idx := len(c.auditLog) - 1; idx >= 0; idx-- {
+		if limit > 0 && len(res) >= limit {
+			break
+		}
+
+		entry := c.auditLog[idx]
+		if fileID != uuid.Nil && entry.FileID != fileID {
+			continue
+		}
+
+		resEntry := *entry
+		res = append(res, &resEntry)
+	}
+
+	return res, nil
+}
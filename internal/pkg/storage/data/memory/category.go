@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// categoryNameExists checks if a category other than excludeID already has
+// the given name. The caller must hold the lock.
+func (c *Client) categoryNameExists(name string, excludeID uuid.UUID) bool {
+	for _, category := range c.categories {
+		if category.Name == name && category.ID != excludeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) AllTagCategories() ([]*records.TagCategory, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	res := []*records.TagCategory{}
+	for _, category := range c.categories {
+		res = append(res, copyTagCategory(category))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+func (c *Client) GetTagCategory(id uuid.UUID) (*records.TagCategory, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	category, ok := c.categories[id]
+	if !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	return copyTagCategory(category), nil
+}
+
+func (c *Client) CreateTagCategory(name string, color string) (uuid.UUID, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.categoryNameExists(name, uuid.Nil) {
+		return uuid.Nil, scerrors.ErrExists
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	c.categories[id] = &records.TagCategory{
+		ID:    id,
+		Name:  name,
+		Color: color,
+	}
+
+	return id, nil
+}
+
+func (c *Client) UpdateTagCategory(category *records.TagCategory) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	existing, ok := c.categories[category.ID]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	if c.categoryNameExists(category.Name, category.ID) {
+		return scerrors.ErrExists
+	}
+
+	existing.Name = category.Name
+	existing.Color = category.Color
+
+	return nil
+}
+
+func (c *Client) RemoveTagCategory(id uuid.UUID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.categories[id]; !ok {
+		return scerrors.ErrNotFound
+	}
+
+	for _, tag := range c.tags {
+		if tag.Category != nil && tag.Category.ID == id {
+			tag.Category = nil
+		}
+	}
+	delete(c.categories, id)
+
+	return nil
+}
+
+func (c *Client) SetTagCategory(tagName string, categoryID uuid.UUID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var category *records.TagCategory
+	if categoryID != uuid.Nil {
+		var ok bool
+		category, ok = c.categories[categoryID]
+		if !ok {
+			return scerrors.ErrNotFound
+		}
+	}
+
+	tag, ok := c.findTag(tagName)
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	tag.Category = category
+
+	return nil
+}
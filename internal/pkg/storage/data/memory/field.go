@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) GetFileFields(id uuid.UUID) ([]*records.Field, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if _, ok := c.files[id]; !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	res := []*records.Field{}
+	for _, field := range c.fileFields[id] {
+		res = append(res, copyField(field))
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+func (c *Client) SetFileFields(id uuid.UUID, fields []*records.Field, removedNames []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	f, ok := c.files[id]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	c.setFileFields(id, fields, removedNames)
+	f.Revision++
+	f.Updated = time.Now().UTC()
+
+	return nil
+}
+
+// setFileFields sets and removes fields of a file. The caller must hold
+// the lock.
+func (c *Client) setFileFields(id uuid.UUID, fields []*records.Field, removedNames []string) {
+	if _, ok := c.fileFields[id]; !ok {
+		c.fileFields[id] = map[string]*records.Field{}
+	}
+
+	for _, name := range removedNames {
+		delete(c.fileFields[id], name)
+	}
+	for _, field := range fields {
+		c.fileFields[id][field.Name] = copyField(field)
+	}
+}
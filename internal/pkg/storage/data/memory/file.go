@@ -0,0 +1,375 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// sameDay compares dates the same way the SQL engines do, by calendar
+// day in UTC.
+func sameDay(a, b time.Time) bool {
+	return a.UTC().Format("2006-01-02") == b.UTC().Format("2006-01-02")
+}
+
+func sortedInts(vals map[int]struct{}) []int {
+	res := []int{}
+	for val := range vals {
+		res = append(res, val)
+	}
+	sort.Ints(res)
+
+	return res
+}
+
+func (c *Client) GetFileYears() ([]int, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	years := map[int]struct{}{}
+	for _, f := range c.files {
+		years[f.DocumentDate.UTC().Year()] = struct{}{}
+	}
+
+	return sortedInts(years), nil
+}
+
+func (c *Client) GetFileMonths(year int) ([]int, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	months := map[int]struct{}{}
+	for _, f := range c.files {
+		docDate := f.DocumentDate.UTC()
+		if docDate.Year() == year {
+			months[int(docDate.Month())] = struct{}{}
+		}
+	}
+
+	return sortedInts(months), nil
+}
+
+func (c *Client) GetFileDays(year int, month int) ([]int, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	days := map[int]struct{}{}
+	for _, f := range c.files {
+		docDate := f.DocumentDate.UTC()
+		if docDate.Year() == year && int(docDate.Month()) == month {
+			days[docDate.Day()] = struct{}{}
+		}
+	}
+
+	return sortedInts(days), nil
+}
+
+func (c *Client) AllFiles() (records.FileIterator, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	files := c.filterFiles(func(*records.File) bool {
+		return true
+	})
+
+	return newMemoryFileIterator(files), nil
+}
+
+func (c *Client) GetFile(id uuid.UUID) (*records.File, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	f, ok := c.files[id]
+	if !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	return c.fileWithSize(f), nil
+}
+
+func (c *Client) GetFileByHash(hash string) (*records.File, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	files := c.filterFiles(func(f *records.File) bool {
+		return f.Hash == hash
+	})
+	if len(files) < 1 {
+		return nil, scerrors.ErrNotFound
+	}
+
+	return files[0], nil
+}
+
+func (c *Client) CreateFile(filename string, documentDate time.Time) (uuid.UUID, error) {
+	return c.CreateFileWithTags(
+		filename,
+		documentDate,
+		[]string{
+			consts.TagUnfiled,
+		},
+	)
+}
+
+func (c *Client) CreateFileWithID(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+) error {
+	return c.CreateFileWithIDAndTags(
+		filename,
+		documentDate,
+		fileID,
+		[]string{
+			consts.TagUnfiled,
+		},
+	)
+}
+
+func (c *Client) CreateFileWithTags(
+	filename string,
+	documentDate time.Time,
+	tagNames []string,
+) (uuid.UUID, error) {
+	fileID, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = c.CreateFileWithIDAndTags(
+		filename, documentDate,
+		fileID, tagNames,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return fileID, nil
+}
+
+func (c *Client) CreateFileWithIDAndTags(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+	tagNames []string,
+) error {
+	return c.createFile(filename, documentDate, fileID, tagNames, "", "")
+}
+
+func (c *Client) CreateFileWithSource(
+	filename string,
+	documentDate time.Time,
+	source string,
+	originalPath string,
+) (uuid.UUID, error) {
+	fileID, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = c.createFile(
+		filename, documentDate, fileID,
+		[]string{consts.TagUnfiled},
+		source, originalPath,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return fileID, nil
+}
+
+// createFile creates a file with the given tags, recording where it came
+// from.
+func (c *Client) createFile(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+	tagNames []string,
+	source string,
+	originalPath string,
+) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	tags, err := c.getTags(tagNames)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.files[fileID]; ok {
+		return scerrors.ErrExists
+	}
+	if _, ok := c.trash[fileID]; ok {
+		return scerrors.ErrExists
+	}
+
+	now := time.Now().UTC()
+
+	c.files[fileID] = &records.File{
+		ID:           fileID,
+		Filename:     filename,
+		DocumentDate: documentDate,
+		Revision:     1,
+		Created:      now,
+		Updated:      now,
+		Source:       source,
+		OriginalPath: originalPath,
+	}
+
+	fileTags := map[uuid.UUID]struct{}{}
+	for _, tag := range tags {
+		fileTags[tag.ID] = struct{}{}
+	}
+	c.fileTags[fileID] = fileTags
+
+	return nil
+}
+
+func (c *Client) UpdateFileHash(id uuid.UUID, hash string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	f, ok := c.files[id]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	f.Hash = hash
+	f.Revision++
+	f.Updated = time.Now().UTC()
+
+	return nil
+}
+
+func (c *Client) UpdateFileDate(id uuid.UUID, newFilename string, newDate time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, f := range c.files {
+		if f.ID != id && f.Filename == newFilename && sameDay(f.DocumentDate, newDate) {
+			return scerrors.ErrExists
+		}
+	}
+
+	f, ok := c.files[id]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	f.Filename = newFilename
+	f.DocumentDate = newDate
+	f.Revision++
+	f.Updated = time.Now().UTC()
+
+	return nil
+}
+
+func (c *Client) UpdateFile(
+	file *records.File,
+	fields []*records.Field,
+	removedFields []string,
+) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, f := range c.files {
+		if f.ID != file.ID && f.Filename == file.Filename && sameDay(f.DocumentDate, file.DocumentDate) {
+			return 0, scerrors.ErrExists
+		}
+	}
+
+	f, ok := c.files[file.ID]
+	if !ok {
+		return 0, scerrors.ErrNotFound
+	}
+	if f.Revision != file.Revision {
+		return 0, scerrors.ErrStaleRevision
+	}
+
+	f.Filename = file.Filename
+	f.DocumentDate = file.DocumentDate
+	f.Title = file.Title
+	f.Notes = file.Notes
+	f.Revision++
+	f.Updated = time.Now().UTC()
+
+	c.setFileFields(file.ID, fields, removedFields)
+
+	return f.Revision, nil
+}
+
+func (c *Client) RemoveFile(id uuid.UUID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	f, ok := c.files[id]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	delete(c.files, id)
+	c.trash[id] = &records.TrashedFile{
+		File:    f,
+		Deleted: time.Now().UTC(),
+	}
+
+	return nil
+}
+
+func (c *Client) GetFileWithDate(filename string, date time.Time) (*records.File, error) {
+	if filename == "" {
+		return nil, fmt.Errorf("empty file name")
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	files := c.filterFiles(func(f *records.File) bool {
+		return f.Filename == filename && sameDay(f.DocumentDate, date)
+	})
+	if len(files) < 1 {
+		return nil, scerrors.ErrNotFound
+	}
+
+	return files[0], nil
+}
+
+func (c *Client) FindFilesWithDate(documentDate time.Time) ([]*records.File, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.filterFiles(func(f *records.File) bool {
+		return sameDay(f.DocumentDate, documentDate)
+	}), nil
+}
+
+func (c *Client) FindFilesWithTags(tagNames []string) ([]*records.File, error) {
+	if len(tagNames) < 1 {
+		return []*records.File{}, nil
+	}
+
+	return c.QueryFiles(query.AllTags(tagNames...))
+}
+
+func (c *Client) FindFilesWithIdPrefix(idPrefix string) ([]*records.File, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	files := c.filterFiles(func(f *records.File) bool {
+		return strings.HasPrefix(f.ID.String(), idPrefix)
+	})
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ID.String() < files[j].ID.String()
+	})
+
+	return files, nil
+}
@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) CreateMetadataWithID(hash string, fileSize uint64, id uuid.UUID) error {
+	return c.CreateMetadataWithStoredSize(hash, fileSize, fileSize, id)
+}
+
+func (c *Client) CreateMetadataWithStoredSize(
+	hash string,
+	fileSize uint64,
+	storedSize uint64,
+	id uuid.UUID,
+) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.metadata[hash]; ok {
+		return scerrors.ErrExists
+	}
+
+	c.metadata[hash] = &records.FileMetadata{
+		ID:         id,
+		Hash:       hash,
+		FileSize:   fileSize,
+		StoredSize: storedSize,
+	}
+
+	return nil
+}
+
+func (c *Client) FindMetadataByHash(hash string) (*records.FileMetadata, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	md, ok := c.metadata[hash]
+	if !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	res := *md
+	return &res, nil
+}
+
+// hashReferenced checks if any file, including files in the trash, or
+// file version uses the hash.
+func (c *Client) hashReferenced(hash string) bool {
+	for _, f := range c.files {
+		if f.Hash == hash {
+			return true
+		}
+	}
+	for _, trashed := range c.trash {
+		if trashed.File.Hash == hash {
+			return true
+		}
+	}
+	for _, versions := range c.fileVersions {
+		for _, version := range versions {
+			if version.Hash == hash {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (c *Client) filterMetadata(keep func(*records.FileMetadata) bool) []*records.FileMetadata {
+	res := []*records.FileMetadata{}
+	for _, md := range c.metadata {
+		if !keep(md) {
+			continue
+		}
+
+		resMD := *md
+		res = append(res, &resMD)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Hash < res[j].Hash
+	})
+
+	return res
+}
+
+func (c *Client) AllMetadata() ([]*records.FileMetadata, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.filterMetadata(func(*records.FileMetadata) bool {
+		return true
+	}), nil
+}
+
+func (c *Client) FindOrphanedMetadata() ([]*records.FileMetadata, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.filterMetadata(func(md *records.FileMetadata) bool {
+		return !c.hashReferenced(md.Hash)
+	}), nil
+}
+
+func (c *Client) SetMetadataScrubResult(
+	id uuid.UUID,
+	status records.ScrubStatus,
+	scrubbed time.Time,
+) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, md := range c.metadata {
+		if md.ID != id {
+			continue
+		}
+
+		md.ScrubStatus = status
+		md.Scrubbed = scrubbed
+
+		return nil
+	}
+
+	return scerrors.ErrNotFound
+}
+
+func (c *Client) RemoveMetadata(id uuid.UUID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for hash, md := range c.metadata {
+		if md.ID != id {
+			continue
+		}
+
+		if c.hashReferenced(hash) {
+			return scerrors.ErrInUse
+		}
+
+		delete(c.metadata, hash)
+		delete(c.contents, hash)
+
+		return nil
+	}
+
+	return scerrors.ErrNotFound
+}
+
+func (c *Client) FindDuplicates() ([]*records.DuplicateGroup, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	byHash := map[string]*records.DuplicateGroup{}
+	for _, f := range c.files {
+		if f.Hash == "" {
+			continue
+		}
+
+		group, ok := byHash[f.Hash]
+		if !ok {
+			group = &records.DuplicateGroup{Hash: f.Hash}
+			byHash[f.Hash] = group
+		}
+		group.Files = append(group.Files, c.fileWithSize(f))
+	}
+
+	res := []*records.DuplicateGroup{}
+	for _, group := range byHash {
+		if len(group.Files) < 2 {
+			continue
+		}
+
+		sort.Slice(group.Files, func(i, j int) bool {
+			a, b := group.Files[i], group.Files[j]
+			if a.Created.Equal(b.Created) {
+				return a.ID.String() < b.ID.String()
+			}
+			return a.Created.Before(b.Created)
+		})
+		res = append(res, group)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Hash < res[j].Hash
+	})
+
+	return res, nil
+}
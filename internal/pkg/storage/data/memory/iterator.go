@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type memoryFileIterator struct {
+	files []*records.File
+
+	resChan chan *records.FileItem
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func newMemoryFileIterator(files []*records.File) *memoryFileIterator {
+	mfi := &memoryFileIterator{
+		files:     files,
+		resChan:   make(chan *records.FileItem),
+		closeChan: make(chan struct{}),
+	}
+
+	go mfi.worker()
+
+	return mfi
+}
+
+func (mfi *memoryFileIterator) worker() {
+	defer close(mfi.resChan)
+
+	for _, file := range mfi.files {
+		select {
+		case mfi.resChan <- &records.FileItem{File: file}:
+		case <-mfi.closeChan:
+			return
+		}
+	}
+}
+
+func (mfi *memoryFileIterator) Files() <-chan *records.FileItem {
+	return mfi.resChan
+}
+
+func (mfi *memoryFileIterator) Close() error {
+	mfi.closeOnce.Do(func() {
+		close(mfi.closeChan)
+	})
+	return nil
+}
+
+type memoryTagIterator struct {
+	tags []*records.Tag
+
+	resChan chan *records.TagItem
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func newMemoryTagIterator(tags []*records.Tag) *memoryTagIterator {
+	mti := &memoryTagIterator{
+		tags:      tags,
+		resChan:   make(chan *records.TagItem),
+		closeChan: make(chan struct{}),
+	}
+
+	go mti.worker()
+
+	return mti
+}
+
+func (mti *memoryTagIterator) worker() {
+	defer close(mti.resChan)
+
+	for _, tag := range mti.tags {
+		select {
+		case mti.resChan <- &records.TagItem{Tag: tag}:
+		case <-mti.closeChan:
+			return
+		}
+	}
+}
+
+func (mti *memoryTagIterator) Tags() <-chan *records.TagItem {
+	return mti.resChan
+}
+
+func (mti *memoryTagIterator) Close() error {
+	mti.closeOnce.Do(func() {
+		close(mti.closeChan)
+	})
+	return nil
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) CreateFileLink(sourceID uuid.UUID, targetID uuid.UUID, linkType records.LinkType) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, id := range []uuid.UUID{sourceID, targetID} {
+		if _, ok := c.files[id]; !ok {
+			return scerrors.ErrNotFound
+		}
+	}
+
+	for _, link := range c.fileLinks {
+		if link.SourceID == sourceID && link.TargetID == targetID && link.Type == linkType {
+			return scerrors.ErrExists
+		}
+	}
+
+	c.fileLinks = append(c.fileLinks, &records.FileLink{
+		SourceID: sourceID,
+		TargetID: targetID,
+		Type:     linkType,
+		Created:  time.Now().UTC(),
+	})
+
+	return nil
+}
+
+func (c *Client) RemoveFileLink(sourceID uuid.UUID, targetID uuid.UUID, linkType records.LinkType) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for idx, link := range c.fileLinks {
+		if link.SourceID == sourceID && link.TargetID == targetID && link.Type == linkType {
+			c.fileLinks = append(c.fileLinks[:idx], c.fileLinks[idx+1:]...)
+			return nil
+		}
+	}
+
+	return scerrors.ErrNotFound
+}
+
+func (c *Client) GetFileLinks(id uuid.UUID) ([]*records.FileLink, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if _, ok := c.files[id]; !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	res := []*records.FileLink{}
+	for _, link := range c.fileLinks {
+		if link.SourceID != id && link.TargetID != id {
+			continue
+		}
+		if _, ok := c.files[link.OtherID(id)]; !ok {
+			continue
+		}
+
+		copied := *link
+		res = append(res, &copied)
+	}
+
+	return res, nil
+}
+
+// removeFileLinks removes all of the links from and to a file. The caller
+// must hold the lock.
+func (c *Client) removeFileLinks(id uuid.UUID) {
+	kept := c.fileLinks[:0]
+	for _, link := range c.fileLinks {
+		if link.SourceID != id && link.TargetID != id {
+			kept = append(kept, link)
+		}
+	}
+	c.fileLinks = kept
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// compareFiles compares two files by the sort key and then by ID, the same
+// way the SQL engines order them.
+func compareFiles(key records.FileSort, a, b *records.File) int {
+	res := 0
+	switch key {
+	case records.FILE_SORT_FILENAME:
+		res = strings.Compare(a.Filename, b.Filename)
+	case records.FILE_SORT_SIZE:
+		if a.Size < b.Size {
+			res = -1
+		} else if a.Size > b.Size {
+			res = 1
+		}
+	case records.FILE_SORT_CREATED:
+		if a.Created.Before(b.Created) {
+			res = -1
+		} else if a.Created.After(b.Created) {
+			res = 1
+		}
+	default:
+		if a.DocumentDate.Before(b.DocumentDate) {
+			res = -1
+		} else if a.DocumentDate.After(b.DocumentDate) {
+			res = 1
+		}
+	}
+
+	if res == 0 {
+		res = strings.Compare(a.ID.String(), b.ID.String())
+	}
+
+	return res
+}
+
+func (c *Client) ListFiles(opts *storage.ListFilesOptions) (*storage.FilePage, error) {
+	switch opts.Sort {
+	case records.FILE_SORT_DOCUMENT_DATE, records.FILE_SORT_FILENAME,
+		records.FILE_SORT_SIZE, records.FILE_SORT_CREATED:
+	default:
+		return nil, fmt.Errorf("unknown sort key %d", opts.Sort)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	files := c.filterFiles(func(f *records.File) bool {
+		return opts.Query == nil || c.matchFile(opts.Query, f)
+	})
+
+	// compare orders files in the direction they're listed
+	compare := func(a, b *records.File) int {
+		res := compareFiles(opts.Sort, a, b)
+		if opts.Descending {
+			return -res
+		}
+		return res
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return compare(files[i], files[j]) < 0
+	})
+
+	res := &storage.FilePage{
+		Files: []*records.File{},
+		Total: len(files),
+	}
+	for _, f := range files {
+		if opts.After != nil && compare(f, opts.After) <= 0 {
+			continue
+		}
+		if opts.Limit > 0 && len(res.Files) == opts.Limit {
+			res.More = true
+			break
+		}
+
+		res.Files = append(res.Files, f)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+var unfiledTagID = uuid.Must(uuid.Parse("00000000-0000-0000-0001-000000000001"))
+
+// Client is a storage.Data that keeps everything in memory. It's intended
+// for tests and ephemeral servers, nothing is persisted when the process
+// exits.
+type Client struct {
+	lock sync.RWMutex
+
+	files map[uuid.UUID]*records.File
+	// trash holds removed files, which keep their tags, fields and
+	// versions until they're purged.
+	trash    map[uuid.UUID]*records.TrashedFile
+	metadata map[string]*records.FileMetadata
+	contents map[string]string
+	tags     map[uuid.UUID]*records.Tag
+	fileTags map[uuid.UUID]map[uuid.UUID]struct{}
+	// fileFields holds the custom fields of each file by name.
+	fileFields map[uuid.UUID]map[string]*records.Field
+	// fileVersions holds the versions of each file, oldest first.
+	fileVersions map[uuid.UUID][]*records.FileVersion
+	// fileLinks holds the links between files, oldest first.
+	fileLinks []*records.FileLink
+
+	// categories are shared with the tags they're assigned to, so
+	// updates are seen by every tag in the category.
+	categories map[uuid.UUID]*records.TagCategory
+
+	// auditLog holds audit entries oldest first.
+	auditLog []*records.AuditEntry
+}
+
+var _ storage.Data = &Client{}
+
+func NewClient() *Client {
+	return &Client{
+		files:    map[uuid.UUID]*records.File{},
+		trash:    map[uuid.UUID]*records.TrashedFile{},
+		metadata: map[string]*records.FileMetadata{},
+		contents: map[string]string{},
+		tags: map[uuid.UUID]*records.Tag{
+			unfiledTagID: {
+				ID:     unfiledTagID,
+				Name:   consts.TagUnfiled,
+				System: true,
+			},
+		},
+		fileTags:     map[uuid.UUID]map[uuid.UUID]struct{}{},
+		fileFields:   map[uuid.UUID]map[string]*records.Field{},
+		fileVersions: map[uuid.UUID][]*records.FileVersion{},
+		fileLinks:    []*records.FileLink{},
+		categories:   map[uuid.UUID]*records.TagCategory{},
+		auditLog:     []*records.AuditEntry{},
+	}
+}
+
+func copyFile(f *records.File) *records.File {
+	res := *f
+	return &res
+}
+
+func copyTag(t *records.Tag) *records.Tag {
+	res := *t
+	if t.Category != nil {
+		res.Category = copyTagCategory(t.Category)
+	}
+	return &res
+}
+
+func copyField(f *records.Field) *records.Field {
+	res := *f
+	return &res
+}
+
+func copyTagCategory(tc *records.TagCategory) *records.TagCategory {
+	res := *tc
+	return &res
+}
+
+// fileWithSize returns a copy of the file with the size filled in from
+// its metadata, the same way the SQL engines join on file_metadata.
+// The caller must hold the lock.
+func (c *Client) fileWithSize(f *records.File) *records.File {
+	res := copyFile(f)
+	res.Size = 0
+	if md, ok := c.metadata[f.Hash]; ok {
+		res.Size = md.FileSize
+	}
+	return res
+}
+
+// filterFiles returns copies of all files matching the filter sorted by
+// filename. The caller must hold the lock.
+func (c *Client) filterFiles(filter func(*records.File) bool) []*records.File {
+	res := []*records.File{}
+	for _, f := range c.files {
+		if filter(f) {
+			res = append(res, c.fileWithSize(f))
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Filename == res[j].Filename {
+			return res[i].ID.String() < res[j].ID.String()
+		}
+		return res[i].Filename < res[j].Filename
+	})
+
+	return res
+}
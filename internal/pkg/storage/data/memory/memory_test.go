@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+func TestClient(t *testing.T) {
+	storagetest.RunDataTests(t, func(t *testing.T) storage.Data {
+		return NewClient()
+	})
+}
@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) QueryFiles(q query.Node) ([]*records.File, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.filterFiles(func(f *records.File) bool {
+		return c.matchFile(q, f)
+	}), nil
+}
+
+// matchFile checks if the file matches the query. The caller must hold the
+// lock.
+func (c *Client) matchFile(q query.Node, f *records.File) bool {
+	tagNames := []string{}
+	for tagID := range c.fileTags[f.ID] {
+		if tag, ok := c.tags[tagID]; ok {
+			tagNames = append(tagNames, c.tagWithAncestors(tag)...)
+		}
+	}
+
+	fields := []*records.Field{}
+	for _, field := range c.fileFields[f.ID] {
+		fields = append(fields, field)
+	}
+
+	return query.Match(q, f, tagNames, fields)
+}
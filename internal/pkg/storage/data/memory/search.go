@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	"github.com/aphistic/softcopy/internal/pkg/storage/search"
+)
+
+func (c *Client) SetFileContents(hash string, contents string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.contents[hash] = contents
+
+	return nil
+}
+
+func (c *Client) SearchFiles(query string) ([]*records.SearchHit, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	hits := []*records.SearchHit{}
+
+	terms := search.Terms(query)
+	if len(terms) < 1 {
+		return hits, nil
+	}
+
+	files := c.filterFiles(func(f *records.File) bool {
+		_, ok := c.contents[f.Hash]
+		return ok
+	})
+	for _, f := range files {
+		contents := c.contents[f.Hash]
+
+		rank := search.Score(contents, terms)
+		if rank <= 0 {
+			continue
+		}
+
+		hits = append(hits, &records.SearchHit{
+			File:    f,
+			Rank:    rank,
+			Snippet: search.Snippet(contents, terms),
+		})
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Rank > hits[j].Rank
+	})
+
+	return hits, nil
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) GetStats() (*records.Stats, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	stats := &records.Stats{
+		Files:          len(c.files),
+		TrashedFiles:   len(c.trash),
+		Tags:           len(c.tags),
+		StoredContents: len(c.metadata),
+		Years:          []*records.YearStats{},
+	}
+
+	years := map[int]*records.YearStats{}
+	for _, f := range c.files {
+		if _, ok := c.fileTags[f.ID][unfiledTagID]; ok {
+			stats.InboxFiles++
+		}
+		if md, ok := c.metadata[f.Hash]; ok {
+			stats.LogicalBytes += md.FileSize
+		}
+
+		year := f.DocumentDate.UTC().Year()
+		if _, ok := years[year]; !ok {
+			years[year] = &records.YearStats{Year: year}
+			stats.Years = append(stats.Years, years[year])
+		}
+		years[year].Files++
+	}
+	sort.Slice(stats.Years, func(i, j int) bool {
+		return stats.Years[i].Year < stats.Years[j].Year
+	})
+
+	for _, md := range c.metadata {
+		stats.StoredBytes += md.StoredSize
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,315 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func sortTags(tags []*records.Tag) {
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+}
+
+// findTag returns the tag with the given name. The caller must hold
+// the lock.
+func (c *Client) findTag(name string) (*records.Tag, bool) {
+	for _, tag := range c.tags {
+		if tag.Name == name {
+			return tag, true
+		}
+	}
+	return nil, false
+}
+
+// getTags returns the tags with the given names. The caller must hold
+// the lock.
+func (c *Client) getTags(names []string) ([]*records.Tag, error) {
+	res := make([]*records.Tag, 0)
+	for _, name := range names {
+		tag, ok := c.findTag(name)
+		if !ok {
+			return nil, fmt.Errorf("could not find all tags specified")
+		}
+
+		res = append(res, copyTag(tag))
+	}
+
+	return res, nil
+}
+
+func (c *Client) AllTags() (records.TagIterator, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	tags := []*records.Tag{}
+	for _, tag := range c.tags {
+		tags = append(tags, copyTag(tag))
+	}
+	sortTags(tags)
+
+	return newMemoryTagIterator(tags), nil
+}
+
+func (c *Client) GetTags(names []string) ([]*records.Tag, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.getTags(names)
+}
+
+func (c *Client) GetTagsForFile(id uuid.UUID) (records.TagIterator, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	tags := []*records.Tag{}
+	for tagID := range c.fileTags[id] {
+		if tag, ok := c.tags[tagID]; ok {
+			tags = append(tags, copyTag(tag))
+		}
+	}
+	sortTags(tags)
+
+	return newMemoryTagIterator(tags), nil
+}
+
+func (c *Client) FindTagByName(name string) (*records.Tag, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	tag, ok := c.findTag(name)
+	if !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	return copyTag(tag), nil
+}
+
+// getOrCreateTag returns the named tag, creating it and any of its missing
+// ancestors. The caller must hold the lock.
+func (c *Client) getOrCreateTag(name string) (*records.Tag, error) {
+	if tag, ok := c.findTag(name); ok {
+		return tag, nil
+	}
+
+	parentID := uuid.Nil
+	if parentName := records.TagParentName(name); parentName != "" {
+		parent, err := c.getOrCreateTag(parentName)
+		if err != nil {
+			return nil, err
+		}
+		parentID = parent.ID
+	}
+
+	tagID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &records.Tag{
+		ID:       tagID,
+		Name:     name,
+		ParentID: parentID,
+	}
+	c.tags[tagID] = tag
+
+	return tag, nil
+}
+
+// hasChildTags checks if any tags have the given tag as their parent. The
+// caller must hold the lock.
+func (c *Client) hasChildTags(id uuid.UUID) bool {
+	for _, tag := range c.tags {
+		if tag.ParentID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// tagWithAncestors returns the name of the tag and all of its ancestors.
+// The caller must hold the lock.
+func (c *Client) tagWithAncestors(tag *records.Tag) []string {
+	names := []string{tag.Name}
+	for tag.ParentID != uuid.Nil {
+		parent, ok := c.tags[tag.ParentID]
+		if !ok {
+			break
+		}
+
+		names = append(names, parent.Name)
+		tag = parent
+	}
+
+	return names
+}
+
+func (c *Client) CreateTags(names []string) ([]uuid.UUID, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var ids []uuid.UUID
+	for _, name := range names {
+		// If the tag already exists this returns the current tag
+		tag, err := c.getOrCreateTag(name)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, tag.ID)
+	}
+
+	return ids, nil
+}
+
+func (c *Client) UpdateFileTags(id uuid.UUID, addedTags, removedTags []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	added, err := c.getTags(addedTags)
+	if err != nil {
+		return err
+	}
+
+	removed, err := c.getTags(removedTags)
+	if err != nil {
+		return err
+	}
+
+	f, ok := c.files[id]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	fileTags := c.fileTags[id]
+	for _, tag := range added {
+		fileTags[tag.ID] = struct{}{}
+	}
+	for _, tag := range removed {
+		delete(fileTags, tag.ID)
+	}
+	f.Revision++
+	f.Updated = time.Now().UTC()
+
+	return nil
+}
+
+// findModifiableTag finds a tag by name, returning errors.ErrNotPermitted
+// if it's a system tag. The caller must hold the lock.
+func (c *Client) findModifiableTag(name string) (*records.Tag, error) {
+	tag, ok := c.findTag(name)
+	if !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	if tag.System {
+		return nil, scerrors.ErrNotPermitted
+	}
+
+	return tag, nil
+}
+
+func (c *Client) RenameTag(oldName string, newName string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	tag, err := c.findModifiableTag(oldName)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(newName, oldName+records.TagSeparator) {
+		// A tag can't be moved under itself
+		return scerrors.ErrNotPermitted
+	}
+
+	if existing, ok := c.findTag(newName); ok && existing.ID != tag.ID {
+		return scerrors.ErrExists
+	}
+
+	parentID := uuid.Nil
+	if parentName := records.TagParentName(newName); parentName != "" {
+		parent, err := c.getOrCreateTag(parentName)
+		if err != nil {
+			return err
+		}
+		parentID = parent.ID
+	}
+
+	// Descendants keep their parents but their names need to start
+	// with the new name.
+	oldPrefix := oldName + records.TagSeparator
+	for _, child := range c.tags {
+		if strings.HasPrefix(child.Name, oldPrefix) {
+			child.Name = newName + records.TagSeparator + child.Name[len(oldPrefix):]
+		}
+	}
+
+	tag.Name = newName
+	tag.ParentID = parentID
+
+	return nil
+}
+
+func (c *Client) MergeTags(sourceName string, targetName string) error {
+	if sourceName == targetName {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	source, err := c.findModifiableTag(sourceName)
+	if err != nil {
+		return err
+	}
+
+	target, err := c.findModifiableTag(targetName)
+	if err != nil {
+		return err
+	}
+
+	if c.hasChildTags(source.ID) {
+		return scerrors.ErrInUse
+	}
+
+	for _, fileTags := range c.fileTags {
+		if _, ok := fileTags[source.ID]; ok {
+			delete(fileTags, source.ID)
+			fileTags[target.ID] = struct{}{}
+		}
+	}
+	delete(c.tags, source.ID)
+
+	return nil
+}
+
+func (c *Client) DeleteTag(name string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	tag, err := c.findModifiableTag(name)
+	if err != nil {
+		return err
+	}
+
+	if c.hasChildTags(tag.ID) {
+		return scerrors.ErrInUse
+	}
+
+	for _, fileTags := range c.fileTags {
+		if _, ok := fileTags[tag.ID]; ok {
+			return scerrors.ErrInUse
+		}
+	}
+	delete(c.tags, tag.ID)
+
+	return nil
+}
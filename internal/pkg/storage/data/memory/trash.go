@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) ListTrash() ([]*records.TrashedFile, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	res := []*records.TrashedFile{}
+	for _, trashed := range c.trash {
+		res = append(res, &records.TrashedFile{
+			File:    c.fileWithSize(trashed.File),
+			Deleted: trashed.Deleted,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Deleted.Equal(res[j].Deleted) {
+			return res[i].File.Filename < res[j].File.Filename
+		}
+		return res[i].Deleted.Before(res[j].Deleted)
+	})
+
+	return res, nil
+}
+
+func (c *Client) RestoreFile(id uuid.UUID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	trashed, ok := c.trash[id]
+	if !ok {
+		return scerrors.ErrNotFound
+	}
+
+	for _, f := range c.files {
+		if f.Filename == trashed.File.Filename &&
+			sameDay(f.DocumentDate, trashed.File.DocumentDate) {
+			return scerrors.ErrExists
+		}
+	}
+
+	delete(c.trash, id)
+	c.files[id] = trashed.File
+
+	return nil
+}
+
+func (c *Client) PurgeFile(id uuid.UUID) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.trash[id]; !ok {
+		return scerrors.ErrNotFound
+	}
+
+	delete(c.trash, id)
+	delete(c.fileTags, id)
+	delete(c.fileFields, id)
+	delete(c.fileVersions, id)
+	c.removeFileLinks(id)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// versionWithSize returns a copy of the version with the size filled in
+// from its metadata. The caller must hold the lock.
+func (c *Client) versionWithSize(v *records.FileVersion) *records.FileVersion {
+	res := *v
+	res.Size = 0
+	if md, ok := c.metadata[v.Hash]; ok {
+		res.Size = md.FileSize
+	}
+	return &res
+}
+
+func (c *Client) AddFileVersion(id uuid.UUID, hash string, source string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	f, ok := c.files[id]
+	if !ok {
+		return 0, scerrors.ErrNotFound
+	}
+
+	f.Hash = hash
+	f.Revision++
+	f.Updated = time.Now().UTC()
+
+	version := &records.FileVersion{
+		FileID:  id,
+		Version: len(c.fileVersions[id]) + 1,
+		Hash:    hash,
+		Created: time.Now().UTC(),
+		Source:  source,
+	}
+	c.fileVersions[id] = append(c.fileVersions[id], version)
+
+	return version.Version, nil
+}
+
+func (c *Client) GetFileVersions(id uuid.UUID) ([]*records.FileVersion, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if _, ok := c.files[id]; !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	res := []*records.FileVersion{}
+	for _, version := range c.fileVersions[id] {
+		res = append(res, c.versionWithSize(version))
+	}
+
+	return res, nil
+}
+
+func (c *Client) GetFileVersion(id uuid.UUID, version int) (*records.FileVersion, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if _, ok := c.files[id]; !ok {
+		return nil, scerrors.ErrNotFound
+	}
+
+	versions := c.fileVersions[id]
+	if version < 1 || version > len(versions) {
+		return nil, scerrors.ErrNotFound
+	}
+
+	return c.versionWithSize(versions[version-1]), nil
+}
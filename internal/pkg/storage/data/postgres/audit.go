@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) AddAuditEntry(entry *records.AuditEntry) error {
+	var fileID sql.NullString
+	if entry.FileID != uuid.Nil {
+		fileID = sql.NullString{String: entry.FileID.String(), Valid: true}
+	}
+
+	_, err := c.db.Exec(`
+		INSERT INTO audit_log (created, actor, action, file_id, before_value, after_value)
+		VALUES ($1, $2, $3, $4, $5, $6);
+	`,
+		entry.Created.UTC(), entry.Actor, entry.Action,
+		fileID, entry.Before, entry.After,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetAuditLog(fileID uuid.UUID, limit int) ([]*records.AuditEntry, error) {
+	query := `
+		SELECT id, created, actor, action, file_id, before_value, after_value
+		FROM audit_log
+	`
+	args := []interface{}{}
+	if fileID != uuid.Nil {
+		query += fmt.Sprintf("WHERE file_id = $%d ", len(args)+1)
+		args = append(args, fileID.String())
+	}
+	query += "ORDER BY id DESC "
+	if limit > 0 {
+		query += fmt.Sprintf("LIMIT $%d ", len(args)+1)
+		args = append(args, limit)
+	}
+
+	rows, err := c.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.AuditEntry{}
+	for rows.Next() {
+		entry := &records.AuditEntry{}
+		var entryFileID sql.NullString
+		err := rows.Scan(
+			&entry.ID,
+			&entry.Created,
+			&entry.Actor,
+			&entry.Action,
+			&entryFileID,
+			&entry.Before,
+			&entry.After,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if entryFileID.Valid {
+			entry.FileID, err = uuid.Parse(entryFileID.String)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res = append(res, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
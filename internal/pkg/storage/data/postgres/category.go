@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// isUniqueViolation checks if the error is from violating a unique
+// constraint or index.
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
+
+func scanTagCategory(row rowScanner) (*records.TagCategory, error) {
+	category := &records.TagCategory{}
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Color,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *Client) AllTagCategories() ([]*records.TagCategory, error) {
+	rows, err := c.db.Query(`
+		SELECT id, name, color FROM tag_categories
+		ORDER BY name;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.TagCategory{}
+	for rows.Next() {
+		category, err := scanTagCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, category)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *Client) GetTagCategory(id uuid.UUID) (*records.TagCategory, error) {
+	category, err := scanTagCategory(c.db.QueryRow(`
+		SELECT id, name, color FROM tag_categories
+		WHERE id = $1;
+	`, id))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *Client) CreateTagCategory(name string, color string) (uuid.UUID, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	_, err = c.db.Exec(`
+		INSERT INTO tag_categories (id, name, color) VALUES ($1, $2, $3);
+	`, id, name, color)
+	if isUniqueViolation(err) {
+		return uuid.Nil, scerrors.ErrExists
+	} else if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+func (c *Client) UpdateTagCategory(category *records.TagCategory) error {
+	res, err := c.db.Exec(`
+		UPDATE tag_categories SET name = $1, color = $2
+		WHERE id = $3;
+	`, category.Name, category.Color, category.ID)
+	if isUniqueViolation(err) {
+		return scerrors.ErrExists
+	} else if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	} else if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveTagCategory(id uuid.UUID) error {
+	// Tags in the category are cleared by the ON DELETE SET NULL on
+	// the foreign key.
+	res, err := c.db.Exec(`DELETE FROM tag_categories WHERE id = $1;`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	} else if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) SetTagCategory(tagName string, categoryID uuid.UUID) error {
+	var category interface{}
+	if categoryID != uuid.Nil {
+		_, err := c.GetTagCategory(categoryID)
+		if err != nil {
+			return err
+		}
+		category = categoryID
+	}
+
+	res, err := c.db.Exec(`
+		UPDATE tags SET category_id = $1 WHERE name = $2;
+	`, category, tagName)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	} else if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,607 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const selectFiles = `
+	SELECT
+		f.id,
+		f.filename,
+		f.document_date,
+		f.hash,
+		COALESCE(fm.file_size, 0) AS file_size,
+		f.title,
+		f.notes,
+		f.revision,
+		f.created_at,
+		f.updated_at,
+		f.source,
+		f.original_path
+	FROM files f
+	LEFT JOIN file_metadata fm ON f.hash = fm.hash
+`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanFile(row rowScanner) (*records.File, error) {
+	file := &records.File{}
+	err := row.Scan(
+		&file.ID,
+		&file.Filename,
+		&file.DocumentDate,
+		&file.Hash,
+		&file.Size,
+		&file.Title,
+		&file.Notes,
+		&file.Revision,
+		&file.Created,
+		&file.Updated,
+		&file.Source,
+		&file.OriginalPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func rowsToFiles(rows *sql.Rows) ([]*records.File, error) {
+	defer rows.Close()
+
+	files := []*records.File{}
+	for rows.Next() {
+		file, err := scanFile(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func rowsToInts(rows *sql.Rows) ([]int, error) {
+	defer rows.Close()
+
+	res := []int{}
+	for rows.Next() {
+		var val int
+		err := rows.Scan(&val)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, val)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type postgresFileIterator struct {
+	rows *sql.Rows
+
+	resChan chan *records.FileItem
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func newPostgresFileIterator(rows *sql.Rows) *postgresFileIterator {
+	pfi := &postgresFileIterator{
+		rows:      rows,
+		resChan:   make(chan *records.FileItem),
+		closeChan: make(chan struct{}),
+	}
+
+	go pfi.worker()
+
+	return pfi
+}
+
+func (pfi *postgresFileIterator) worker() {
+	defer func() {
+		close(pfi.resChan)
+		pfi.rows.Close()
+	}()
+
+	for pfi.rows.Next() {
+		res := &records.FileItem{}
+		file, err := scanFile(pfi.rows)
+		if err != nil {
+			res.Error = err
+		} else {
+			res.File = file
+		}
+
+		select {
+		case pfi.resChan <- res:
+		case <-pfi.closeChan:
+			return
+		}
+	}
+
+	if err := pfi.rows.Err(); err != nil {
+		select {
+		case pfi.resChan <- &records.FileItem{Error: err}:
+		case <-pfi.closeChan:
+		}
+	}
+}
+
+func (pfi *postgresFileIterator) Files() <-chan *records.FileItem {
+	return pfi.resChan
+}
+
+func (pfi *postgresFileIterator) Close() error {
+	pfi.closeOnce.Do(func() {
+		close(pfi.closeChan)
+	})
+	return nil
+}
+
+func (c *Client) GetFileYears() ([]int, error) {
+	rows, err := c.db.Query(`
+		SELECT DISTINCT
+			EXTRACT(YEAR FROM document_date AT TIME ZONE 'UTC')::int AS document_year
+		FROM files
+		WHERE deleted_at IS NULL
+		ORDER BY document_year;
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToInts(rows)
+}
+
+func (c *Client) GetFileMonths(year int) ([]int, error) {
+	rows, err := c.db.Query(`
+		SELECT DISTINCT
+			EXTRACT(MONTH FROM document_date AT TIME ZONE 'UTC')::int AS document_month
+		FROM files
+		WHERE
+			deleted_at IS NULL AND
+			EXTRACT(YEAR FROM document_date AT TIME ZONE 'UTC') = $1
+		ORDER BY document_month;
+	`, year)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToInts(rows)
+}
+
+func (c *Client) GetFileDays(year int, month int) ([]int, error) {
+	rows, err := c.db.Query(`
+		SELECT DISTINCT
+			EXTRACT(DAY FROM document_date AT TIME ZONE 'UTC')::int AS document_day
+		FROM files
+		WHERE
+			deleted_at IS NULL AND
+			EXTRACT(YEAR FROM document_date AT TIME ZONE 'UTC') = $1 AND
+			EXTRACT(MONTH FROM document_date AT TIME ZONE 'UTC') = $2
+		ORDER BY document_day;
+	`, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToInts(rows)
+}
+
+func (c *Client) AllFiles() (records.FileIterator, error) {
+	rows, err := c.db.Query(selectFiles + `
+		WHERE f.deleted_at IS NULL
+		ORDER BY f.filename;
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostgresFileIterator(rows), nil
+}
+
+func (c *Client) GetFile(id uuid.UUID) (*records.File, error) {
+	file, err := scanFile(c.db.QueryRow(selectFiles+`
+		WHERE f.id = $1 AND f.deleted_at IS NULL;
+	`, id))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (c *Client) GetFileByHash(hash string) (*records.File, error) {
+	file, err := scanFile(c.db.QueryRow(selectFiles+`
+		WHERE f.hash = $1 AND f.deleted_at IS NULL
+		ORDER BY f.filename
+		LIMIT 1;
+	`, hash))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (c *Client) CreateFile(filename string, documentDate time.Time) (uuid.UUID, error) {
+	return c.CreateFileWithTags(
+		filename,
+		documentDate,
+		[]string{
+			consts.TagUnfiled,
+		},
+	)
+}
+
+func (c *Client) CreateFileWithID(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+) error {
+	return c.CreateFileWithIDAndTags(
+		filename,
+		documentDate,
+		fileID,
+		[]string{
+			consts.TagUnfiled,
+		},
+	)
+}
+
+func (c *Client) CreateFileWithTags(
+	filename string,
+	documentDate time.Time,
+	tagNames []string,
+) (uuid.UUID, error) {
+	fileID, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = c.CreateFileWithIDAndTags(
+		filename, documentDate,
+		fileID, tagNames,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return fileID, nil
+}
+
+func (c *Client) CreateFileWithIDAndTags(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+	tagNames []string,
+) error {
+	return c.createFile(filename, documentDate, fileID, tagNames, "", "")
+}
+
+func (c *Client) CreateFileWithSource(
+	filename string,
+	documentDate time.Time,
+	source string,
+	originalPath string,
+) (uuid.UUID, error) {
+	fileID, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = c.createFile(
+		filename, documentDate, fileID,
+		[]string{consts.TagUnfiled},
+		source, originalPath,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return fileID, nil
+}
+
+// createFile creates a file with the given tags, recording where it came
+// from.
+func (c *Client) createFile(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+	tagNames []string,
+	source string,
+	originalPath string,
+) error {
+	// Get the tags we're adding first
+	tags, err := c.GetTags(tagNames)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	_, err = tx.Exec(`
+		INSERT INTO files (
+			id, filename, document_date, hash,
+			created_at, updated_at, source, original_path
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+	`,
+		fileID,
+		filename,
+		documentDate,
+		"",
+		now,
+		now,
+		source,
+		originalPath,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		_, err = tx.Exec(`
+			INSERT INTO file_tags (file_id, tag_id)
+			VALUES ($1, $2);
+		`,
+			fileID,
+			tag.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) UpdateFileHash(id uuid.UUID, hash string) error {
+	res, err := c.db.Exec(
+		"UPDATE files SET hash = $1, revision = revision + 1, updated_at = now() WHERE id = $2;",
+		hash, id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateFileDate(id uuid.UUID, newFilename string, newDate time.Time) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var existingID uuid.UUID
+	err = tx.QueryRow(`
+		SELECT id FROM files
+		WHERE
+			filename = $1 AND
+			(document_date AT TIME ZONE 'UTC')::date = $2::date AND
+			id <> $3 AND
+			deleted_at IS NULL;
+	`,
+		newFilename,
+		newDate.Format("2006-01-02"),
+		id,
+	).Scan(&existingID)
+	if err == nil {
+		return scerrors.ErrExists
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+
+	res, err := tx.Exec(`
+		UPDATE files SET
+			filename = $1, document_date = $2, revision = revision + 1,
+			updated_at = now()
+		WHERE id = $3 AND deleted_at IS NULL;
+	`,
+		newFilename,
+		newDate,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) UpdateFile(
+	file *records.File,
+	fields []*records.Field,
+	removedFields []string,
+) (int, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var existingID uuid.UUID
+	err = tx.QueryRow(`
+		SELECT id FROM files
+		WHERE
+			filename = $1 AND
+			(document_date AT TIME ZONE 'UTC')::date = $2::date AND
+			id <> $3 AND
+			deleted_at IS NULL;
+	`,
+		file.Filename,
+		file.DocumentDate.Format("2006-01-02"),
+		file.ID,
+	).Scan(&existingID)
+	if err == nil {
+		return 0, scerrors.ErrExists
+	} else if err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	var revision int
+	err = tx.QueryRow(`
+		UPDATE files SET
+			filename = $1,
+			document_date = $2,
+			title = $3,
+			notes = $4,
+			revision = revision + 1,
+			updated_at = now()
+		WHERE id = $5 AND revision = $6 AND deleted_at IS NULL
+		RETURNING revision;
+	`,
+		file.Filename,
+		file.DocumentDate,
+		file.Title,
+		file.Notes,
+		file.ID,
+		file.Revision,
+	).Scan(&revision)
+	if err == sql.ErrNoRows {
+		exists, err := fileExists(tx, file.ID)
+		if err != nil {
+			return 0, err
+		} else if exists {
+			return 0, scerrors.ErrStaleRevision
+		}
+		return 0, scerrors.ErrNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	err = setFileFields(tx, file.ID, fields, removedFields)
+	if err != nil {
+		return 0, err
+	}
+
+	return revision, tx.Commit()
+}
+
+func (c *Client) RemoveFile(id uuid.UUID) error {
+	res, err := c.db.Exec(
+		"UPDATE files SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL;",
+		time.Now().UTC(), id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) GetFileWithDate(filename string, date time.Time) (*records.File, error) {
+	if filename == "" {
+		return nil, fmt.Errorf("empty file name")
+	}
+
+	file, err := scanFile(c.db.QueryRow(selectFiles+`
+		WHERE
+			f.filename = $1 AND
+			(f.document_date AT TIME ZONE 'UTC')::date = $2::date AND
+			f.deleted_at IS NULL;
+	`, filename, date.Format("2006-01-02")))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (c *Client) FindFilesWithDate(documentDate time.Time) ([]*records.File, error) {
+	rows, err := c.db.Query(selectFiles+`
+		WHERE
+			(f.document_date AT TIME ZONE 'UTC')::date = $1::date AND
+			f.deleted_at IS NULL
+		ORDER BY f.filename;
+	`, documentDate.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToFiles(rows)
+}
+
+func (c *Client) FindFilesWithTags(tagNames []string) ([]*records.File, error) {
+	if len(tagNames) < 1 {
+		return []*records.File{}, nil
+	}
+
+	return c.QueryFiles(query.AllTags(tagNames...))
+}
+
+func (c *Client) FindFilesWithIdPrefix(idPrefix string) ([]*records.File, error) {
+	rows, err := c.db.Query(selectFiles+`
+		WHERE f.id::text LIKE $1 AND f.deleted_at IS NULL
+		ORDER BY f.id;
+	`, idPrefix+"%")
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToFiles(rows)
+}
@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) CreateMetadataWithID(hash string, fileSize uint64, id uuid.UUID) error {
+	return c.CreateMetadataWithStoredSize(hash, fileSize, fileSize, id)
+}
+
+func (c *Client) CreateMetadataWithStoredSize(
+	hash string,
+	fileSize uint64,
+	storedSize uint64,
+	id uuid.UUID,
+) error {
+	_, err := c.db.Exec(`
+		INSERT INTO file_metadata (id, hash, file_size, stored_size)
+		VALUES ($1, $2, $3, $4);
+	`,
+		id, hash, int64(fileSize), int64(storedSize),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const metadataColumns = `
+	fm.id, fm.hash, fm.file_size, fm.stored_size,
+	fm.scrub_status, fm.scrubbed_at
+`
+
+func scanMetadata(row rowScanner) (*records.FileMetadata, error) {
+	md := &records.FileMetadata{}
+	var scrubbed sql.NullTime
+	err := row.Scan(
+		&md.ID,
+		&md.Hash,
+		&md.FileSize,
+		&md.StoredSize,
+		&md.ScrubStatus,
+		&scrubbed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if scrubbed.Valid {
+		md.Scrubbed = scrubbed.Time
+	}
+
+	return md, nil
+}
+
+func (c *Client) FindMetadataByHash(hash string) (*records.FileMetadata, error) {
+	md, err := scanMetadata(c.db.QueryRow(`
+		SELECT `+metadataColumns+`
+		FROM file_metadata fm
+		WHERE hash = $1;
+	`, hash))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return md, nil
+}
+
+const metadataUnreferenced = `
+	NOT EXISTS (SELECT 1 FROM files f WHERE f.hash = fm.hash)
+	AND NOT EXISTS (SELECT 1 FROM file_versions fv WHERE fv.hash = fm.hash)
+`
+
+func (c *Client) queryMetadata(query string, args ...interface{}) ([]*records.FileMetadata, error) {
+	rows, err := c.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.FileMetadata{}
+	for rows.Next() {
+		md, err := scanMetadata(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, md)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Client) AllMetadata() ([]*records.FileMetadata, error) {
+	return c.queryMetadata(`
+		SELECT ` + metadataColumns + `
+		FROM file_metadata fm
+		ORDER BY hash;
+	`)
+}
+
+func (c *Client) FindOrphanedMetadata() ([]*records.FileMetadata, error) {
+	return c.queryMetadata(`
+		SELECT ` + metadataColumns + `
+		FROM file_metadata fm
+		WHERE ` + metadataUnreferenced + `
+		ORDER BY fm.hash;
+	`)
+}
+
+func (c *Client) SetMetadataScrubResult(
+	id uuid.UUID,
+	status records.ScrubStatus,
+	scrubbed time.Time,
+) error {
+	res, err := c.db.Exec(`
+		UPDATE file_metadata
+		SET scrub_status = $1, scrubbed_at = $2
+		WHERE id = $3;
+	`, status, scrubbed, id)
+	if err != nil {
+		return err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveMetadata(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var hash string
+	err = tx.QueryRow(
+		"SELECT hash FROM file_metadata WHERE id = $1 FOR UPDATE;",
+		id,
+	).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return scerrors.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	var orphaned bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM file_metadata fm
+			WHERE fm.id = $1 AND `+metadataUnreferenced+`
+		);
+	`, id).Scan(&orphaned)
+	if err != nil {
+		return err
+	}
+	if !orphaned {
+		return scerrors.ErrInUse
+	}
+
+	_, err = tx.Exec("DELETE FROM file_contents WHERE hash = $1;", hash)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM file_metadata WHERE id = $1;", id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) FindDuplicates() ([]*records.DuplicateGroup, error) {
+	rows, err := c.db.Query(selectFiles + `
+		WHERE f.deleted_at IS NULL AND f.hash IN (
+			SELECT hash FROM files
+			WHERE deleted_at IS NULL AND hash <> ''
+			GROUP BY hash
+			HAVING COUNT(*) > 1
+		)
+		ORDER BY f.hash, f.created_at, f.id;
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := rowsToFiles(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*records.DuplicateGroup{}
+	for _, file := range files {
+		if len(res) == 0 || res[len(res)-1].Hash != file.Hash {
+			res = append(res, &records.DuplicateGroup{Hash: file.Hash})
+		}
+		group := res[len(res)-1]
+		group.Files = append(group.Files, file)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// fileSortColumns are the expressions files are ordered by for each sort
+// key.
+var fileSortColumns = map[records.FileSort]string{
+	records.FILE_SORT_DOCUMENT_DATE: "f.document_date",
+	records.FILE_SORT_FILENAME:      "f.filename",
+	records.FILE_SORT_SIZE:          "COALESCE(fm.file_size, 0)",
+	records.FILE_SORT_CREATED:       "f.created_at",
+}
+
+// fileSortValue returns the value of the file's sort key in the form it's
+// compared with the sort column.
+func fileSortValue(sort records.FileSort, file *records.File) interface{} {
+	switch sort {
+	case records.FILE_SORT_FILENAME:
+		return file.Filename
+	case records.FILE_SORT_SIZE:
+		return int64(file.Size)
+	case records.FILE_SORT_CREATED:
+		return file.Created.UTC()
+	default:
+		return file.DocumentDate.UTC()
+	}
+}
+
+func (c *Client) ListFiles(opts *storage.ListFilesOptions) (*storage.FilePage, error) {
+	column, ok := fileSortColumns[opts.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort key %d", opts.Sort)
+	}
+
+	where := "f.deleted_at IS NULL"
+	args := []interface{}{}
+	if opts.Query != nil {
+		queryWhere, queryArgs, err := query.SQL(opts.Query, postgresDialect{})
+		if err != nil {
+			return nil, err
+		}
+
+		where += " AND " + queryWhere
+		args = queryArgs
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res := &storage.FilePage{
+		Files: []*records.File{},
+	}
+	err = tx.QueryRow("SELECT COUNT(*) FROM files f WHERE "+where, args...).Scan(&res.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	op, dir := ">", "ASC"
+	if opts.Descending {
+		op, dir = "<", "DESC"
+	}
+
+	if opts.After != nil {
+		where += fmt.Sprintf(
+			" AND (%s, f.id) %s ($%d, $%d)",
+			column, op, len(args)+1, len(args)+2,
+		)
+		args = append(args, fileSortValue(opts.Sort, opts.After), opts.After.ID)
+	}
+
+	limit := ""
+	if opts.Limit > 0 {
+		// Get an extra file to know if there are more pages
+		limit = fmt.Sprintf("LIMIT %d", opts.Limit+1)
+	}
+
+	rows, err := tx.Query(selectFiles+`
+		WHERE `+where+`
+		ORDER BY `+column+` `+dir+`, f.id `+dir+`
+		`+limit+`;
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Files, err = rowsToFiles(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Limit > 0 && len(res.Files) > opts.Limit {
+		res.Files = res.Files[:opts.Limit]
+		res.More = true
+	}
+
+	return res, nil
+}
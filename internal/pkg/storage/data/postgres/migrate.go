@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"time"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/data/postgres/migrations"
+	"github.com/aphistic/softcopy/internal/pkg/storage/migration"
+)
+
+func (c *Client) Migrations() ([]*migration.Migration, error) {
+	ms, err := migrations.NewVaultMigrationSource()
+	if err != nil {
+		return nil, err
+	}
+
+	return migration.Status(c.db, "postgres", ms)
+}
+
+// MigrateTo migrates the schema up or down to the version, returning the
+// number of migrations applied.
+func (c *Client) MigrateTo(version int) (int, error) {
+	ms, err := migrations.NewVaultMigrationSource()
+	if err != nil {
+		return 0, err
+	}
+
+	return migration.To(c.db, "postgres", ms, version)
+}
+
+// Snapshot dumps the database to a new file in dir with pg_dump, which
+// needs to be installed, returning the path of the dump. The dump is in
+// pg_dump's custom format, restore it with pg_restore.
+func (c *Client) Snapshot(dir string) (string, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	snapshotPath := path.Join(dir, fmt.Sprintf(
+		"softcopy-%s.dump",
+		time.Now().UTC().Format("20060102T150405.000Z"),
+	))
+	_, err = os.Stat(snapshotPath)
+	if err == nil {
+		return "", fmt.Errorf("snapshot %s already exists", snapshotPath)
+	}
+
+	out, err := exec.Command(
+		"pg_dump",
+		"--format=custom",
+		"--file="+snapshotPath,
+		"--dbname="+c.dsn,
+	).CombinedOutput()
+	if err != nil {
+		os.Remove(snapshotPath)
+		return "", fmt.Errorf("could not run pg_dump: %s: %s", err, out)
+	}
+
+	return snapshotPath, nil
+}
@@ -0,0 +1,40 @@
+-- +migrate Up
+CREATE TABLE files (
+    id UUID PRIMARY KEY,
+    filename TEXT NOT NULL,
+    document_date TIMESTAMPTZ NOT NULL,
+    hash TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX ix_files_filename ON files(filename);
+CREATE INDEX ix_files_document_date ON files(document_date);
+CREATE INDEX ix_files_hash ON files(hash);
+
+CREATE TABLE file_metadata (
+    id UUID PRIMARY KEY,
+    hash TEXT NOT NULL,
+    file_size BIGINT NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX ix_file_metadata_hash ON file_metadata(hash);
+
+CREATE TABLE tags (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    system BOOLEAN NOT NULL DEFAULT FALSE
+);
+CREATE UNIQUE INDEX ix_tags_name ON tags(name);
+INSERT INTO tags (id, name, system) VALUES (
+    '00000000-0000-0000-0001-000000000001', 'unfiled', TRUE
+);
+
+CREATE TABLE file_tags (
+    file_id UUID NOT NULL REFERENCES files(id),
+    tag_id UUID NOT NULL REFERENCES tags(id),
+    PRIMARY KEY (file_id, tag_id)
+);
+CREATE INDEX ix_file_tags_tag_id ON file_tags(tag_id);
+
+-- +migrate Down
+DROP TABLE file_tags;
+DROP TABLE tags;
+DROP TABLE file_metadata;
+DROP TABLE files;
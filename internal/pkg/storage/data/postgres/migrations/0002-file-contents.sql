@@ -0,0 +1,10 @@
+-- +migrate Up
+CREATE TABLE file_contents (
+    hash TEXT PRIMARY KEY,
+    contents TEXT NOT NULL
+);
+CREATE INDEX ix_file_contents_search ON file_contents
+    USING GIN (to_tsvector('simple', contents));
+
+-- +migrate Down
+DROP TABLE file_contents;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE tag_categories (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX ix_tag_categories_name ON tag_categories(name);
+
+ALTER TABLE tags ADD COLUMN category_id UUID NULL
+    REFERENCES tag_categories(id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE tags DROP COLUMN category_id;
+DROP TABLE tag_categories;
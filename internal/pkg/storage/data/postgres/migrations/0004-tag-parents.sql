@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE tags ADD COLUMN parent_id UUID NULL REFERENCES tags(id);
+CREATE INDEX ix_tags_parent_id ON tags(parent_id);
+
+-- +migrate Down
+ALTER TABLE tags DROP COLUMN parent_id;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE file_fields (
+    file_id UUID NOT NULL REFERENCES files(id),
+    name TEXT NOT NULL,
+    field_type INTEGER NOT NULL,
+    string_value TEXT NULL,
+    number_value DOUBLE PRECISION NULL,
+    date_value TIMESTAMPTZ NULL,
+    money_amount BIGINT NULL,
+    money_currency TEXT NULL,
+    PRIMARY KEY (file_id, name)
+);
+CREATE INDEX ix_file_fields_name ON file_fields(name);
+
+-- +migrate Down
+DROP TABLE file_fields;
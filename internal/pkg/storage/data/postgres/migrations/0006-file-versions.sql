@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE file_versions (
+    file_id UUID NOT NULL REFERENCES files(id),
+    version INTEGER NOT NULL,
+    hash TEXT NOT NULL,
+    created TIMESTAMPTZ NOT NULL,
+    source TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (file_id, version)
+);
+CREATE INDEX ix_file_versions_hash ON file_versions(hash);
+
+-- Existing contents become the first version of each file
+INSERT INTO file_versions (file_id, version, hash, created, source)
+SELECT id, 1, hash, NOW(), '' FROM files WHERE hash != '';
+
+-- +migrate Down
+DROP TABLE file_versions;
@@ -0,0 +1,12 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+CREATE INDEX ix_files_deleted_at ON files(deleted_at);
+
+-- +migrate Down
+DELETE FROM file_tags WHERE file_id IN (SELECT id FROM files WHERE deleted_at IS NOT NULL);
+DELETE FROM file_fields WHERE file_id IN (SELECT id FROM files WHERE deleted_at IS NOT NULL);
+DELETE FROM file_versions WHERE file_id IN (SELECT id FROM files WHERE deleted_at IS NOT NULL);
+DELETE FROM files WHERE deleted_at IS NOT NULL;
+
+DROP INDEX ix_files_deleted_at;
+ALTER TABLE files DROP COLUMN deleted_at;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- The audit log doesn't reference files so entries are kept after a
+-- file is purged.
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created TIMESTAMPTZ NOT NULL,
+    actor TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    file_id UUID,
+    before_value TEXT NOT NULL DEFAULT '',
+    after_value TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX ix_audit_log_file_id ON audit_log(file_id);
+
+-- +migrate Down
+DROP TABLE audit_log;
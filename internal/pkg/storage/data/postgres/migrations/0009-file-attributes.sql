@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN title TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE files DROP COLUMN revision;
+ALTER TABLE files DROP COLUMN notes;
+ALTER TABLE files DROP COLUMN title;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN created_at TIMESTAMPTZ;
+-- Files created before creation times were recorded use their document date
+UPDATE files SET created_at = document_date;
+ALTER TABLE files ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE files ALTER COLUMN created_at SET DEFAULT now();
+CREATE INDEX ix_files_created_at ON files(created_at);
+
+-- +migrate Down
+DROP INDEX ix_files_created_at;
+ALTER TABLE files DROP COLUMN created_at;
@@ -0,0 +1,12 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN updated_at TIMESTAMPTZ;
+UPDATE files SET updated_at = created_at;
+ALTER TABLE files ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE files ALTER COLUMN updated_at SET DEFAULT now();
+ALTER TABLE files ADD COLUMN source TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN original_path TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE files DROP COLUMN original_path;
+ALTER TABLE files DROP COLUMN source;
+ALTER TABLE files DROP COLUMN updated_at;
@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE file_links (
+    source_id UUID NOT NULL REFERENCES files(id),
+    target_id UUID NOT NULL REFERENCES files(id),
+    link_type INTEGER NOT NULL,
+    created TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (source_id, target_id, link_type)
+);
+CREATE INDEX ix_file_links_target_id ON file_links(target_id);
+
+-- +migrate Down
+DROP TABLE file_links;
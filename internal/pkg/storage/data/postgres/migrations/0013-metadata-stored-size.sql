@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE file_metadata ADD COLUMN stored_size BIGINT NOT NULL DEFAULT 0;
+UPDATE file_metadata SET stored_size = file_size;
+
+-- +migrate Down
+ALTER TABLE file_metadata DROP COLUMN stored_size;
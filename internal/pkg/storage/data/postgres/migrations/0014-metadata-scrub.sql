@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE file_metadata ADD COLUMN scrub_status INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE file_metadata ADD COLUMN scrubbed_at TIMESTAMPTZ NULL;
+
+-- +migrate Down
+ALTER TABLE file_metadata DROP COLUMN scrubbed_at;
+ALTER TABLE file_metadata DROP COLUMN scrub_status;
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"database/sql"
+
+	_ "github.com/lib/pq" // import postgres driver
+	"github.com/rubenv/sql-migrate"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/data/postgres/migrations"
+	"github.com/aphistic/softcopy/internal/pkg/storage/migration"
+)
+
+type Client struct {
+	dsn string
+	db  *sql.DB
+}
+
+var _ storage.Data = &Client{}
+
+func NewClient(dsn string) (*Client, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Client{
+		dsn: dsn,
+		db:  db,
+	}, nil
+}
+
+func (c *Client) Migrate() error {
+	ms, err := migrations.NewVaultMigrationSource()
+	if err != nil {
+		return err
+	}
+
+	status, err := migration.Status(c.db, "postgres", ms)
+	if err != nil {
+		return err
+	}
+	err = migration.Check(status)
+	if err != nil {
+		return err
+	}
+
+	_, err = migrate.Exec(c.db, "postgres", ms, migrate.Up)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) Close() error {
+	return c.db.Close()
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+// envTestDSN points the tests at a throwaway Postgres server. Each test
+// creates and drops its own schema so the database itself is left untouched.
+const envTestDSN = "SOFTCOPY_TEST_POSTGRES_DSN"
+
+func newTestClient(t *testing.T) *Client {
+	dsn := os.Getenv(envTestDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", envTestDSN)
+	}
+
+	admin, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+
+	schema := "softcopy_test_" + strings.Replace(uuid.New().String(), "-", "", -1)
+	_, err = admin.Exec(fmt.Sprintf("CREATE SCHEMA %s;", schema))
+	require.NoError(t, err)
+
+	if strings.Contains(dsn, "://") {
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		dsn = dsn + sep + "search_path=" + schema
+	} else {
+		dsn = dsn + " search_path=" + schema
+	}
+
+	c, err := NewClient(dsn)
+	require.NoError(t, err)
+	require.NoError(t, c.Migrate())
+
+	t.Cleanup(func() {
+		c.Close()
+		admin.Exec(fmt.Sprintf("DROP SCHEMA %s CASCADE;", schema))
+		admin.Close()
+	})
+
+	return c
+}
+
+func TestClient(t *testing.T) {
+	storagetest.RunDataTests(t, func(t *testing.T) storage.Data {
+		return newTestClient(t)
+	})
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type postgresDialect struct{}
+
+func (postgresDialect) Placeholder(n int) string        { return "$" + strconv.Itoa(n) }
+func (postgresDialect) Date(column string) string       { return column }
+func (postgresDialect) DateArg(t time.Time) interface{} { return t.UTC() }
+func (postgresDialect) Like() string                    { return "ILIKE" }
+
+func (c *Client) QueryFiles(q query.Node) ([]*records.File, error) {
+	where, args, err := query.SQL(q, postgresDialect{})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.Query(selectFiles+`
+		WHERE f.deleted_at IS NULL AND `+where+`
+		ORDER BY f.filename;
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToFiles(rows)
+}
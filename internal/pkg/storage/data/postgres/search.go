@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	"github.com/aphistic/softcopy/internal/pkg/storage/search"
+)
+
+var headlineOptions = fmt.Sprintf(
+	"StartSel=%s, StopSel=%s, MinWords=8, MaxWords=24",
+	search.SnippetStart, search.SnippetEnd,
+)
+
+func (c *Client) SetFileContents(hash string, contents string) error {
+	_, err := c.db.Exec(`
+		INSERT INTO file_contents (hash, contents) VALUES ($1, $2)
+		ON CONFLICT (hash) DO UPDATE SET contents = EXCLUDED.contents;
+	`, hash, contents)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) SearchFiles(query string) ([]*records.SearchHit, error) {
+	terms := search.Terms(query)
+	if len(terms) < 1 {
+		return []*records.SearchHit{}, nil
+	}
+
+	rows, err := c.db.Query(`
+		SELECT
+			f.id,
+			f.filename,
+			f.document_date,
+			f.hash,
+			COALESCE(fm.file_size, 0) AS file_size,
+			f.title,
+			f.notes,
+			f.revision,
+			f.created_at,
+			f.updated_at,
+			f.source,
+			f.original_path,
+			ts_rank(to_tsvector('simple', fc.contents), q) AS rank,
+			ts_headline('simple', fc.contents, q, $2) AS snippet
+		FROM files f
+		INNER JOIN file_contents fc ON fc.hash = f.hash
+		LEFT JOIN file_metadata fm ON f.hash = fm.hash
+		CROSS JOIN plainto_tsquery('simple', $1) q
+		WHERE to_tsvector('simple', fc.contents) @@ q AND f.deleted_at IS NULL
+		ORDER BY rank DESC, f.filename;
+	`, strings.Join(terms, " "), headlineOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := []*records.SearchHit{}
+	for rows.Next() {
+		hit := &records.SearchHit{
+			File: &records.File{},
+		}
+		err = rows.Scan(
+			&hit.File.ID,
+			&hit.File.Filename,
+			&hit.File.DocumentDate,
+			&hit.File.Hash,
+			&hit.File.Size,
+			&hit.File.Title,
+			&hit.File.Notes,
+			&hit.File.Revision,
+			&hit.File.Created,
+			&hit.File.Updated,
+			&hit.File.Source,
+			&hit.File.OriginalPath,
+			&hit.Rank,
+			&hit.Snippet,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		hits = append(hits, hit)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,488 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const selectTags = `
+	SELECT
+		t.id,
+		t.name,
+		t.system,
+		t.parent_id,
+		tc.id,
+		tc.name,
+		tc.color
+	FROM tags t
+	LEFT JOIN tag_categories tc ON tc.id = t.category_id
+`
+
+func scanTag(row rowScanner) (*records.Tag, error) {
+	tag := &records.Tag{}
+
+	var parentID, catID, catName, catColor sql.NullString
+	err := row.Scan(
+		&tag.ID,
+		&tag.Name,
+		&tag.System,
+		&parentID,
+		&catID,
+		&catName,
+		&catColor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		id, err := uuid.Parse(parentID.String)
+		if err != nil {
+			return nil, err
+		}
+		tag.ParentID = id
+	}
+
+	if catID.Valid {
+		id, err := uuid.Parse(catID.String)
+		if err != nil {
+			return nil, err
+		}
+
+		tag.Category = &records.TagCategory{
+			ID:    id,
+			Name:  catName.String,
+			Color: catColor.String,
+		}
+	}
+
+	return tag, nil
+}
+
+type postgresTagIterator struct {
+	rows *sql.Rows
+
+	resChan chan *records.TagItem
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func newPostgresTagIterator(rows *sql.Rows) *postgresTagIterator {
+	pti := &postgresTagIterator{
+		rows:      rows,
+		resChan:   make(chan *records.TagItem),
+		closeChan: make(chan struct{}),
+	}
+
+	go pti.worker()
+
+	return pti
+}
+
+func (pti *postgresTagIterator) worker() {
+	defer func() {
+		close(pti.resChan)
+		pti.rows.Close()
+	}()
+
+	for pti.rows.Next() {
+		res := &records.TagItem{}
+		tag, err := scanTag(pti.rows)
+		if err != nil {
+			res.Error = err
+		} else {
+			res.Tag = tag
+		}
+
+		select {
+		case pti.resChan <- res:
+		case <-pti.closeChan:
+			return
+		}
+	}
+
+	if err := pti.rows.Err(); err != nil {
+		select {
+		case pti.resChan <- &records.TagItem{Error: err}:
+		case <-pti.closeChan:
+		}
+	}
+}
+
+func (pti *postgresTagIterator) Tags() <-chan *records.TagItem {
+	return pti.resChan
+}
+
+func (pti *postgresTagIterator) Close() error {
+	pti.closeOnce.Do(func() {
+		close(pti.closeChan)
+	})
+
+	return nil
+}
+
+func (c *Client) AllTags() (records.TagIterator, error) {
+	rows, err := c.db.Query(selectTags + `
+		ORDER BY t.name;
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostgresTagIterator(rows), nil
+}
+
+func (c *Client) GetTags(names []string) ([]*records.Tag, error) {
+	if len(names) == 0 {
+		return []*records.Tag{}, nil
+	}
+
+	rows, err := c.db.Query(selectTags+`
+		WHERE t.name = ANY($1);
+	`, pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*records.Tag, 0)
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, tag)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) != len(names) {
+		return nil, fmt.Errorf("could not find all tags specified")
+	}
+
+	return res, nil
+}
+
+func (c *Client) GetTagsForFile(id uuid.UUID) (records.TagIterator, error) {
+	rows, err := c.db.Query(selectTags+`
+		INNER JOIN file_tags ft ON ft.tag_id = t.id
+		WHERE ft.file_id = $1
+		ORDER BY t.name;
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPostgresTagIterator(rows), nil
+}
+
+func (c *Client) FindTagByName(name string) (*records.Tag, error) {
+	tag, err := scanTag(c.db.QueryRow(selectTags+`
+		WHERE t.name = $1;
+	`, name))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// getOrCreateTag returns the id of the named tag as part of a transaction,
+// creating it and any of its missing ancestors.
+func getOrCreateTag(tx *sql.Tx, name string) (uuid.UUID, error) {
+	var parentID interface{}
+	if parentName := records.TagParentName(name); parentName != "" {
+		id, err := getOrCreateTag(tx, parentName)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		parentID = id
+	}
+
+	newID, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// If the tag already exists the insert is skipped and the
+	// current id is returned instead.
+	_, err = tx.Exec(`
+		INSERT INTO tags (id, name, parent_id) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO NOTHING;
+	`, newID, name, parentID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var tagID uuid.UUID
+	err = tx.QueryRow(
+		"SELECT id FROM tags WHERE name = $1;",
+		name,
+	).Scan(&tagID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return tagID, nil
+}
+
+func (c *Client) CreateTags(names []string) ([]uuid.UUID, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var ids []uuid.UUID
+	for _, name := range names {
+		tagID, err := getOrCreateTag(tx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, tagID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (c *Client) UpdateFileTags(id uuid.UUID, addedTags, removedTags []string) error {
+	added, err := c.GetTags(addedTags)
+	if err != nil {
+		return err
+	}
+
+	removed, err := c.GetTags(removedTags)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, addedTag := range added {
+		_, err = tx.Exec(`
+			INSERT INTO file_tags (file_id, tag_id) VALUES ($1, $2)
+			ON CONFLICT DO NOTHING;
+		`, id, addedTag.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, removedTag := range removed {
+		_, err = tx.Exec(
+			"DELETE FROM file_tags WHERE file_id = $1 AND tag_id = $2;",
+			id, removedTag.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec("UPDATE files SET revision = revision + 1, updated_at = now() WHERE id = $1;", id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// findModifiableTag finds and locks a tag by name as part of a transaction,
+// returning errors.ErrNotPermitted if it's a system tag.
+func findModifiableTag(tx *sql.Tx, name string) (*records.Tag, error) {
+	tag, err := scanTag(tx.QueryRow(selectTags+`
+		WHERE t.name = $1
+		FOR UPDATE OF t;
+	`, name))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if tag.System {
+		return nil, scerrors.ErrNotPermitted
+	}
+
+	return tag, nil
+}
+
+// hasChildTags checks if any tags have the given tag as their parent.
+func hasChildTags(tx *sql.Tx, id uuid.UUID) (bool, error) {
+	var hasChildren bool
+	err := tx.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM tags WHERE parent_id = $1);",
+		id,
+	).Scan(&hasChildren)
+	if err != nil {
+		return false, err
+	}
+
+	return hasChildren, nil
+}
+
+func (c *Client) RenameTag(oldName string, newName string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	tag, err := findModifiableTag(tx, oldName)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(newName, oldName+records.TagSeparator) {
+		// A tag can't be moved under itself
+		return scerrors.ErrNotPermitted
+	}
+
+	var parentID interface{}
+	if parentName := records.TagParentName(newName); parentName != "" {
+		id, err := getOrCreateTag(tx, parentName)
+		if err != nil {
+			return err
+		}
+		parentID = id
+	}
+
+	_, err = tx.Exec(
+		"UPDATE tags SET name = $1, parent_id = $2 WHERE id = $3;",
+		newName, parentID, tag.ID,
+	)
+	if isUniqueViolation(err) {
+		return scerrors.ErrExists
+	} else if err != nil {
+		return err
+	}
+
+	// Descendants keep their parents but their names need to start
+	// with the new name.
+	oldPrefix := oldName + records.TagSeparator
+	prefixLen := utf8.RuneCountInString(oldPrefix)
+	_, err = tx.Exec(`
+		UPDATE tags SET name = $1 || substr(name, $2)
+		WHERE substr(name, 1, $3) = $4;
+	`, newName+records.TagSeparator, prefixLen+1, prefixLen, oldPrefix)
+	if isUniqueViolation(err) {
+		return scerrors.ErrExists
+	} else if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) MergeTags(sourceName string, targetName string) error {
+	if sourceName == targetName {
+		return nil
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	source, err := findModifiableTag(tx, sourceName)
+	if err != nil {
+		return err
+	}
+
+	target, err := findModifiableTag(tx, targetName)
+	if err != nil {
+		return err
+	}
+
+	hasChildren, err := hasChildTags(tx, source.ID)
+	if err != nil {
+		return err
+	} else if hasChildren {
+		return scerrors.ErrInUse
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO file_tags (file_id, tag_id)
+		SELECT file_id, $1 FROM file_tags WHERE tag_id = $2
+		ON CONFLICT DO NOTHING;
+	`, target.ID, source.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM file_tags WHERE tag_id = $1;", source.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM tags WHERE id = $1;", source.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) DeleteTag(name string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	tag, err := findModifiableTag(tx, name)
+	if err != nil {
+		return err
+	}
+
+	hasChildren, err := hasChildTags(tx, tag.ID)
+	if err != nil {
+		return err
+	} else if hasChildren {
+		return scerrors.ErrInUse
+	}
+
+	var inUse bool
+	err = tx.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM file_tags WHERE tag_id = $1);",
+		tag.ID,
+	).Scan(&inUse)
+	if err != nil {
+		return err
+	} else if inUse {
+		return scerrors.ErrInUse
+	}
+
+	_, err = tx.Exec("DELETE FROM tags WHERE id = $1;", tag.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
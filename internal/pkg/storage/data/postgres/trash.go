@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) ListTrash() ([]*records.TrashedFile, error) {
+	rows, err := c.db.Query(`
+		SELECT
+			f.id,
+			f.filename,
+			f.document_date,
+			f.hash,
+			COALESCE(fm.file_size, 0) AS file_size,
+			f.title,
+			f.notes,
+			f.revision,
+			f.created_at,
+			f.updated_at,
+			f.source,
+			f.original_path,
+			f.deleted_at
+		FROM files f
+		LEFT JOIN file_metadata fm ON f.hash = fm.hash
+		WHERE f.deleted_at IS NOT NULL
+		ORDER BY f.deleted_at, f.filename;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.TrashedFile{}
+	for rows.Next() {
+		trashed := &records.TrashedFile{
+			File: &records.File{},
+		}
+		err = rows.Scan(
+			&trashed.File.ID,
+			&trashed.File.Filename,
+			&trashed.File.DocumentDate,
+			&trashed.File.Hash,
+			&trashed.File.Size,
+			&trashed.File.Title,
+			&trashed.File.Notes,
+			&trashed.File.Revision,
+			&trashed.File.Created,
+			&trashed.File.Updated,
+			&trashed.File.Source,
+			&trashed.File.OriginalPath,
+			&trashed.Deleted,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, trashed)
+	}
+
+	return res, rows.Err()
+}
+
+// isTrashed checks if the file is in the trash as part of a transaction,
+// locking the file if it is.
+func isTrashed(tx *sql.Tx, id uuid.UUID) (bool, error) {
+	var trashedID uuid.UUID
+	err := tx.QueryRow(
+		"SELECT id FROM files WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE;",
+		id,
+	).Scan(&trashedID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *Client) RestoreFile(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	trashed, err := isTrashed(tx, id)
+	if err != nil {
+		return err
+	} else if !trashed {
+		return scerrors.ErrNotFound
+	}
+
+	// Another file may have been created with the same name and date
+	// while this one was in the trash.
+	var conflicts int
+	err = tx.QueryRow(`
+		SELECT COUNT(*) FROM files f
+		INNER JOIN files t ON
+			t.filename = f.filename AND
+			(t.document_date AT TIME ZONE 'UTC')::date =
+				(f.document_date AT TIME ZONE 'UTC')::date
+		WHERE t.id = $1 AND f.deleted_at IS NULL;
+	`, id).Scan(&conflicts)
+	if err != nil {
+		return err
+	} else if conflicts > 0 {
+		return scerrors.ErrExists
+	}
+
+	_, err = tx.Exec(
+		"UPDATE files SET deleted_at = NULL WHERE id = $1;",
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) PurgeFile(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	trashed, err := isTrashed(tx, id)
+	if err != nil {
+		return err
+	} else if !trashed {
+		return scerrors.ErrNotFound
+	}
+
+	for _, query := range []string{
+		"DELETE FROM file_tags WHERE file_id = $1;",
+		"DELETE FROM file_fields WHERE file_id = $1;",
+		"DELETE FROM file_versions WHERE file_id = $1;",
+		"DELETE FROM file_links WHERE source_id = $1 OR target_id = $1;",
+		"DELETE FROM files WHERE id = $1;",
+	} {
+		_, err = tx.Exec(query, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
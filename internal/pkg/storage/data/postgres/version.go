@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const selectFileVersions = `
+	SELECT
+		fv.file_id,
+		fv.version,
+		fv.hash,
+		COALESCE(fm.file_size, 0) AS file_size,
+		fv.created,
+		fv.source
+	FROM file_versions fv
+	LEFT JOIN file_metadata fm ON fv.hash = fm.hash
+`
+
+func scanFileVersion(row rowScanner) (*records.FileVersion, error) {
+	version := &records.FileVersion{}
+	err := row.Scan(
+		&version.FileID,
+		&version.Version,
+		&version.Hash,
+		&version.Size,
+		&version.Created,
+		&version.Source,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (c *Client) AddFileVersion(id uuid.UUID, hash string, source string) (int, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Updating the file locks its row, so concurrent versions of the
+	// same file get different numbers.
+	res, err := tx.Exec(
+		"UPDATE files SET hash = $1, revision = revision + 1, updated_at = now() WHERE id = $2 AND deleted_at IS NULL;",
+		hash, id,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected < 1 {
+		return 0, scerrors.ErrNotFound
+	}
+
+	var version int
+	err = tx.QueryRow(
+		"SELECT COALESCE(MAX(version), 0) + 1 FROM file_versions WHERE file_id = $1;",
+		id,
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO file_versions (file_id, version, hash, created, source)
+		VALUES ($1, $2, $3, $4, $5);
+	`, id, version, hash, time.Now().UTC(), source)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (c *Client) GetFileVersions(id uuid.UUID) ([]*records.FileVersion, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	exists, err := fileExists(tx, id)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, scerrors.ErrNotFound
+	}
+
+	rows, err := tx.Query(selectFileVersions+`
+		WHERE fv.file_id = $1
+		ORDER BY fv.version;
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.FileVersion{}
+	for rows.Next() {
+		version, err := scanFileVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, version)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *Client) GetFileVersion(id uuid.UUID, version int) (*records.FileVersion, error) {
+	res, err := scanFileVersion(c.db.QueryRow(selectFileVersions+`
+		JOIN files f ON fv.file_id = f.id
+		WHERE fv.file_id = $1 AND fv.version = $2 AND f.deleted_at IS NULL;
+	`, id, version))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,210 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func scanTagCategory(row rowScanner) (*records.TagCategory, error) {
+	category := &records.TagCategory{}
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Color,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *Client) AllTagCategories() ([]*records.TagCategory, error) {
+	rows, err := c.db.Query(`
+		SELECT id, name, color FROM tag_categories
+		ORDER BY name;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.TagCategory{}
+	for rows.Next() {
+		category, err := scanTagCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, category)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *Client) GetTagCategory(id uuid.UUID) (*records.TagCategory, error) {
+	row := c.db.QueryRow(`
+		SELECT id, name, color FROM tag_categories
+		WHERE id = ?;
+	`, id.String())
+
+	category, err := scanTagCategory(row)
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// categoryNameExists checks if a category other than excludeID already has
+// the given name.
+func categoryNameExists(tx *sql.Tx, name string, excludeID uuid.UUID) (bool, error) {
+	var count int
+	err := tx.QueryRow(`
+		SELECT COUNT(*) FROM tag_categories
+		WHERE name = ? AND id != ?;
+	`, name, excludeID.String()).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (c *Client) CreateTagCategory(name string, color string) (uuid.UUID, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	exists, err := categoryNameExists(tx, name, uuid.Nil)
+	if err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	} else if exists {
+		tx.Rollback()
+		return uuid.Nil, scerrors.ErrExists
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO tag_categories (id, name, color) VALUES (?, ?, ?);
+	`, id.String(), name, color)
+	if err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+func (c *Client) UpdateTagCategory(category *records.TagCategory) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	exists, err := categoryNameExists(tx, category.Name, category.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if exists {
+		tx.Rollback()
+		return scerrors.ErrExists
+	}
+
+	res, err := tx.Exec(`
+		UPDATE tag_categories SET name = ?, color = ?
+		WHERE id = ?;
+	`, category.Name, category.Color, category.ID.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if affected < 1 {
+		tx.Rollback()
+		return scerrors.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) RemoveTagCategory(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE tags SET category_id = NULL WHERE category_id = ?;
+	`, id.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	res, err := tx.Exec(`DELETE FROM tag_categories WHERE id = ?;`, id.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if affected < 1 {
+		tx.Rollback()
+		return scerrors.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) SetTagCategory(tagName string, categoryID uuid.UUID) error {
+	var category interface{}
+	if categoryID != uuid.Nil {
+		_, err := c.GetTagCategory(categoryID)
+		if err != nil {
+			return err
+		}
+		category = categoryID.String()
+	}
+
+	res, err := c.db.Exec(`
+		UPDATE tags SET category_id = ? WHERE name = ?;
+	`, category, tagName)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	} else if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
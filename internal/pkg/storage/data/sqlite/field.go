@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func scanField(row rowScanner) (*records.Field, error) {
+	field := &records.Field{}
+
+	var stringValue, moneyCurrency sql.NullString
+	var numberValue sql.NullFloat64
+	var dateValue sql.NullTime
+	var moneyAmount sql.NullInt64
+	err := row.Scan(
+		&field.Name,
+		&field.Type,
+		&stringValue,
+		&numberValue,
+		&dateValue,
+		&moneyAmount,
+		&moneyCurrency,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	field.String = stringValue.String
+	field.Number = numberValue.Float64
+	if dateValue.Valid {
+		field.Date = dateValue.Time.UTC()
+	}
+	field.Money = records.Money{
+		Amount:   moneyAmount.Int64,
+		Currency: moneyCurrency.String,
+	}
+
+	return field, nil
+}
+
+// fieldColumns returns the values of the string_value, number_value,
+// date_value, money_amount and money_currency columns for the field. Only
+// the columns for the field's type are set.
+func fieldColumns(field *records.Field) []interface{} {
+	res := make([]interface{}, 5)
+	switch field.Type {
+	case records.FIELD_TYPE_STRING:
+		res[0] = field.String
+	case records.FIELD_TYPE_NUMBER:
+		res[1] = field.Number
+	case records.FIELD_TYPE_DATE:
+		res[2] = field.Date.UTC()
+	case records.FIELD_TYPE_MONEY:
+		res[3] = field.Money.Amount
+		res[4] = field.Money.Currency
+	}
+	return res
+}
+
+// fileExists checks if the file exists, and isn't in the trash, as part of
+// a transaction.
+func fileExists(tx *sql.Tx, id uuid.UUID) (bool, error) {
+	var count int
+	err := tx.QueryRow(
+		"SELECT COUNT(*) FROM files WHERE id = ? AND deleted_at IS NULL;",
+		id.String(),
+	).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (c *Client) GetFileFields(id uuid.UUID) ([]*records.Field, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	exists, err := fileExists(tx, id)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, scerrors.ErrNotFound
+	}
+
+	rows, err := tx.Query(`
+		SELECT
+			name,
+			field_type,
+			string_value,
+			number_value,
+			date_value,
+			money_amount,
+			money_currency
+		FROM file_fields
+		WHERE file_id = ?
+		ORDER BY name;
+	`, id.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.Field{}
+	for rows.Next() {
+		field, err := scanField(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, field)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *Client) SetFileFields(id uuid.UUID, fields []*records.Field, removedNames []string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	exists, err := fileExists(tx, id)
+	if err != nil {
+		return err
+	} else if !exists {
+		return scerrors.ErrNotFound
+	}
+
+	err = setFileFields(tx, id, fields, removedNames)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		"UPDATE files SET revision = revision + 1, updated_at = ? WHERE id = ?;",
+		time.Now().UTC(), id.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setFileFields sets and removes fields of a file as part of a
+// transaction.
+func setFileFields(tx *sql.Tx, id uuid.UUID, fields []*records.Field, removedNames []string) error {
+	for _, name := range removedNames {
+		_, err := tx.Exec(
+			"DELETE FROM file_fields WHERE file_id = ? AND name = ?;",
+			id.String(), name,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, field := range fields {
+		_, err := tx.Exec(
+			"DELETE FROM file_fields WHERE file_id = ? AND name = ?;",
+			id.String(), field.Name,
+		)
+		if err != nil {
+			return err
+		}
+
+		args := append(
+			[]interface{}{id.String(), field.Name, field.Type},
+			fieldColumns(field)...,
+		)
+		_, err = tx.Exec(`
+			INSERT INTO file_fields (
+				file_id,
+				name,
+				field_type,
+				string_value,
+				number_value,
+				date_value,
+				money_amount,
+				money_currency
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+		`, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,6 @@ package sqlite
 import (
 	"database/sql"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -12,18 +11,54 @@ import (
 	"github.com/google/uuid"
 
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
 	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 )
 
-func rowsToFile(rows *sql.Rows) (*records.File, error) {
-	file := &records.File{}
-	err := rows.Scan(
+// fileColumns are the columns scanFile reads, for queries that select
+// more than the file.
+const fileColumns = `
+	f.id,
+	f.filename,
+	f.document_date,
+	f.hash,
+	ifnull(fm.file_size, 0) AS file_size,
+	f.title,
+	f.notes,
+	f.revision,
+	f.created_at,
+	f.updated_at,
+	f.source,
+	f.original_path
+`
+
+const selectFiles = `
+	SELECT ` + fileColumns + `
+	FROM files f
+	LEFT JOIN file_metadata fm ON f.hash = fm.hash
+`
+
+// fileDest returns where the columns in fileColumns are scanned to.
+func fileDest(file *records.File) []interface{} {
+	return []interface{}{
 		&file.ID,
 		&file.Filename,
 		&file.DocumentDate,
 		&file.Hash,
 		&file.Size,
-	)
+		&file.Title,
+		&file.Notes,
+		&file.Revision,
+		&file.Created,
+		&file.Updated,
+		&file.Source,
+		&file.OriginalPath,
+	}
+}
+
+func scanFile(row rowScanner) (*records.File, error) {
+	file := &records.File{}
+	err := row.Scan(fileDest(file)...)
 	if err != nil {
 		return nil, err
 	}
@@ -31,6 +66,22 @@ func rowsToFile(rows *sql.Rows) (*records.File, error) {
 	return file, nil
 }
 
+func rowsToFiles(rows *sql.Rows) ([]*records.File, error) {
+	defer rows.Close()
+
+	files := []*records.File{}
+	for rows.Next() {
+		file, err := scanFile(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, rows.Err()
+}
+
 type sqliteFileIterator struct {
 	rows *sql.Rows
 
@@ -66,7 +117,7 @@ func (sfi *sqliteFileIterator) worker() {
 		}
 
 		res := &records.FileItem{}
-		file, err := rowsToFile(sfi.rows)
+		file, err := scanFile(sfi.rows)
 		if err != nil {
 			res.Error = err
 		} else {
@@ -96,6 +147,7 @@ func (sfi *sqliteFileIterator) Close() error {
 func (c *Client) GetFileYears() ([]int, error) {
 	rows, err := c.db.Query(`
 		SELECT DISTINCT strftime('%Y', document_date) AS document_year FROM files
+		WHERE deleted_at IS NULL
 		ORDER BY document_year;
 	`)
 	if err != nil {
@@ -123,7 +175,7 @@ func (c *Client) GetFileMonths(year int) ([]int, error) {
 		SELECT DISTINCT
 			strftime('%m', document_date) AS document_month
 		FROM files
-		WHERE strftime('%Y', document_date) = ?
+		WHERE deleted_at IS NULL AND strftime('%Y', document_date) = ?
 		ORDER BY document_month;
 	`,
 		fmt.Sprintf("%0000d", year),
@@ -154,6 +206,7 @@ func (c *Client) GetFileDays(year int, month int) ([]int, error) {
 			strftime('%d', document_date) AS document_day
 		FROM files
 		WHERE
+			deleted_at IS NULL AND
 			strftime('%Y', document_date) = ? AND
 			strftime('%m', document_date) = ?
 		ORDER BY document_day;
@@ -182,15 +235,8 @@ func (c *Client) GetFileDays(year int, month int) ([]int, error) {
 }
 
 func (c *Client) AllFiles() (records.FileIterator, error) {
-	rows, err := c.db.Query(`
-		SELECT
-			f.id,
-			f.filename,
-			f.document_date,
-			f.hash,
-			ifnull(fm.file_size, 0) AS file_size
-		FROM files f
-		LEFT JOIN file_metadata fm ON f.hash = fm.hash
+	rows, err := c.db.Query(selectFiles + `
+		WHERE f.deleted_at IS NULL
 		ORDER BY f.filename;
 	`)
 	if err != nil {
@@ -201,56 +247,31 @@ func (c *Client) AllFiles() (records.FileIterator, error) {
 }
 
 func (c *Client) GetFile(id uuid.UUID) (*records.File, error) {
-	rows, err := c.db.Query(`
-		SELECT
-			f.id,
-			f.filename,
-			f.document_date,
-			f.hash,
-			ifnull(fm.file_size, 0) AS file_size
-		FROM files f
-		LEFT JOIN file_metadata fm ON f.hash = fm.hash
-		WHERE f.id = ? ORDER BY f.filename;
-	`, id.String())
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	if !rows.Next() {
+	file, err := scanFile(c.db.QueryRow(selectFiles+`
+		WHERE f.id = ? AND f.deleted_at IS NULL;
+	`, id.String()))
+	if err == sql.ErrNoRows {
 		return nil, scerrors.ErrNotFound
-	}
-
-	res := &records.File{}
-	err = rows.Scan(&res.ID, &res.Filename, &res.DocumentDate, &res.Hash, &res.Size)
-	if err != nil {
+	} else if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return file, nil
 }
 
 func (c *Client) GetFileByHash(hash string) (*records.File, error) {
-	rows, err := c.db.Query(`
-		SELECT id, filename, document_date, hash FROM files
-		WHERE hash = ? ORDER BY filename;
-	`, hash)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	if !rows.Next() {
+	file, err := scanFile(c.db.QueryRow(selectFiles+`
+		WHERE f.hash = ? AND f.deleted_at IS NULL
+		ORDER BY f.filename
+		LIMIT 1;
+	`, hash))
+	if err == sql.ErrNoRows {
 		return nil, scerrors.ErrNotFound
-	}
-
-	res := &records.File{}
-	err = rows.Scan(&res.ID, &res.Hash, &res.Filename, &res.DocumentDate, &res.Size)
-	if err != nil {
+	} else if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return file, nil
 }
 
 func (c *Client) CreateFile(filename string, documentDate time.Time) (uuid.UUID, error) {
@@ -305,6 +326,42 @@ func (c *Client) CreateFileWithIDAndTags(
 	documentDate time.Time,
 	fileID uuid.UUID,
 	tagNames []string,
+) error {
+	return c.createFile(filename, documentDate, fileID, tagNames, "", "")
+}
+
+func (c *Client) CreateFileWithSource(
+	filename string,
+	documentDate time.Time,
+	source string,
+	originalPath string,
+) (uuid.UUID, error) {
+	fileID, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = c.createFile(
+		filename, documentDate, fileID,
+		[]string{consts.TagUnfiled},
+		source, originalPath,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return fileID, nil
+}
+
+// createFile creates a file with the given tags, recording where it came
+// from.
+func (c *Client) createFile(
+	filename string,
+	documentDate time.Time,
+	fileID uuid.UUID,
+	tagNames []string,
+	source string,
+	originalPath string,
 ) error {
 	// Get the tags we're adding first
 	tags, err := c.GetTags(tagNames)
@@ -316,14 +373,23 @@ func (c *Client) CreateFileWithIDAndTags(
 	if err != nil {
 		return err
 	}
+
+	now := time.Now().UTC()
 	_, err = tx.Exec(`
-		INSERT INTO files (id, filename, document_date, hash)
-		VALUES (?, ?, ?, ?);
+		INSERT INTO files (
+			id, filename, document_date, hash,
+			created_at, updated_at, source, original_path
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?);
 	`,
 		fileID.String(),
 		filename,
 		documentDate,
 		"",
+		now,
+		now,
+		source,
+		originalPath,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -353,8 +419,8 @@ func (c *Client) CreateFileWithIDAndTags(
 
 func (c *Client) UpdateFileHash(id uuid.UUID, hash string) error {
 	res, err := c.db.Exec(
-		"UPDATE files SET hash = ? WHERE id = ?",
-		hash, id,
+		"UPDATE files SET hash = ?, revision = revision + 1, updated_at = ? WHERE id = ?",
+		hash, time.Now().UTC(), id,
 	)
 	if err != nil {
 		return err
@@ -373,43 +439,48 @@ func (c *Client) UpdateFileHash(id uuid.UUID, hash string) error {
 }
 
 func (c *Client) UpdateFileDate(id uuid.UUID, newFilename string, newDate time.Time) error {
-	fmt.Printf("updating %s to %s - %s\n", id, newFilename, newDate)
 	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
 
-	res, err := tx.Exec(`
+	rows, err := tx.Query(`
 		SELECT id FROM files
-		WHERE filename = ? AND date(document_date) = ?
+		WHERE
+			filename = ? AND date(document_date) = ? AND
+			id != ? AND deleted_at IS NULL
 	`,
 		newFilename,
 		newDate.Format("2006-01-02"),
+		id.String(),
 	)
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
-	affected, err := res.RowsAffected()
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
-	if affected > 0 {
+	exists := rows.Next()
+	rows.Close()
+	if exists {
 		tx.Rollback()
 		return scerrors.ErrExists
 	}
 
-	res, err = tx.Exec(`
-		UPDATE files SET filename = ?, document_date = ?
-		WHERE id = ?
+	res, err := tx.Exec(`
+		UPDATE files SET
+			filename = ?, document_date = ?, revision = revision + 1,
+			updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`,
 		newFilename,
 		newDate.Format(time.RFC3339Nano),
+		time.Now().UTC(),
 		id,
 	)
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
-	affected, err = res.RowsAffected()
+	affected, err := res.RowsAffected()
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -427,39 +498,103 @@ func (c *Client) UpdateFileDate(id uuid.UUID, newFilename string, newDate time.T
 	return nil
 }
 
-func (c *Client) UpdateFile(file *records.File) error {
-	return fmt.Errorf("not implemented")
-}
-
-func (c *Client) RemoveFile(id uuid.UUID) error {
+func (c *Client) UpdateFile(
+	file *records.File,
+	fields []*records.Field,
+	removedFields []string,
+) (int, error) {
 	tx, err := c.db.Begin()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec("DELETE FROM file_tags WHERE file_id = ?;", id.String())
+	var existingID string
+	err = tx.QueryRow(`
+		SELECT id FROM files
+		WHERE
+			filename = ? AND date(document_date) = ? AND
+			id != ? AND deleted_at IS NULL
+	`,
+		file.Filename,
+		file.DocumentDate.Format("2006-01-02"),
+		file.ID.String(),
+	).Scan(&existingID)
+	if err == nil {
+		return 0, scerrors.ErrExists
+	} else if err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	res, err := tx.Exec(`
+		UPDATE files SET
+			filename = ?,
+			document_date = ?,
+			title = ?,
+			notes = ?,
+			revision = revision + 1,
+			updated_at = ?
+		WHERE id = ? AND revision = ? AND deleted_at IS NULL
+	`,
+		file.Filename,
+		file.DocumentDate.Format(time.RFC3339Nano),
+		file.Title,
+		file.Notes,
+		time.Now().UTC(),
+		file.ID.String(),
+		file.Revision,
+	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	res, err := tx.Exec("DELETE FROM files WHERE id = ?;", id.String())
+	affected, err := res.RowsAffected()
 	if err != nil {
-		return err
+		return 0, err
+	}
+	if affected < 1 {
+		exists, err := fileExists(tx, file.ID)
+		if err != nil {
+			return 0, err
+		} else if exists {
+			return 0, scerrors.ErrStaleRevision
+		}
+		return 0, scerrors.ErrNotFound
 	}
 
-	ra, err := res.RowsAffected()
+	err = setFileFields(tx, file.ID, fields, removedFields)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if ra < 1 {
-		return scerrors.ErrNotFound
+
+	var revision int
+	err = tx.QueryRow(
+		"SELECT revision FROM files WHERE id = ?",
+		file.ID.String(),
+	).Scan(&revision)
+	if err != nil {
+		return 0, err
 	}
 
-	err = tx.Commit()
+	return revision, tx.Commit()
+}
+
+func (c *Client) RemoveFile(id uuid.UUID) error {
+	res, err := c.db.Exec(
+		"UPDATE files SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL;",
+		time.Now().UTC(), id.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
 	if err != nil {
 		return err
 	}
+	if affected < 1 {
+		return scerrors.ErrNotFound
+	}
 
 	return nil
 }
@@ -469,30 +604,14 @@ func (c *Client) GetFileWithDate(filename string, date time.Time) (*records.File
 		return nil, fmt.Errorf("empty file name")
 	}
 
-	query := `
-		SELECT
-			f.id,
-			f.filename,
-			f.document_date,
-			f.hash,
-			ifnull(fm.file_size, 0) AS file_size
-		FROM files f
-		LEFT JOIN file_metadata fm ON f.hash = fm.hash
-		WHERE f.filename = ? AND date(f.document_date) = ?;
-	`
-
-	rows, err := c.db.Query(query, filename, date.Format("2006-01-02"))
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	if !rows.Next() {
+	file, err := scanFile(c.db.QueryRow(selectFiles+`
+		WHERE
+			f.filename = ? AND date(f.document_date) = ? AND
+			f.deleted_at IS NULL;
+	`, filename, date.Format("2006-01-02")))
+	if err == sql.ErrNoRows {
 		return nil, scerrors.ErrNotFound
-	}
-
-	file, err := rowsToFile(rows)
-	if err != nil {
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -500,34 +619,14 @@ func (c *Client) GetFileWithDate(filename string, date time.Time) (*records.File
 }
 
 func (c *Client) FindFilesWithDate(documentDate time.Time) ([]*records.File, error) {
-	query := `
-		SELECT
-			f.id,
-			f.filename,
-			f.document_date,
-			f.hash,
-			ifnull(fm.file_size, 0) AS file_size
-		FROM files f
-		LEFT JOIN file_metadata fm ON f.hash = fm.hash
-		WHERE date(f.document_date) = ?
-	`
-
-	rows, err := c.db.Query(query, documentDate.Format("2006-01-02"))
+	rows, err := c.db.Query(selectFiles+`
+		WHERE date(f.document_date) = ? AND f.deleted_at IS NULL;
+	`, documentDate.Format("2006-01-02"))
 	if err != nil {
 		return nil, err
 	}
 
-	files := []*records.File{}
-	for rows.Next() {
-		file, err := rowsToFile(rows)
-		if err != nil {
-			return nil, err
-		}
-
-		files = append(files, file)
-	}
-
-	return files, nil
+	return rowsToFiles(rows)
 }
 
 func (c *Client) FindFilesWithTags(tagNames []string) ([]*records.File, error) {
@@ -535,59 +634,17 @@ func (c *Client) FindFilesWithTags(tagNames []string) ([]*records.File, error) {
 		return []*records.File{}, nil
 	}
 
-	query := "SELECT f.id, f.filename, f.document_date, f.hash, fm.file_size FROM files f "
-	query = query + "LEFT JOIN file_metadata fm ON f.hash = fm.hash "
-	query = query + "INNER JOIN file_tags ft ON f.id = ft.file_id "
-	query = query + "INNER JOIN tags t ON t.id = ft.tag_id "
-	query = query + "WHERE t.name IN (?"
-	query = query + strings.Repeat(", ?", len(tagNames)-1)
-	query = query + ") ORDER BY f.filename;"
-
-	args := []interface{}{}
-	for _, name := range tagNames {
-		args = append(args, name)
-	}
-
-	rows, err := c.db.Query(query, args...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	res := []*records.File{}
-	for rows.Next() {
-		foundFile := &records.File{}
-
-		foundFile, err := rowsToFile(rows)
-		if err != nil {
-			return nil, err
-		}
-
-		res = append(res, foundFile)
-	}
-
-	return res, nil
+	return c.QueryFiles(query.AllTags(tagNames...))
 }
 
 func (c *Client) FindFilesWithIdPrefix(idPrefix string) ([]*records.File, error) {
-	query := "SELECT id, hash, filename, document_date, file_size FROM files f "
-	query = query + "WHERE id LIKE ? ORDER BY id;"
-
-	rows, err := c.db.Query(query, fmt.Sprintf("%s%%", idPrefix))
+	rows, err := c.db.Query(selectFiles+`
+		WHERE f.id LIKE ? AND f.deleted_at IS NULL
+		ORDER BY f.id;
+	`, fmt.Sprintf("%s%%", idPrefix))
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	res := []*records.File{}
-	for rows.Next() {
-		foundFile, err := rowsToFile(rows)
-		if err != nil {
-			return nil, err
-		}
-
-		res = append(res, foundFile)
-	}
 
-	return res, nil
+	return rowsToFiles(rows)
 }
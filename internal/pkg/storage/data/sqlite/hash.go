@@ -1,19 +1,31 @@
 package sqlite
 
 import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
 	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
 	"github.com/aphistic/softcopy/internal/pkg/storage/records"
-	"github.com/google/uuid"
 )
 
 func (c *Client) CreateMetadataWithID(hash string, fileSize uint64, id uuid.UUID) error {
+	return c.CreateMetadataWithStoredSize(hash, fileSize, fileSize, id)
+}
+
+func (c *Client) CreateMetadataWithStoredSize(
+	hash string,
+	fileSize uint64,
+	storedSize uint64,
+	id uuid.UUID,
+) error {
 	_, err := c.db.Exec(`
-		INSERT INTO file_metadata (id, hash, file_size)
-		VALUES (?, ?, ?);
+		INSERT INTO file_metadata (id, hash, file_size, stored_size)
+		VALUES (?, ?, ?, ?);
 	`,
-		id, hash, fileSize,
+		id, hash, fileSize, storedSize,
 	)
-
 	if err != nil {
 		return err
 	}
@@ -21,26 +33,196 @@ func (c *Client) CreateMetadataWithID(hash string, fileSize uint64, id uuid.UUID
 	return nil
 }
 
+const metadataColumns = `
+	fm.id, fm.hash, fm.file_size, fm.stored_size,
+	fm.scrub_status, fm.scrubbed_at
+`
+
+func scanMetadata(row rowScanner) (*records.FileMetadata, error) {
+	md := &records.FileMetadata{}
+	var scrubbed sql.NullTime
+	err := row.Scan(
+		&md.ID,
+		&md.Hash,
+		&md.FileSize,
+		&md.StoredSize,
+		&md.ScrubStatus,
+		&scrubbed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if scrubbed.Valid {
+		md.Scrubbed = scrubbed.Time
+	}
+
+	return md, nil
+}
+
 func (c *Client) FindMetadataByHash(hash string) (*records.FileMetadata, error) {
-	rows, err := c.db.Query(`
-		SELECT id, hash, file_size
+	md, err := scanMetadata(c.db.QueryRow(`
+		SELECT `+metadataColumns+`
 		FROM file_metadata fm
-		WHERE hash = ?
-	`, hash)
+		WHERE hash = ?;
+	`, hash))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return md, nil
+}
+
+const metadataUnreferenced = `
+	NOT EXISTS (SELECT 1 FROM files f WHERE f.hash = fm.hash)
+	AND NOT EXISTS (SELECT 1 FROM file_versions fv WHERE fv.hash = fm.hash)
+`
+
+func (c *Client) queryMetadata(query string, args ...interface{}) ([]*records.FileMetadata, error) {
+	rows, err := c.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	if !rows.Next() {
-		return nil, scerrors.ErrNotFound
+	res := []*records.FileMetadata{}
+	for rows.Next() {
+		md, err := scanMetadata(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, md)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	md := &records.FileMetadata{}
-	err = rows.Scan(&md.ID, &md.Hash, &md.FileSize)
+	return res, nil
+}
+
+func (c *Client) AllMetadata() ([]*records.FileMetadata, error) {
+	return c.queryMetadata(`
+		SELECT ` + metadataColumns + `
+		FROM file_metadata fm
+		ORDER BY hash;
+	`)
+}
+
+func (c *Client) FindOrphanedMetadata() ([]*records.FileMetadata, error) {
+	return c.queryMetadata(`
+		SELECT ` + metadataColumns + `
+		FROM file_metadata fm
+		WHERE ` + metadataUnreferenced + `
+		ORDER BY fm.hash;
+	`)
+}
+
+func (c *Client) SetMetadataScrubResult(
+	id uuid.UUID,
+	status records.ScrubStatus,
+	scrubbed time.Time,
+) error {
+	res, err := c.db.Exec(`
+		UPDATE file_metadata
+		SET scrub_status = ?, scrubbed_at = ?
+		WHERE id = ?;
+	`, status, scrubbed.UTC(), id.String())
+	if err != nil {
+		return err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveMetadata(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var hash string
+	err = tx.QueryRow(
+		"SELECT hash FROM file_metadata WHERE id = ?;",
+		id.String(),
+	).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return scerrors.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	var orphaned bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM file_metadata fm
+			WHERE fm.id = ? AND `+metadataUnreferenced+`
+		);
+	`, id.String()).Scan(&orphaned)
+	if err != nil {
+		return err
+	}
+	if !orphaned {
+		return scerrors.ErrInUse
+	}
+
+	_, err = tx.Exec("DELETE FROM file_contents WHERE hash = ?;", hash)
+	if err != nil {
+		return err
+	}
+
+	if c.fts {
+		_, err = tx.Exec("DELETE FROM file_contents_fts WHERE hash = ?;", hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec("DELETE FROM file_metadata WHERE id = ?;", id.String())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) FindDuplicates() ([]*records.DuplicateGroup, error) {
+	rows, err := c.db.Query(selectFiles + `
+		WHERE f.deleted_at IS NULL AND f.hash IN (
+			SELECT hash FROM files
+			WHERE deleted_at IS NULL AND hash <> ''
+			GROUP BY hash
+			HAVING COUNT(*) > 1
+		)
+		ORDER BY f.hash, datetime(f.created_at), f.id;
+	`)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	return md, nil
+	res := []*records.DuplicateGroup{}
+	for rows.Next() {
+		file, err := scanFile(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(res) == 0 || res[len(res)-1].Hash != file.Hash {
+			res = append(res, &records.DuplicateGroup{Hash: file.Hash})
+		}
+		group := res[len(res)-1]
+		group.Files = append(group.Files, file)
+	}
+
+	return res, rows.Err()
 }
@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) CreateFileLink(sourceID uuid.UUID, targetID uuid.UUID, linkType records.LinkType) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, id := range []uuid.UUID{sourceID, targetID} {
+		exists, err := fileExists(tx, id)
+		if err != nil {
+			return err
+		} else if !exists {
+			return scerrors.ErrNotFound
+		}
+	}
+
+	var count int
+	err = tx.QueryRow(`
+		SELECT COUNT(*) FROM file_links
+		WHERE source_id = ? AND target_id = ? AND link_type = ?;
+	`, sourceID.String(), targetID.String(), linkType).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return scerrors.ErrExists
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO file_links (source_id, target_id, link_type, created)
+		VALUES (?, ?, ?, ?);
+	`, sourceID.String(), targetID.String(), linkType, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) RemoveFileLink(sourceID uuid.UUID, targetID uuid.UUID, linkType records.LinkType) error {
+	res, err := c.db.Exec(`
+		DELETE FROM file_links
+		WHERE source_id = ? AND target_id = ? AND link_type = ?;
+	`, sourceID.String(), targetID.String(), linkType)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected < 1 {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) GetFileLinks(id uuid.UUID) ([]*records.FileLink, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	exists, err := fileExists(tx, id)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, scerrors.ErrNotFound
+	}
+
+	rows, err := tx.Query(`
+		SELECT
+			fl.source_id,
+			fl.target_id,
+			fl.link_type,
+			fl.created
+		FROM file_links fl
+		JOIN files sf ON fl.source_id = sf.id
+		JOIN files tf ON fl.target_id = tf.id
+		WHERE
+			(fl.source_id = ? OR fl.target_id = ?) AND
+			sf.deleted_at IS NULL AND
+			tf.deleted_at IS NULL
+		ORDER BY fl.created, fl.source_id, fl.target_id, fl.link_type;
+	`, id.String(), id.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.FileLink{}
+	for rows.Next() {
+		link := &records.FileLink{}
+		err := rows.Scan(
+			&link.SourceID,
+			&link.TargetID,
+			&link.Type,
+			&link.Created,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, link)
+	}
+
+	return res, rows.Err()
+}
@@ -0,0 +1,158 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/data/sqlite/migrations"
+	"github.com/aphistic/softcopy/internal/pkg/storage/migration"
+)
+
+func (c *Client) Migrations() ([]*migration.Migration, error) {
+	ms, err := migrations.NewVaultMigrationSource()
+	if err != nil {
+		return nil, err
+	}
+
+	return migration.Status(c.db, "sqlite3", ms)
+}
+
+// MigrateTo migrates the schema up or down to the version, returning the
+// number of migrations applied. Down migrations rebuild tables other
+// tables reference, so foreign keys are turned off while migrating and
+// checked once it's done.
+func (c *Client) MigrateTo(version int) (int, error) {
+	ms, err := migrations.NewVaultMigrationSource()
+	if err != nil {
+		return 0, err
+	}
+
+	// Pragmas only apply to the connection they're run on, so use a
+	// single connection for the whole migration. sql-migrate needs the
+	// whole pool rather than a single connection, so limit the pool and
+	// put the earlier limit back afterwards.
+	maxOpen := c.db.Stats().MaxOpenConnections
+	c.db.SetMaxOpenConns(1)
+	defer c.db.SetMaxOpenConns(maxOpen)
+
+	_, err = c.db.Exec("PRAGMA foreign_keys = OFF;")
+	if err != nil {
+		return 0, err
+	}
+
+	applied, err := migration.To(c.db, "sqlite3", ms, version)
+
+	_, fkErr := c.db.Exec("PRAGMA foreign_keys = ON;")
+	if err != nil {
+		return applied, err
+	}
+	if fkErr != nil {
+		return applied, fkErr
+	}
+
+	err = c.checkForeignKeys()
+	if err != nil {
+		return applied, err
+	}
+
+	c.fts, err = c.setupFTS()
+	if err != nil {
+		return applied, err
+	}
+
+	return applied, nil
+}
+
+// checkForeignKeys returns an error if any rows reference rows that don't
+// exist.
+func (c *Client) checkForeignKeys() error {
+	rows, err := c.db.Query("PRAGMA foreign_key_check;")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var table, parent string
+		var rowID sql.NullInt64
+		var fkID int
+		err = rows.Scan(&table, &rowID, &parent, &fkID)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("migration left rows in %s referencing missing %s", table, parent)
+	}
+
+	return rows.Err()
+}
+
+// Snapshot copies the database to a new file in dir, returning the path
+// of the copy. It uses sqlite's online backup so the copy is consistent
+// even while the database is being used.
+func (c *Client) Snapshot(dir string) (string, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	base := path.Base(c.dbPath)
+	snapshotPath := path.Join(dir, fmt.Sprintf(
+		"%s-%s%s",
+		strings.TrimSuffix(base, path.Ext(base)),
+		time.Now().UTC().Format("20060102T150405.000Z"),
+		path.Ext(base),
+	))
+	_, err = os.Stat(snapshotPath)
+	if err == nil {
+		return "", fmt.Errorf("snapshot %s already exists", snapshotPath)
+	}
+
+	snapshotDB, err := sql.Open("sqlite3", snapshotPath)
+	if err != nil {
+		return "", err
+	}
+	defer snapshotDB.Close()
+
+	ctx := context.Background()
+	destConn, err := snapshotDB.Conn(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer destConn.Close()
+	srcConn, err := c.db.Conn(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer srcConn.Close()
+
+	err = destConn.Raw(func(dest interface{}) error {
+		return srcConn.Raw(func(src interface{}) error {
+			backup, err := dest.(*sqlite3.SQLiteConn).Backup("main", src.(*sqlite3.SQLiteConn), "main")
+			if err != nil {
+				return err
+			}
+
+			_, err = backup.Step(-1)
+			if err != nil {
+				backup.Finish()
+				return err
+			}
+
+			return backup.Finish()
+		})
+	})
+	if err != nil {
+		os.Remove(snapshotPath)
+		return "", err
+	}
+
+	return snapshotPath, nil
+}
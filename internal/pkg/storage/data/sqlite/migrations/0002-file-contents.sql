@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE file_contents (
+    hash TEXT,
+    contents TEXT
+);
+CREATE UNIQUE INDEX ix_file_contents_hash ON file_contents(hash);
+
+-- The initial migration doesn't drop file_metadata when migrating down,
+-- so it's dropped here and created again if it's missing.
+CREATE TABLE IF NOT EXISTS file_metadata (
+    id TEXT,
+    hash TEXT,
+    file_size INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ix_file_metadata_id ON file_metadata(id);
+CREATE UNIQUE INDEX IF NOT EXISTS ix_file_metadata_hash ON file_metadata(hash);
+
+-- +migrate Down
+DROP TABLE file_contents;
+DROP TABLE file_metadata;
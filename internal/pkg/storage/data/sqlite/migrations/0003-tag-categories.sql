@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE tag_categories (
+    id TEXT,
+    name TEXT,
+    color TEXT NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX ix_tag_categories_id ON tag_categories(id);
+CREATE UNIQUE INDEX ix_tag_categories_name ON tag_categories(name);
+
+ALTER TABLE tags ADD COLUMN category_id TEXT NULL REFERENCES tag_categories(id);
+
+-- +migrate Down
+CREATE TABLE tags_old (
+    id TEXT,
+    name TEXT,
+    system INTEGER NOT NULL DEFAULT 0
+);
+INSERT INTO tags_old (id, name, system) SELECT id, name, system FROM tags;
+DROP TABLE tags;
+ALTER TABLE tags_old RENAME TO tags;
+CREATE UNIQUE INDEX ix_tags_id ON tags(id);
+CREATE UNIQUE INDEX ix_tags_name ON tags(name);
+
+DROP TABLE tag_categories;
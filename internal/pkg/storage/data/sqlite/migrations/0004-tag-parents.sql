@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE tags ADD COLUMN parent_id TEXT NULL REFERENCES tags(id);
+CREATE INDEX ix_tags_parent_id ON tags(parent_id);
+
+-- +migrate Down
+CREATE TABLE tags_old (
+    id TEXT,
+    name TEXT,
+    system INTEGER NOT NULL DEFAULT 0,
+    category_id TEXT NULL REFERENCES tag_categories(id)
+);
+INSERT INTO tags_old (id, name, system, category_id)
+    SELECT id, name, system, category_id FROM tags;
+DROP TABLE tags;
+ALTER TABLE tags_old RENAME TO tags;
+CREATE UNIQUE INDEX ix_tags_id ON tags(id);
+CREATE UNIQUE INDEX ix_tags_name ON tags(name);
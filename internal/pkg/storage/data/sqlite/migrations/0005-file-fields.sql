@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE file_fields (
+    file_id TEXT NOT NULL REFERENCES files(id),
+    name TEXT NOT NULL,
+    field_type INTEGER NOT NULL,
+    string_value TEXT NULL,
+    number_value REAL NULL,
+    date_value DATETIME NULL,
+    money_amount INTEGER NULL,
+    money_currency TEXT NULL
+);
+CREATE UNIQUE INDEX ix_file_fields_file_id_name ON file_fields(file_id, name);
+CREATE INDEX ix_file_fields_name ON file_fields(name);
+
+-- +migrate Down
+DROP TABLE file_fields;
@@ -0,0 +1,21 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN deleted_at DATETIME NULL;
+CREATE INDEX ix_files_deleted_at ON files(deleted_at);
+
+-- +migrate Down
+DELETE FROM file_tags WHERE file_id IN (SELECT id FROM files WHERE deleted_at IS NOT NULL);
+DELETE FROM file_fields WHERE file_id IN (SELECT id FROM files WHERE deleted_at IS NOT NULL);
+DELETE FROM file_versions WHERE file_id IN (SELECT id FROM files WHERE deleted_at IS NOT NULL);
+DELETE FROM files WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE files_old (
+    id TEXT,
+    filename TEXT,
+    document_date DATETIME,
+    hash TEXT
+);
+INSERT INTO files_old (id, filename, document_date, hash)
+SELECT id, filename, document_date, hash FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+CREATE UNIQUE INDEX ix_files_id ON files(id);
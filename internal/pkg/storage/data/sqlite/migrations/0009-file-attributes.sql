@@ -0,0 +1,19 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN title TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+CREATE TABLE files_old (
+    id TEXT,
+    filename TEXT,
+    document_date DATETIME,
+    hash TEXT,
+    deleted_at DATETIME NULL
+);
+INSERT INTO files_old (id, filename, document_date, hash, deleted_at)
+SELECT id, filename, document_date, hash, deleted_at FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+CREATE UNIQUE INDEX ix_files_id ON files(id);
+CREATE INDEX ix_files_deleted_at ON files(deleted_at);
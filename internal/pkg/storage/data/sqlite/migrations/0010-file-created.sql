@@ -0,0 +1,23 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN created_at DATETIME NULL;
+-- Files created before creation times were recorded use their document date
+UPDATE files SET created_at = document_date;
+CREATE INDEX ix_files_created_at ON files(created_at);
+
+-- +migrate Down
+CREATE TABLE files_old (
+    id TEXT,
+    filename TEXT,
+    document_date DATETIME,
+    hash TEXT,
+    deleted_at DATETIME NULL,
+    title TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    revision INTEGER NOT NULL DEFAULT 1
+);
+INSERT INTO files_old (id, filename, document_date, hash, deleted_at, title, notes, revision)
+SELECT id, filename, document_date, hash, deleted_at, title, notes, revision FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+CREATE UNIQUE INDEX ix_files_id ON files(id);
+CREATE INDEX ix_files_deleted_at ON files(deleted_at);
@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE files ADD COLUMN updated_at DATETIME NULL;
+UPDATE files SET updated_at = created_at;
+ALTER TABLE files ADD COLUMN source TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN original_path TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+CREATE TABLE files_old (
+    id TEXT,
+    filename TEXT,
+    document_date DATETIME,
+    hash TEXT,
+    deleted_at DATETIME NULL,
+    title TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    revision INTEGER NOT NULL DEFAULT 1,
+    created_at DATETIME NULL
+);
+INSERT INTO files_old (id, filename, document_date, hash, deleted_at, title, notes, revision, created_at)
+SELECT id, filename, document_date, hash, deleted_at, title, notes, revision, created_at FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+CREATE UNIQUE INDEX ix_files_id ON files(id);
+CREATE INDEX ix_files_deleted_at ON files(deleted_at);
+CREATE INDEX ix_files_created_at ON files(created_at);
@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE file_links (
+    source_id TEXT NOT NULL REFERENCES files(id),
+    target_id TEXT NOT NULL REFERENCES files(id),
+    link_type INTEGER NOT NULL,
+    created DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX ix_file_links_source_id_target_id_link_type ON file_links(source_id, target_id, link_type);
+CREATE INDEX ix_file_links_target_id ON file_links(target_id);
+
+-- +migrate Down
+DROP TABLE file_links;
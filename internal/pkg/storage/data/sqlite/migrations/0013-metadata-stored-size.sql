@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE file_metadata ADD COLUMN stored_size INTEGER NOT NULL DEFAULT 0;
+UPDATE file_metadata SET stored_size = file_size;
+
+-- +migrate Down
+CREATE TABLE file_metadata_old (
+    id TEXT,
+    hash TEXT,
+    file_size INTEGER NOT NULL DEFAULT 0
+);
+INSERT INTO file_metadata_old (id, hash, file_size)
+SELECT id, hash, file_size FROM file_metadata;
+DROP TABLE file_metadata;
+ALTER TABLE file_metadata_old RENAME TO file_metadata;
+CREATE UNIQUE INDEX ix_file_metadata_id ON file_metadata(id);
+CREATE UNIQUE INDEX ix_file_metadata_hash ON file_metadata(hash);
@@ -0,0 +1,39 @@
+package sqlite
+
+import (
+	"time"
+
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Placeholder(n int) string { return "?" }
+
+// Date normalizes the stored date to UTC in a format that sorts the
+// same way as the time it represents.
+func (sqliteDialect) Date(column string) string { return "datetime(" + column + ")" }
+func (sqliteDialect) DateArg(t time.Time) interface{} {
+	return t.UTC().Format("2006-01-02 15:04:05")
+}
+
+// Like uses LIKE, which is already case insensitive for ASCII in sqlite.
+func (sqliteDialect) Like() string { return "LIKE" }
+
+func (c *Client) QueryFiles(q query.Node) ([]*records.File, error) {
+	where, args, err := query.SQL(q, sqliteDialect{})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.Query(selectFiles+`
+		WHERE f.deleted_at IS NULL AND `+where+`
+		ORDER BY f.filename;
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToFiles(rows)
+}
@@ -0,0 +1,224 @@
+package sqlite
+
+import (
+	"database/sql"
+	"sort"
+	"strings"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	"github.com/aphistic/softcopy/internal/pkg/storage/search"
+)
+
+// setupFTS creates the FTS5 index of file contents if sqlite was built
+// with FTS5 support (the sqlite_fts5 build tag). Without it searches fall
+// back to scanning file contents. The index is dropped if the schema was
+// migrated down to before file contents were stored.
+//
+// The index isn't created by a migration because migrations are plain SQL
+// run the same way by every build, and creating an FTS5 table fails
+// without FTS5 support. Keeping it out of the migrations also means a
+// database can move between builds with and without FTS5: it's rebuilt
+// from file_contents, which is always kept, whenever it's missing.
+func (c *Client) setupFTS() (bool, error) {
+	var enabled bool
+	err := c.db.QueryRow(
+		"SELECT sqlite_compileoption_used('ENABLE_FTS5');",
+	).Scan(&enabled)
+	if err != nil {
+		return false, err
+	}
+	if !enabled {
+		return false, nil
+	}
+
+	var hasContents bool
+	err = c.db.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM sqlite_master
+			WHERE type = 'table' AND name = 'file_contents'
+		);
+	`).Scan(&hasContents)
+	if err != nil {
+		return false, err
+	}
+	if !hasContents {
+		_, err = c.db.Exec("DROP TABLE IF EXISTS file_contents_fts;")
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+
+	_, err = c.db.Exec(`
+		CREATE VIRTUAL TABLE IF NOT EXISTS file_contents_fts
+		USING fts5(hash UNINDEXED, contents);
+	`)
+	if err != nil {
+		return false, err
+	}
+
+	// Index any contents stored while running without FTS5 support
+	_, err = c.db.Exec(`
+		INSERT INTO file_contents_fts (hash, contents)
+		SELECT hash, contents FROM file_contents
+		WHERE hash NOT IN (SELECT hash FROM file_contents_fts);
+	`)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// FullTextSearch returns whether searches use the FTS5 index. Without it
+// every search scans the contents of all files.
+func (c *Client) FullTextSearch() bool {
+	return c.fts
+}
+
+func (c *Client) SetFileContents(hash string, contents string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT OR REPLACE INTO file_contents (hash, contents)
+		VALUES (?, ?);
+	`, hash, contents)
+	if err != nil {
+		return err
+	}
+
+	if c.fts {
+		_, err = tx.Exec("DELETE FROM file_contents_fts WHERE hash = ?;", hash)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO file_contents_fts (hash, contents)
+			VALUES (?, ?);
+		`, hash, contents)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) SearchFiles(query string) ([]*records.SearchHit, error) {
+	terms := search.Terms(query)
+	if len(terms) < 1 {
+		return []*records.SearchHit{}, nil
+	}
+
+	if c.fts {
+		return c.searchFilesFTS(terms)
+	}
+
+	return c.searchFilesScan(terms)
+}
+
+func (c *Client) searchFilesFTS(terms []string) ([]*records.SearchHit, error) {
+	// Quote each term so nothing in it is treated as FTS5 query syntax,
+	// documents must contain all of them to match.
+	quoted := []string{}
+	for _, term := range terms {
+		quoted = append(quoted, `"`+term+`"`)
+	}
+
+	rows, err := c.db.Query(`
+		SELECT `+fileColumns+`,
+			-bm25(file_contents_fts) AS rank,
+			snippet(file_contents_fts, 1, ?, ?, '...', 16) AS snippet
+		FROM file_contents_fts
+		INNER JOIN files f ON f.hash = file_contents_fts.hash
+		LEFT JOIN file_metadata fm ON f.hash = fm.hash
+		WHERE file_contents_fts MATCH ? AND f.deleted_at IS NULL
+		ORDER BY rank DESC, f.filename;
+	`,
+		search.SnippetStart, search.SnippetEnd,
+		strings.Join(quoted, " "),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := []*records.SearchHit{}
+	for rows.Next() {
+		hit := &records.SearchHit{
+			File: &records.File{},
+		}
+		err = rows.Scan(append(fileDest(hit.File), &hit.Rank, &hit.Snippet)...)
+		if err != nil {
+			return nil, err
+		}
+
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+func (c *Client) searchFilesScan(terms []string) ([]*records.SearchHit, error) {
+	query := `
+		SELECT ` + fileColumns + `,
+			fc.contents
+		FROM file_contents fc
+		INNER JOIN files f ON f.hash = fc.hash
+		LEFT JOIN file_metadata fm ON f.hash = fm.hash
+		WHERE f.deleted_at IS NULL AND fc.contents LIKE ?
+	`
+	query = query + strings.Repeat(" AND fc.contents LIKE ?", len(terms)-1)
+	query = query + " ORDER BY f.filename;"
+
+	args := []interface{}{}
+	for _, term := range terms {
+		args = append(args, "%"+term+"%")
+	}
+
+	rows, err := c.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := []*records.SearchHit{}
+	for rows.Next() {
+		file := &records.File{}
+		var contents sql.NullString
+		err = rows.Scan(append(fileDest(file), &contents)...)
+		if err != nil {
+			return nil, err
+		}
+
+		// LIKE also matches partial words, so only keep files that
+		// contain every term as a whole word.
+		rank := search.Score(contents.String, terms)
+		if rank <= 0 {
+			continue
+		}
+
+		hits = append(hits, &records.SearchHit{
+			File:    file,
+			Rank:    rank,
+			Snippet: search.Snippet(contents.String, terms),
+		})
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Rank > hits[j].Rank
+	})
+
+	return hits, nil
+}
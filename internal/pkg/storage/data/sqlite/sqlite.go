@@ -11,11 +11,16 @@ import (
 
 	"github.com/aphistic/softcopy/internal/pkg/storage"
 	"github.com/aphistic/softcopy/internal/pkg/storage/data/sqlite/migrations"
+	"github.com/aphistic/softcopy/internal/pkg/storage/migration"
 )
 
 type Client struct {
 	dbPath string
 	db     *sql.DB
+
+	// fts is set when sqlite was built with FTS5 support and the
+	// full-text index was set up during migration.
+	fts bool
 }
 
 var _ storage.Data = &Client{}
@@ -64,10 +69,28 @@ func (c *Client) Migrate() error {
 		return err
 	}
 
+	status, err := migration.Status(c.db, "sqlite3", ms)
+	if err != nil {
+		return err
+	}
+	err = migration.Check(status)
+	if err != nil {
+		return err
+	}
+
 	_, err = migrate.Exec(c.db, "sqlite3", ms, migrate.Up)
 	if err != nil {
 		return err
 	}
 
+	c.fts, err = c.setupFTS()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func (c *Client) Close() error {
+	return c.db.Close()
+}
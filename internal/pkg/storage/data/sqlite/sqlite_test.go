@@ -0,0 +1,213 @@
+package sqlite
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/migration"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+func TestClient(t *testing.T) {
+	storagetest.RunDataTests(t, func(t *testing.T) storage.Data {
+		dbRoot, err := ioutil.TempDir("", "softcopy-sqlite-")
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			os.RemoveAll(dbRoot)
+		})
+
+		c, err := NewClient(path.Join(dbRoot, "softcopy.db"))
+		require.NoError(t, err)
+		require.NoError(t, c.Migrate())
+
+		return c
+	})
+}
+
+func TestFullTextSearch(t *testing.T) {
+	dbRoot, err := ioutil.TempDir("", "softcopy-sqlite-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dbRoot)
+	})
+
+	c, err := NewClient(path.Join(dbRoot, "softcopy.db"))
+	require.NoError(t, err)
+	require.NoError(t, c.Migrate())
+	if !c.FullTextSearch() {
+		t.Skip("sqlite was built without FTS5")
+	}
+
+	t.Run("removing metadata removes indexed contents", func(t *testing.T) {
+		id := uuid.New()
+		require.NoError(t, c.CreateMetadataWithID("abc", 5, id))
+		require.NoError(t, c.SetFileContents("abc", "property tax statement"))
+
+		var indexed int
+		err := c.db.QueryRow(
+			"SELECT COUNT(*) FROM file_contents_fts WHERE hash = ?;", "abc",
+		).Scan(&indexed)
+		require.NoError(t, err)
+		assert.Equal(t, 1, indexed)
+
+		require.NoError(t, c.RemoveMetadata(id))
+
+		err = c.db.QueryRow(
+			"SELECT COUNT(*) FROM file_contents_fts WHERE hash = ?;", "abc",
+		).Scan(&indexed)
+		require.NoError(t, err)
+		assert.Equal(t, 0, indexed)
+	})
+	t.Run("migrating below file contents drops the index", func(t *testing.T) {
+		hasIndex := func(t *testing.T) bool {
+			var exists bool
+			err := c.db.QueryRow(`
+				SELECT EXISTS (
+					SELECT 1 FROM sqlite_master WHERE name = 'file_contents_fts'
+				);
+			`).Scan(&exists)
+			require.NoError(t, err)
+
+			return exists
+		}
+
+		status, err := c.Migrations()
+		require.NoError(t, err)
+		latest := migration.Latest(status)
+
+		_, err = c.MigrateTo(1)
+		require.NoError(t, err)
+		assert.False(t, c.FullTextSearch())
+		assert.False(t, hasIndex(t))
+
+		_, err = c.MigrateTo(latest)
+		require.NoError(t, err)
+		assert.True(t, c.FullTextSearch())
+		assert.True(t, hasIndex(t))
+	})
+}
+
+func TestMigrateTo(t *testing.T) {
+	newMigratedClient := func(t *testing.T) (*Client, string) {
+		dbRoot, err := ioutil.TempDir("", "softcopy-sqlite-")
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			os.RemoveAll(dbRoot)
+		})
+
+		c, err := NewClient(path.Join(dbRoot, "softcopy.db"))
+		require.NoError(t, err)
+		require.NoError(t, c.Migrate())
+
+		return c, dbRoot
+	}
+
+	t.Run("down and up", func(t *testing.T) {
+		c, _ := newMigratedClient(t)
+
+		status, err := c.Migrations()
+		require.NoError(t, err)
+		latest := migration.Latest(status)
+		assert.Equal(t, latest, migration.Current(status))
+
+		_, err = c.CreateTags([]string{"finance"})
+		require.NoError(t, err)
+		id, err := c.CreateFileWithTags("a.pdf", time.Now(), []string{"finance"})
+		require.NoError(t, err)
+
+		// Rebuilding the files table keeps the files and the tags
+		// that reference them.
+		applied, err := c.MigrateTo(latest - 2)
+		require.NoError(t, err)
+		assert.Equal(t, 2, applied)
+
+		status, err = c.Migrations()
+		require.NoError(t, err)
+		assert.Equal(t, latest-2, migration.Current(status))
+
+		applied, err = c.MigrateTo(latest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, applied)
+
+		f, err := c.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "a.pdf", f.Filename)
+		tags, err := c.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Contains(t, tagNames(tags), "finance")
+
+		applied, err = c.MigrateTo(0)
+		require.NoError(t, err)
+		assert.Equal(t, latest, applied)
+
+		applied, err = c.MigrateTo(latest)
+		require.NoError(t, err)
+		assert.Equal(t, latest, applied)
+
+		_, err = c.MigrateTo(latest + 1)
+		assert.Error(t, err)
+	})
+	t.Run("keeps the connection limit", func(t *testing.T) {
+		c, _ := newMigratedClient(t)
+		c.db.SetMaxOpenConns(3)
+
+		_, err := c.MigrateTo(1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, c.db.Stats().MaxOpenConnections)
+	})
+	t.Run("newer schema", func(t *testing.T) {
+		c, _ := newMigratedClient(t)
+
+		_, err := c.db.Exec(
+			"INSERT INTO gorp_migrations (id, applied_at) VALUES (?, ?);",
+			"9999-from-the-future.sql", time.Now(),
+		)
+		require.NoError(t, err)
+
+		status, err := c.Migrations()
+		require.NoError(t, err)
+		assert.True(t, status[len(status)-1].Unknown)
+
+		assert.Equal(t, migration.ErrNewerSchema, c.Migrate())
+		_, err = c.MigrateTo(1)
+		assert.Equal(t, migration.ErrNewerSchema, err)
+	})
+	t.Run("snapshot", func(t *testing.T) {
+		c, dbRoot := newMigratedClient(t)
+
+		id, err := c.CreateFile("a.pdf", time.Now())
+		require.NoError(t, err)
+
+		snapshotPath, err := c.Snapshot(path.Join(dbRoot, "snapshots"))
+		require.NoError(t, err)
+
+		snapshot, err := NewClient(snapshotPath)
+		require.NoError(t, err)
+		defer snapshot.Close()
+
+		f, err := snapshot.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "a.pdf", f.Filename)
+	})
+}
+
+func tagNames(it records.TagIterator) []string {
+	defer it.Close()
+
+	names := []string{}
+	for item := range it.Tags() {
+		if item.Error == nil {
+			names = append(names, item.Tag.Name)
+		}
+	}
+	return names
+}
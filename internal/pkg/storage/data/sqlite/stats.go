@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) GetStats() (*records.Stats, error) {
+	stats := &records.Stats{}
+	err := c.db.QueryRow(`
+		SELECT
+			(SELECT COUNT(*) FROM files WHERE deleted_at IS NULL),
+			(SELECT COUNT(*) FROM files WHERE deleted_at IS NOT NULL),
+			(
+				SELECT COUNT(*) FROM files f
+				INNER JOIN file_tags ft ON ft.file_id = f.id
+				INNER JOIN tags t ON t.id = ft.tag_id
+				WHERE f.deleted_at IS NULL AND t.name = ?
+			),
+			(SELECT COUNT(*) FROM tags),
+			(
+				SELECT ifnull(SUM(fm.file_size), 0) FROM files f
+				INNER JOIN file_metadata fm ON fm.hash = f.hash
+				WHERE f.deleted_at IS NULL
+			),
+			(SELECT COUNT(*) FROM file_metadata),
+			(SELECT ifnull(SUM(stored_size), 0) FROM file_metadata);
+	`, consts.TagUnfiled).Scan(
+		&stats.Files,
+		&stats.TrashedFiles,
+		&stats.InboxFiles,
+		&stats.Tags,
+		&stats.LogicalBytes,
+		&stats.StoredContents,
+		&stats.StoredBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.Query(`
+		SELECT strftime('%Y', document_date) AS document_year, COUNT(*)
+		FROM files
+		WHERE deleted_at IS NULL
+		GROUP BY document_year
+		ORDER BY document_year;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats.Years = []*records.YearStats{}
+	for rows.Next() {
+		year := &records.YearStats{}
+		err = rows.Scan(&year.Year, &year.Files)
+		if err != nil {
+			return nil, err
+		}
+		stats.Years = append(stats.Years, year)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
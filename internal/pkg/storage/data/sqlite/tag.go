@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
@@ -12,6 +14,64 @@ import (
 	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 )
 
+const selectTags = `
+	SELECT
+		t.id,
+		t.name,
+		t.system,
+		t.parent_id,
+		tc.id,
+		tc.name,
+		tc.color
+	FROM tags t
+	LEFT JOIN tag_categories tc ON tc.id = t.category_id
+`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTag(row rowScanner) (*records.Tag, error) {
+	tag := &records.Tag{}
+
+	var parentID, catID, catName, catColor sql.NullString
+	err := row.Scan(
+		&tag.ID,
+		&tag.Name,
+		&tag.System,
+		&parentID,
+		&catID,
+		&catName,
+		&catColor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		id, err := uuid.Parse(parentID.String)
+		if err != nil {
+			return nil, err
+		}
+		tag.ParentID = id
+	}
+
+	if catID.Valid {
+		id, err := uuid.Parse(catID.String)
+		if err != nil {
+			return nil, err
+		}
+
+		tag.Category = &records.TagCategory{
+			ID:    id,
+			Name:  catName.String,
+			Color: catColor.String,
+		}
+	}
+
+	return tag, nil
+}
+
 type sqliteTagIterator struct {
 	rows *sql.Rows
 
@@ -47,12 +107,7 @@ func (sti *sqliteTagIterator) worker() {
 
 		res := &records.TagItem{}
 
-		tag := &records.Tag{}
-		err := sti.rows.Scan(
-			&tag.ID,
-			&tag.Name,
-			&tag.System,
-		)
+		tag, err := scanTag(sti.rows)
 		if err != nil {
 			res.Error = err
 		} else {
@@ -82,9 +137,7 @@ func (sti *sqliteTagIterator) Close() error {
 }
 
 func (c *Client) AllTags() (records.TagIterator, error) {
-	query := "SELECT id, name, system FROM tags t"
-
-	rows, err := c.db.Query(query)
+	rows, err := c.db.Query(selectTags + " ORDER BY t.name;")
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +150,7 @@ func (c *Client) GetTags(names []string) ([]*records.Tag, error) {
 		return []*records.Tag{}, nil
 	}
 
-	query := "SELECT id, name, system FROM tags WHERE name IN (?"
+	query := selectTags + " WHERE t.name IN (?"
 	query = query + strings.Repeat(",? ", len(names)-1)
 	query = query + ");"
 
@@ -114,8 +167,7 @@ func (c *Client) GetTags(names []string) ([]*records.Tag, error) {
 
 	res := make([]*records.Tag, 0)
 	for rows.Next() {
-		foundTag := &records.Tag{}
-		err = rows.Scan(&foundTag.ID, &foundTag.Name, &foundTag.System)
+		foundTag, err := scanTag(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -131,9 +183,9 @@ func (c *Client) GetTags(names []string) ([]*records.Tag, error) {
 }
 
 func (c *Client) GetTagsForFile(id uuid.UUID) (records.TagIterator, error) {
-	query := "SELECT id, name, system FROM tags t "
+	query := selectTags
 	query = query + "INNER JOIN file_tags ft ON ft.tag_id = t.id "
-	query = query + "WHERE ft.file_id = ?;"
+	query = query + "WHERE ft.file_id = ? ORDER BY t.name;"
 
 	rows, err := c.db.Query(query, id)
 	if err != nil {
@@ -144,28 +196,54 @@ func (c *Client) GetTagsForFile(id uuid.UUID) (records.TagIterator, error) {
 }
 
 func (c *Client) FindTagByName(name string) (*records.Tag, error) {
-	query := `
-		SELECT id, name, system FROM tags t
-		WHERE name = ?;
-	`
+	row := c.db.QueryRow(selectTags+`
+		WHERE t.name = ?;
+	`, name)
 
-	rows, err := c.db.Query(query, name)
-	if err != nil {
+	foundTag, err := scanTag(row)
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	if !rows.Next() {
-		return nil, scerrors.ErrNotFound
+	return foundTag, nil
+}
+
+// getOrCreateTag returns the id of the named tag as part of a transaction,
+// creating it and any of its missing ancestors.
+func getOrCreateTag(tx *sql.Tx, name string) (uuid.UUID, error) {
+	var dbID string
+	err := tx.QueryRow("SELECT id FROM tags WHERE name = ?;", name).Scan(&dbID)
+	if err == nil {
+		return uuid.Parse(dbID)
+	} else if err != sql.ErrNoRows {
+		return uuid.Nil, err
 	}
 
-	foundTag := &records.Tag{}
-	err = rows.Scan(&foundTag.ID, &foundTag.Name, &foundTag.System)
+	var parentID interface{}
+	if parentName := records.TagParentName(name); parentName != "" {
+		id, err := getOrCreateTag(tx, parentName)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		parentID = id.String()
+	}
+
+	tagID, err := uuid.NewRandom()
 	if err != nil {
-		return nil, err
+		return uuid.Nil, err
 	}
 
-	return foundTag, nil
+	_, err = tx.Exec(
+		"INSERT INTO tags(id, name, parent_id) VALUES (?, ?, ?);",
+		tagID.String(), name, parentID,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return tagID, nil
 }
 
 func (c *Client) CreateTags(names []string) ([]uuid.UUID, error) {
@@ -176,44 +254,8 @@ func (c *Client) CreateTags(names []string) ([]uuid.UUID, error) {
 
 	var ids []uuid.UUID
 	for _, name := range names {
-		rows, err := tx.Query("SELECT id FROM tags WHERE name = ?", name)
-		if err != nil {
-			tx.Rollback()
-			return nil, err
-		}
-
-		if rows.Next() {
-			// If the tag already exists just return the current id
-			var dbID string
-			err = rows.Scan(&dbID)
-			if err != nil {
-				rows.Close()
-				tx.Rollback()
-				return nil, err
-			}
-
-			tagID, err := uuid.Parse(dbID)
-			if err != nil {
-				rows.Close()
-				tx.Rollback()
-				return nil, err
-			}
-
-			ids = append(ids, tagID)
-			rows.Close()
-			continue
-		}
-		rows.Close()
-
-		tagID, err := uuid.NewRandom()
-		if err != nil {
-			tx.Rollback()
-			return nil, err
-		}
-		_, err = tx.Exec(
-			"INSERT INTO tags(id, name) VALUES (?, ?);",
-			tagID.String(), name,
-		)
+		// If the tag already exists this returns the current id
+		tagID, err := getOrCreateTag(tx, name)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
@@ -247,8 +289,12 @@ func (c *Client) UpdateFileTags(id uuid.UUID, addedTags, removedTags []string) e
 	}
 
 	for _, addedTag := range added {
-		_, err = tx.Exec(
-			"INSERT OR REPLACE INTO file_tags (file_id, tag_id)	VALUES (?, ?);",
+		_, err = tx.Exec(`
+			INSERT INTO file_tags (file_id, tag_id)
+			SELECT ?1, ?2 WHERE NOT EXISTS (
+				SELECT 1 FROM file_tags WHERE file_id = ?1 AND tag_id = ?2
+			);
+		`,
 			id.String(), addedTag.ID.String(),
 		)
 		if err != nil {
@@ -268,6 +314,15 @@ func (c *Client) UpdateFileTags(id uuid.UUID, addedTags, removedTags []string) e
 		}
 	}
 
+	_, err = tx.Exec(
+		"UPDATE files SET revision = revision + 1, updated_at = ? WHERE id = ?;",
+		time.Now().UTC(), id.String(),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -275,3 +330,203 @@ func (c *Client) UpdateFileTags(id uuid.UUID, addedTags, removedTags []string) e
 
 	return nil
 }
+
+// findModifiableTag finds a tag by name as part of a transaction, returning
+// errors.ErrNotPermitted if it's a system tag.
+func findModifiableTag(tx *sql.Tx, name string) (*records.Tag, error) {
+	tag, err := scanTag(tx.QueryRow(selectTags+`
+		WHERE t.name = ?;
+	`, name))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if tag.System {
+		return nil, scerrors.ErrNotPermitted
+	}
+
+	return tag, nil
+}
+
+// hasChildTags checks if any tags have the given tag as their parent.
+func hasChildTags(tx *sql.Tx, id uuid.UUID) (bool, error) {
+	var count int
+	err := tx.QueryRow(
+		"SELECT COUNT(*) FROM tags WHERE parent_id = ?;",
+		id.String(),
+	).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (c *Client) RenameTag(oldName string, newName string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	tag, err := findModifiableTag(tx, oldName)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if strings.HasPrefix(newName, oldName+records.TagSeparator) {
+		// A tag can't be moved under itself
+		tx.Rollback()
+		return scerrors.ErrNotPermitted
+	}
+
+	var count int
+	err = tx.QueryRow(
+		"SELECT COUNT(*) FROM tags WHERE name = ? AND id != ?;",
+		newName, tag.ID.String(),
+	).Scan(&count)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if count > 0 {
+		tx.Rollback()
+		return scerrors.ErrExists
+	}
+
+	var parentID interface{}
+	if parentName := records.TagParentName(newName); parentName != "" {
+		id, err := getOrCreateTag(tx, parentName)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		parentID = id.String()
+	}
+
+	_, err = tx.Exec(
+		"UPDATE tags SET name = ?, parent_id = ? WHERE id = ?;",
+		newName, parentID, tag.ID.String(),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Descendants keep their parents but their names need to start
+	// with the new name.
+	oldPrefix := oldName + records.TagSeparator
+	prefixLen := utf8.RuneCountInString(oldPrefix)
+	_, err = tx.Exec(`
+		UPDATE tags SET name = ? || substr(name, ?)
+		WHERE substr(name, 1, ?) = ?;
+	`, newName+records.TagSeparator, prefixLen+1, prefixLen, oldPrefix)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) MergeTags(sourceName string, targetName string) error {
+	if sourceName == targetName {
+		return nil
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	source, err := findModifiableTag(tx, sourceName)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	target, err := findModifiableTag(tx, targetName)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	hasChildren, err := hasChildTags(tx, source.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if hasChildren {
+		tx.Rollback()
+		return scerrors.ErrInUse
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO file_tags (file_id, tag_id)
+		SELECT ft.file_id, ?1 FROM file_tags ft
+		WHERE ft.tag_id = ?2 AND NOT EXISTS (
+			SELECT 1 FROM file_tags WHERE file_id = ft.file_id AND tag_id = ?1
+		);
+	`, target.ID.String(), source.ID.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM file_tags WHERE tag_id = ?;", source.ID.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM tags WHERE id = ?;", source.ID.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) DeleteTag(name string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	tag, err := findModifiableTag(tx, name)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	hasChildren, err := hasChildTags(tx, tag.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if hasChildren {
+		tx.Rollback()
+		return scerrors.ErrInUse
+	}
+
+	var count int
+	err = tx.QueryRow(
+		"SELECT COUNT(*) FROM file_tags WHERE tag_id = ?;",
+		tag.ID.String(),
+	).Scan(&count)
+	if err != nil {
+		tx.Rollback()
+		return err
+	} else if count > 0 {
+		tx.Rollback()
+		return scerrors.ErrInUse
+	}
+
+	_, err = tx.Exec("DELETE FROM tags WHERE id = ?;", tag.ID.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
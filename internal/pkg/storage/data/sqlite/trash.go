@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func (c *Client) ListTrash() ([]*records.TrashedFile, error) {
+	rows, err := c.db.Query(`
+		SELECT ` + fileColumns + `, f.deleted_at
+		FROM files f
+		LEFT JOIN file_metadata fm ON f.hash = fm.hash
+		WHERE f.deleted_at IS NOT NULL
+		ORDER BY f.deleted_at, f.filename;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.TrashedFile{}
+	for rows.Next() {
+		trashed := &records.TrashedFile{
+			File: &records.File{},
+		}
+		err = rows.Scan(append(fileDest(trashed.File), &trashed.Deleted)...)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, trashed)
+	}
+
+	return res, rows.Err()
+}
+
+// isTrashed checks if the file is in the trash as part of a transaction.
+func isTrashed(tx *sql.Tx, id uuid.UUID) (bool, error) {
+	var count int
+	err := tx.QueryRow(
+		"SELECT COUNT(*) FROM files WHERE id = ? AND deleted_at IS NOT NULL;",
+		id.String(),
+	).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (c *Client) RestoreFile(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	trashed, err := isTrashed(tx, id)
+	if err != nil {
+		return err
+	} else if !trashed {
+		return scerrors.ErrNotFound
+	}
+
+	// Another file may have been created with the same name and date
+	// while this one was in the trash.
+	var conflicts int
+	err = tx.QueryRow(`
+		SELECT COUNT(*) FROM files f
+		INNER JOIN files t ON
+			t.filename = f.filename AND
+			date(t.document_date) = date(f.document_date)
+		WHERE t.id = ? AND f.deleted_at IS NULL;
+	`, id.String()).Scan(&conflicts)
+	if err != nil {
+		return err
+	} else if conflicts > 0 {
+		return scerrors.ErrExists
+	}
+
+	_, err = tx.Exec(
+		"UPDATE files SET deleted_at = NULL WHERE id = ?;",
+		id.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *Client) PurgeFile(id uuid.UUID) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	trashed, err := isTrashed(tx, id)
+	if err != nil {
+		return err
+	} else if !trashed {
+		return scerrors.ErrNotFound
+	}
+
+	for _, query := range []string{
+		"DELETE FROM file_tags WHERE file_id = ?;",
+		"DELETE FROM file_fields WHERE file_id = ?;",
+		"DELETE FROM file_versions WHERE file_id = ?;",
+		"DELETE FROM file_links WHERE ? IN (source_id, target_id);",
+		"DELETE FROM files WHERE id = ?;",
+	} {
+		_, err = tx.Exec(query, id.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
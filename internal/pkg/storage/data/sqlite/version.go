@@ -0,0 +1,138 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const selectFileVersions = `
+	SELECT
+		fv.file_id,
+		fv.version,
+		fv.hash,
+		ifnull(fm.file_size, 0) AS file_size,
+		fv.created,
+		fv.source
+	FROM file_versions fv
+	LEFT JOIN file_metadata fm ON fv.hash = fm.hash
+`
+
+func scanFileVersion(row rowScanner) (*records.FileVersion, error) {
+	version := &records.FileVersion{}
+	err := row.Scan(
+		&version.FileID,
+		&version.Version,
+		&version.Hash,
+		&version.Size,
+		&version.Created,
+		&version.Source,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (c *Client) AddFileVersion(id uuid.UUID, hash string, source string) (int, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		"UPDATE files SET hash = ?, revision = revision + 1, updated_at = ? WHERE id = ? AND deleted_at IS NULL;",
+		hash, time.Now().UTC(), id.String(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected < 1 {
+		return 0, scerrors.ErrNotFound
+	}
+
+	var version int
+	err = tx.QueryRow(
+		"SELECT ifnull(MAX(version), 0) + 1 FROM file_versions WHERE file_id = ?;",
+		id.String(),
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO file_versions (file_id, version, hash, created, source)
+		VALUES (?, ?, ?, ?, ?);
+	`, id.String(), version, hash, time.Now().UTC(), source)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (c *Client) GetFileVersions(id uuid.UUID) ([]*records.FileVersion, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	exists, err := fileExists(tx, id)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, scerrors.ErrNotFound
+	}
+
+	rows, err := tx.Query(selectFileVersions+`
+		WHERE fv.file_id = ?
+		ORDER BY fv.version;
+	`, id.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*records.FileVersion{}
+	for rows.Next() {
+		version, err := scanFileVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, version)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *Client) GetFileVersion(id uuid.UUID, version int) (*records.FileVersion, error) {
+	res, err := scanFileVersion(c.db.QueryRow(selectFileVersions+`
+		JOIN files f ON fv.file_id = f.id
+		WHERE fv.file_id = ? AND fv.version = ? AND f.deleted_at IS NULL;
+	`, id.String(), version))
+	if err == sql.ErrNoRows {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -4,14 +4,31 @@ import (
 	"io"
 
 	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
 )
 
 type File interface {
 	OpenFile(uuid.UUID) (OpenFile, error)
 	OpenTempFile(uuid.UUID) (OpenFile, error)
 
-	ReadFile(filePath string) (io.ReadCloser, error)
-	ReadFileFromOffset(filePath string, offset uint64) (io.ReadCloser, error)
+	// ReadFile reads the contents of a claimed file.
+	ReadFile(uuid.UUID) (io.ReadCloser, error)
+	// ReadFileFromOffset reads the contents of a claimed file starting
+	// at the offset.
+	ReadFileFromOffset(id uuid.UUID, offset uint64) (io.ReadCloser, error)
+
+	// ListBlobs lists the IDs of all claimed files.
+	ListBlobs() ([]uuid.UUID, error)
+	// RemoveBlob removes a claimed file, returning errors.ErrNotFound
+	// if it doesn't exist.
+	RemoveBlob(uuid.UUID) error
+	// ListTempFiles lists files that were opened for writing but never
+	// claimed or dropped.
+	ListTempFiles() ([]*records.TempFile, error)
+	// RemoveTempFile removes a temporary file, returning
+	// errors.ErrNotFound if it doesn't exist.
+	RemoveTempFile(uuid.UUID) error
 }
 
 type OpenFile interface {
@@ -29,3 +46,16 @@ type OpenFile interface {
 	// from storage
 	Drop() error
 }
+
+// StoredSizer is implemented by open files that keep their contents in a
+// different size than they were written, such as when they're compressed.
+// The stored size is only known once the file has been claimed.
+type StoredSizer interface {
+	StoredSize() uint64
+}
+
+// FileWrapper is implemented by engines that keep their files in another
+// engine, such as to encrypt or compress them.
+type FileWrapper interface {
+	Unwrap() File
+}
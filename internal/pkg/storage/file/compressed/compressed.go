@@ -0,0 +1,218 @@
+package compressed
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const (
+	// defaultChunkSize is how much of a file is compressed in each frame.
+	defaultChunkSize = 256 * 1024
+	// maxChunkSize keeps compressed frames small enough for the seek
+	// table's sizes.
+	maxChunkSize = 1024 * 1024 * 1024
+)
+
+type FileCompressedOption func(*FileCompressed)
+
+func WithLogger(logger logging.Logger) FileCompressedOption {
+	return func(fc *FileCompressed) {
+		fc.logger = logger
+	}
+}
+
+// WithChunkSize sets how much of a file is compressed in each frame,
+// which is how much needs to be decompressed to read any part of it.
+// Larger chunks compress better.
+func WithChunkSize(chunkSize int) FileCompressedOption {
+	return func(fc *FileCompressed) {
+		fc.chunkSize = chunkSize
+	}
+}
+
+// WithLevel sets how hard new files are compressed, which is one of
+// fastest, default, better or best.
+func WithLevel(level string) FileCompressedOption {
+	return func(fc *FileCompressed) {
+		fc.level = level
+	}
+}
+
+// FileCompressed is a storage.File that compresses files with zstd before
+// they're kept by another storage.File. Files that were kept before
+// compression was used are read as they are.
+type FileCompressed struct {
+	logger logging.Logger
+
+	file      storage.File
+	chunkSize int
+	level     string
+
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+
+	lock sync.RWMutex
+	// temps are the files opened for writing by this process, which know
+	// the size of their contents before they're compressed.
+	temps map[uuid.UUID]*openCompressedFile
+}
+
+var _ storage.File = &FileCompressed{}
+
+func NewFileCompressed(file storage.File, opts ...FileCompressedOption) (*FileCompressed, error) {
+	fc := &FileCompressed{
+		logger: logging.NewNilLogger(),
+
+		file:      file,
+		chunkSize: defaultChunkSize,
+		level:     "default",
+
+		temps: map[uuid.UUID]*openCompressedFile{},
+	}
+
+	for _, opt := range opts {
+		opt(fc)
+	}
+
+	if fc.chunkSize <= 0 || fc.chunkSize > maxChunkSize {
+		return nil, fmt.Errorf("chunk size must be between 1 and %d", maxChunkSize)
+	}
+
+	ok, level := zstd.EncoderLevelFromString(fc.level)
+	if !ok {
+		return nil, fmt.Errorf("unknown compression level '%s'", fc.level)
+	}
+
+	var err error
+	fc.encoder, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	if err != nil {
+		return nil, err
+	}
+	fc.decoder, err = zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return fc, nil
+}
+
+// Unwrap returns the engine the compressed files are kept in.
+func (fc *FileCompressed) Unwrap() storage.File {
+	return fc.file
+}
+
+func (fc *FileCompressed) OpenFile(id uuid.UUID) (storage.OpenFile, error) {
+	of, err := fc.file.OpenFile(id)
+	if err != nil {
+		return nil, err
+	}
+
+	st, ok, err := readSeekTable(of)
+	if err != nil {
+		of.Close()
+		return nil, err
+	}
+
+	if !ok {
+		// Kept before compression was used
+		_, err = of.Seek(0, io.SeekStart)
+		if err != nil {
+			of.Close()
+			return nil, err
+		}
+
+		return of, nil
+	}
+
+	return newOpenCompressedReadFile(fc, of, st), nil
+}
+
+func (fc *FileCompressed) OpenTempFile(handleID uuid.UUID) (storage.OpenFile, error) {
+	of, err := fc.file.OpenTempFile(handleID)
+	if err != nil {
+		return nil, err
+	}
+
+	ocf := newOpenCompressedWriteFile(fc, of, handleID)
+
+	fc.lock.Lock()
+	fc.temps[handleID] = ocf
+	fc.lock.Unlock()
+
+	return ocf, nil
+}
+
+func (fc *FileCompressed) removeTemp(handleID uuid.UUID) {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+
+	delete(fc.temps, handleID)
+}
+
+func (fc *FileCompressed) ReadFile(id uuid.UUID) (io.ReadCloser, error) {
+	return fc.ReadFileFromOffset(id, 0)
+}
+
+// ReadFileFromOffset reads through OpenFile, which needs the seek table
+// at the end of the file to find the offset.
+func (fc *FileCompressed) ReadFileFromOffset(
+	id uuid.UUID,
+	offset uint64,
+) (io.ReadCloser, error) {
+	of, err := fc.OpenFile(id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = of.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		of.Close()
+		return nil, err
+	}
+
+	return of, nil
+}
+
+func (fc *FileCompressed) ListBlobs() ([]uuid.UUID, error) {
+	return fc.file.ListBlobs()
+}
+
+func (fc *FileCompressed) RemoveBlob(id uuid.UUID) error {
+	return fc.file.RemoveBlob(id)
+}
+
+func (fc *FileCompressed) ListTempFiles() ([]*records.TempFile, error) {
+	temps, err := fc.file.ListTempFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	// Files opened by this process report the size of what's been
+	// written to them rather than what's been compressed so far.
+	fc.lock.RLock()
+	open := make(map[uuid.UUID]*openCompressedFile, len(fc.temps))
+	for handleID, ocf := range fc.temps {
+		open[handleID] = ocf
+	}
+	fc.lock.RUnlock()
+
+	for _, temp := range temps {
+		if ocf, ok := open[temp.ID]; ok {
+			temp.Size = ocf.writtenSize()
+		}
+	}
+
+	return temps, nil
+}
+
+func (fc *FileCompressed) RemoveTempFile(handleID uuid.UUID) error {
+	return fc.file.RemoveTempFile(handleID)
+}
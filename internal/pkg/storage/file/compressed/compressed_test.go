@@ -0,0 +1,242 @@
+package compressed
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	fileEncrypted "github.com/aphistic/softcopy/internal/pkg/storage/file/encrypted"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+func newTestFileCompressed(
+	t *testing.T,
+	file storage.File,
+	opts ...FileCompressedOption,
+) *FileCompressed {
+	fc, err := NewFileCompressed(file, opts...)
+	require.NoError(t, err)
+
+	return fc
+}
+
+func writeTest(t *testing.T, f storage.File, id uuid.UUID, data string) storage.OpenFile {
+	of, err := f.OpenTempFile(uuid.New())
+	require.NoError(t, err)
+	_, err = io.Copy(of, strings.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, of.Claim(id))
+
+	return of
+}
+
+func readTest(t *testing.T, f storage.File, id uuid.UUID) string {
+	of, err := f.OpenFile(id)
+	require.NoError(t, err)
+	defer of.Close()
+
+	data, err := ioutil.ReadAll(of)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestFileCompressed(t *testing.T) {
+	t.Run("conformance", func(t *testing.T) {
+		storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+			return newTestFileCompressed(t, fileMemory.NewFileMemory())
+		})
+	})
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := NewFileCompressed(fileMemory.NewFileMemory(), WithChunkSize(0))
+		assert.Error(t, err)
+		_, err = NewFileCompressed(fileMemory.NewFileMemory(), WithLevel("loud"))
+		assert.Error(t, err)
+		_, err = NewFileCompressed(fileMemory.NewFileMemory(), WithLevel("best"))
+		assert.NoError(t, err)
+	})
+	t.Run("keeps files compressed", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		fc := newTestFileCompressed(t, mem)
+
+		contents := strings.Repeat("hello world ", 1000)
+		id := uuid.New()
+		of := writeTest(t, fc, id, contents)
+
+		stored := readTest(t, mem, id)
+		assert.Less(t, len(stored), len(contents)/10)
+		require.Implements(t, (*storage.StoredSizer)(nil), of)
+		assert.EqualValues(t, len(stored), of.(storage.StoredSizer).StoredSize())
+		assert.Equal(t, contents, readTest(t, fc, id))
+
+		// Any zstd decoder can read the files
+		dec, err := zstd.NewReader(strings.NewReader(stored))
+		require.NoError(t, err)
+		defer dec.Close()
+		plain, err := ioutil.ReadAll(dec)
+		require.NoError(t, err)
+		assert.Equal(t, contents, string(plain))
+	})
+	t.Run("empty file", func(t *testing.T) {
+		fc := newTestFileCompressed(t, fileMemory.NewFileMemory())
+
+		id := uuid.New()
+		writeTest(t, fc, id, "")
+		assert.Equal(t, "", readTest(t, fc, id))
+	})
+	t.Run("random access", func(t *testing.T) {
+		fc := newTestFileCompressed(t, fileMemory.NewFileMemory(), WithChunkSize(4))
+
+		id := uuid.New()
+		writeTest(t, fc, id, "hello world!")
+
+		of, err := fc.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		size, err := of.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		assert.EqualValues(t, 12, size)
+
+		buf := make([]byte, 5)
+		_, err = of.Seek(3, io.SeekStart)
+		require.NoError(t, err)
+		_, err = io.ReadFull(of, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "lo wo", string(buf))
+
+		for offset, expected := range map[uint64]string{
+			0:  "hello world!",
+			4:  "o world!",
+			6:  "world!",
+			11: "!",
+			12: "",
+			20: "",
+		} {
+			r, err := fc.ReadFileFromOffset(id, offset)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, expected, string(data), "offset %d", offset)
+		}
+	})
+	t.Run("writes out of order within a chunk", func(t *testing.T) {
+		fc := newTestFileCompressed(t, fileMemory.NewFileMemory(), WithChunkSize(4))
+
+		of, err := fc.OpenTempFile(uuid.New())
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello world!"))
+		require.NoError(t, err)
+		_, err = of.Seek(-2, io.SeekEnd)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("?"))
+		require.NoError(t, err)
+		_, err = of.Seek(0, io.SeekStart)
+		assert.Error(t, err)
+
+		id := uuid.New()
+		require.NoError(t, of.Claim(id))
+		assert.Equal(t, "hello worl?!", readTest(t, fc, id))
+	})
+	t.Run("reads files kept before compression", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		fc := newTestFileCompressed(t, mem)
+
+		id := uuid.New()
+		writeTest(t, mem, id, "kept before compression")
+		assert.Equal(t, "kept before compression", readTest(t, fc, id))
+
+		r, err := fc.ReadFileFromOffset(id, 5)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "before compression", string(data))
+
+		// Contents that only look compressed at the end are read as they are
+		tail := newSeekTable().marshal()
+		looksCompressed := "not really compressed" + string(tail)
+		writeTest(t, mem, id, looksCompressed)
+		assert.Equal(t, looksCompressed, readTest(t, fc, id))
+	})
+	t.Run("detects changes", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		fc := newTestFileCompressed(t, mem, WithChunkSize(4))
+
+		id := uuid.New()
+		writeTest(t, fc, id, "hello world!")
+
+		stored := []byte(readTest(t, mem, id))
+		// Change the checksum at the end of the last frame
+		stored[len(stored)-footerSize-tableHeadSize-3*entrySize-1] ^= 0xff
+		writeTest(t, mem, id, string(stored))
+
+		_, err := ioutil.ReadAll(mustOpen(t, fc, id))
+		assert.Error(t, err)
+	})
+	t.Run("reports temp file sizes", func(t *testing.T) {
+		fc := newTestFileCompressed(t, fileMemory.NewFileMemory(), WithChunkSize(4))
+
+		handleID := uuid.New()
+		of, err := fc.OpenTempFile(handleID)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello world!"))
+		require.NoError(t, err)
+
+		temps, err := fc.ListTempFiles()
+		require.NoError(t, err)
+		require.Len(t, temps, 1)
+		assert.Equal(t, handleID, temps[0].ID)
+		assert.EqualValues(t, 12, temps[0].Size)
+
+		require.NoError(t, of.Drop())
+		temps, err = fc.ListTempFiles()
+		require.NoError(t, err)
+		assert.Len(t, temps, 0)
+	})
+	t.Run("compresses before encrypting", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		fe, err := fileEncrypted.NewFileEncrypted(
+			mem,
+			map[string][]byte{"a": bytes.Repeat([]byte{0xa}, 32)},
+			"a",
+		)
+		require.NoError(t, err)
+		fc := newTestFileCompressed(t, fe)
+		assert.Equal(t, fe, fc.Unwrap())
+
+		contents := strings.Repeat("hello world ", 1000)
+		id := uuid.New()
+		writeTest(t, fc, id, contents)
+
+		assert.Less(t, len(readTest(t, mem, id)), len(contents)/10)
+		assert.Equal(t, contents, readTest(t, fc, id))
+
+		r, err := fc.ReadFileFromOffset(id, 6)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, contents[6:], string(data))
+	})
+}
+
+func mustOpen(t *testing.T, f storage.File, id uuid.UUID) storage.OpenFile {
+	of, err := f.OpenFile(id)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		of.Close()
+	})
+
+	return of
+}
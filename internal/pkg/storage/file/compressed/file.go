@@ -0,0 +1,287 @@
+package compressed
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type openCompressedFile struct {
+	fileCompressed *FileCompressed
+
+	mode   records.FileMode
+	file   storage.OpenFile
+	st     *seekTable
+	offset int64
+
+	// frame is the index of the decompressed frame in plain, or -1 if
+	// nothing is decompressed yet.
+	frame int
+	plain []byte
+
+	// lock guards the contents of a file opened for writing, which are
+	// also read when listing temp files. The seek table has the frames
+	// that have been compressed, buf holds what's been written after them.
+	// A frame is only compressed once it's been written past, so it won't
+	// be written to again. storedSize is the size of the file once it's
+	// been claimed.
+	lock       sync.Mutex
+	handleID   uuid.UUID
+	buf        []byte
+	storedSize uint64
+}
+
+var _ storage.StoredSizer = &openCompressedFile{}
+
+func newOpenCompressedReadFile(
+	fc *FileCompressed,
+	file storage.OpenFile,
+	st *seekTable,
+) *openCompressedFile {
+	return &openCompressedFile{
+		fileCompressed: fc,
+
+		mode: records.FILE_MODE_READ,
+		file: file,
+		st:   st,
+
+		frame: -1,
+	}
+}
+
+func newOpenCompressedWriteFile(
+	fc *FileCompressed,
+	file storage.OpenFile,
+	handleID uuid.UUID,
+) *openCompressedFile {
+	return &openCompressedFile{
+		fileCompressed: fc,
+
+		mode: records.FILE_MODE_WRITE,
+		file: file,
+		st:   newSeekTable(),
+
+		frame:    -1,
+		handleID: handleID,
+	}
+}
+
+func (ocf *openCompressedFile) Seek(offset int64, whence int) (int64, error) {
+	ocf.lock.Lock()
+	defer ocf.lock.Unlock()
+
+	size := ocf.st.plainSize()
+	if ocf.mode == records.FILE_MODE_WRITE {
+		size += int64(len(ocf.buf))
+	}
+
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = ocf.offset + offset
+	case io.SeekEnd:
+		newOffset = size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if newOffset < 0 {
+		return 0, fmt.Errorf("negative offset")
+	}
+	if ocf.mode == records.FILE_MODE_WRITE && newOffset < ocf.st.plainSize() {
+		return 0, fmt.Errorf("can't seek to data that's already been compressed")
+	}
+
+	ocf.offset = newOffset
+
+	return newOffset, nil
+}
+
+func (ocf *openCompressedFile) Read(b []byte) (int, error) {
+	if ocf.mode != records.FILE_MODE_READ {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+
+	if ocf.offset >= ocf.st.plainSize() {
+		return 0, io.EOF
+	}
+
+	index := ocf.st.frame(ocf.offset)
+	if index != ocf.frame {
+		err := ocf.readFrame(index)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, ocf.plain[ocf.offset-ocf.st.plainOffsets[index]:])
+	ocf.offset += int64(n)
+
+	return n, nil
+}
+
+// readFrame reads and decompresses the frame with the index.
+func (ocf *openCompressedFile) readFrame(index int) error {
+	start := ocf.st.compressedOffsets[index]
+	compressed := make([]byte, ocf.st.compressedOffsets[index+1]-start)
+
+	_, err := ocf.file.Seek(start, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = io.ReadFull(ocf.file, compressed)
+	if err != nil {
+		return err
+	}
+
+	ocf.frame = -1
+	ocf.plain, err = ocf.fileCompressed.decoder.DecodeAll(compressed, ocf.plain[:0])
+	if err != nil {
+		return fmt.Errorf("could not decompress frame %d: %s", index, err)
+	}
+	err = ocf.st.checkFrame(index, ocf.plain)
+	if err != nil {
+		return err
+	}
+	ocf.frame = index
+
+	return nil
+}
+
+func (ocf *openCompressedFile) Write(b []byte) (int, error) {
+	if ocf.mode != records.FILE_MODE_WRITE {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+
+	ocf.lock.Lock()
+	defer ocf.lock.Unlock()
+
+	// Writing past the end of the file fills the gap with zeros,
+	// the same as a sparse file on disk.
+	bufOffset := ocf.offset - ocf.st.plainSize()
+	end := bufOffset + int64(len(b))
+	if end > int64(len(ocf.buf)) {
+		grown := make([]byte, end)
+		copy(grown, ocf.buf)
+		ocf.buf = grown
+	}
+
+	n := copy(ocf.buf[bufOffset:], b)
+	ocf.offset += int64(n)
+
+	// Compress full chunks once they've been written past, files are
+	// almost always written in order so they won't be written to again.
+	chunkSize := ocf.fileCompressed.chunkSize
+	for len(ocf.buf) > chunkSize && ocf.offset-ocf.st.plainSize() >= int64(chunkSize) {
+		err := ocf.writeFrame(ocf.buf[:chunkSize])
+		if err != nil {
+			return n, err
+		}
+
+		ocf.buf = append([]byte{}, ocf.buf[chunkSize:]...)
+	}
+
+	return n, nil
+}
+
+// writeFrame compresses the next frame and writes it to the file. The
+// caller must hold the lock.
+func (ocf *openCompressedFile) writeFrame(plain []byte) error {
+	if ocf.st.frames() >= maxTableFrames {
+		return fmt.Errorf("file is too large to compress")
+	}
+
+	compressed := ocf.fileCompressed.encoder.EncodeAll(plain, nil)
+	_, err := ocf.file.Write(compressed)
+	if err != nil {
+		return err
+	}
+	ocf.st.add(int64(len(compressed)), int64(len(plain)))
+
+	return nil
+}
+
+// Flush flushes the frames that have been compressed, the rest are
+// compressed when the file is claimed.
+func (ocf *openCompressedFile) Flush() error {
+	return ocf.file.Flush()
+}
+
+func (ocf *openCompressedFile) Close() error {
+	ocf.plain = nil
+	return ocf.file.Close()
+}
+
+func (ocf *openCompressedFile) Claim(id uuid.UUID) error {
+	if ocf.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	ocf.lock.Lock()
+	defer ocf.lock.Unlock()
+
+	ocf.fileCompressed.removeTemp(ocf.handleID)
+
+	chunkSize := ocf.fileCompressed.chunkSize
+	for len(ocf.buf) > 0 {
+		size := chunkSize
+		if len(ocf.buf) < size {
+			size = len(ocf.buf)
+		}
+
+		err := ocf.writeFrame(ocf.buf[:size])
+		if err != nil {
+			return err
+		}
+		ocf.buf = ocf.buf[size:]
+	}
+	ocf.buf = nil
+
+	table := ocf.st.marshal()
+	_, err := ocf.file.Write(table)
+	if err != nil {
+		return err
+	}
+	ocf.storedSize = uint64(ocf.st.compressedSize()) + uint64(len(table))
+
+	return ocf.file.Claim(id)
+}
+
+func (ocf *openCompressedFile) Drop() error {
+	if ocf.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	ocf.lock.Lock()
+	defer ocf.lock.Unlock()
+
+	ocf.fileCompressed.removeTemp(ocf.handleID)
+	ocf.buf = nil
+
+	return ocf.file.Drop()
+}
+
+// StoredSize is the size of the compressed file once it's been claimed.
+func (ocf *openCompressedFile) StoredSize() uint64 {
+	ocf.lock.Lock()
+	defer ocf.lock.Unlock()
+
+	return ocf.storedSize
+}
+
+// writtenSize is the size of what's been written to a file opened for
+// writing.
+func (ocf *openCompressedFile) writtenSize() uint64 {
+	ocf.lock.Lock()
+	defer ocf.lock.Unlock()
+
+	return uint64(ocf.st.plainSize()) + uint64(len(ocf.buf))
+}
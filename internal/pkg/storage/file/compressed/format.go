@@ -0,0 +1,200 @@
+package compressed
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Compressed files use the zstd seekable format, so they can be read with
+// any zstd decoder. The contents are split into chunks that are each
+// compressed as an independent zstd frame, followed by a seek table in a
+// skippable frame so any part of a file can be read by only decompressing
+// the frame it's in:
+//
+//	frames         (zstd frames)
+//	table magic    uint32 0x184D2A5E
+//	table size     uint32
+//	entries        ([number of frames] of compressed size uint32,
+//	               decompressed size uint32, [checksum uint32])
+//	frame count    uint32
+//	descriptor     uint8
+//	seekable magic uint32 0x8F92EAB1
+//
+// Everything in the seek table is little endian. The checksums are only
+// there if the descriptor's top bit is set, and aren't written or checked
+// since the zstd frames have their own.
+const (
+	zstdMagic      = 0xFD2FB528
+	tableMagic     = 0x184D2A5E
+	seekableMagic  = 0x8F92EAB1
+	checksumFlag   = 0x80
+	reservedFlags  = 0x7C
+	tableHeadSize  = 8
+	footerSize     = 9
+	entrySize      = 8
+	checksumSize   = 4
+	maxTableFrames = 0x8000000
+)
+
+type seekTable struct {
+	// compressedOffsets and plainOffsets are where each frame starts in
+	// the file and in its decompressed contents, with an extra offset at
+	// the end for where the frames end.
+	compressedOffsets []int64
+	plainOffsets      []int64
+}
+
+func newSeekTable() *seekTable {
+	return &seekTable{
+		compressedOffsets: []int64{0},
+		plainOffsets:      []int64{0},
+	}
+}
+
+func (st *seekTable) frames() int {
+	return len(st.plainOffsets) - 1
+}
+
+func (st *seekTable) add(compressedSize int64, plainSize int64) {
+	st.compressedOffsets = append(st.compressedOffsets, st.compressedSize()+compressedSize)
+	st.plainOffsets = append(st.plainOffsets, st.plainSize()+plainSize)
+}
+
+// compressedSize is the size of the frames, without the seek table.
+func (st *seekTable) compressedSize() int64 {
+	return st.compressedOffsets[len(st.compressedOffsets)-1]
+}
+
+func (st *seekTable) plainSize() int64 {
+	return st.plainOffsets[len(st.plainOffsets)-1]
+}
+
+// frame returns the index of the frame holding the decompressed offset,
+// which must be less than plainSize.
+func (st *seekTable) frame(offset int64) int {
+	return sort.Search(st.frames(), func(i int) bool {
+		return st.plainOffsets[i+1] > offset
+	})
+}
+
+func (st *seekTable) marshal() []byte {
+	frames := st.frames()
+
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.LittleEndian, uint32(tableMagic))
+	binary.Write(buf, binary.LittleEndian, uint32(frames*entrySize+footerSize))
+	for i := 0; i < frames; i++ {
+		binary.Write(buf, binary.LittleEndian, uint32(st.compressedOffsets[i+1]-st.compressedOffsets[i]))
+		binary.Write(buf, binary.LittleEndian, uint32(st.plainOffsets[i+1]-st.plainOffsets[i]))
+	}
+	binary.Write(buf, binary.LittleEndian, uint32(frames))
+	buf.WriteByte(0)
+	binary.Write(buf, binary.LittleEndian, uint32(seekableMagic))
+
+	return buf.Bytes()
+}
+
+// readSeekTable reads the seek table from the end of a file, returning
+// false if the file isn't compressed.
+func readSeekTable(r io.ReadSeeker) (*seekTable, bool, error) {
+	fileSize, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, false, err
+	}
+	if fileSize < tableHeadSize+footerSize {
+		return nil, false, nil
+	}
+
+	footer := make([]byte, footerSize)
+	_, err = r.Seek(fileSize-footerSize, io.SeekStart)
+	if err != nil {
+		return nil, false, err
+	}
+	_, err = io.ReadFull(r, footer)
+	if err != nil {
+		return nil, false, err
+	}
+
+	frames := int64(binary.LittleEndian.Uint32(footer[0:4]))
+	descriptor := footer[4]
+	if binary.LittleEndian.Uint32(footer[5:9]) != seekableMagic ||
+		descriptor&reservedFlags != 0 ||
+		frames > maxTableFrames {
+		return nil, false, nil
+	}
+
+	size := int64(entrySize)
+	if descriptor&checksumFlag != 0 {
+		size += checksumSize
+	}
+
+	tableSize := tableHeadSize + frames*size + footerSize
+	if tableSize > fileSize {
+		return nil, false, nil
+	}
+
+	table := make([]byte, tableSize-footerSize)
+	_, err = r.Seek(fileSize-tableSize, io.SeekStart)
+	if err != nil {
+		return nil, false, err
+	}
+	_, err = io.ReadFull(r, table)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if binary.LittleEndian.Uint32(table[0:4]) != tableMagic ||
+		int64(binary.LittleEndian.Uint32(table[4:8])) != tableSize-tableHeadSize {
+		return nil, false, nil
+	}
+
+	st := newSeekTable()
+	entries := table[tableHeadSize:]
+	for i := int64(0); i < frames; i++ {
+		entry := entries[i*size:]
+		st.add(
+			int64(binary.LittleEndian.Uint32(entry[0:4])),
+			int64(binary.LittleEndian.Uint32(entry[4:8])),
+		)
+	}
+
+	// Files that just happen to end like a seek table won't also have
+	// frames that add up to the rest of the file.
+	if st.compressedSize()+tableSize != fileSize {
+		return nil, false, nil
+	}
+
+	if frames > 0 {
+		magic := make([]byte, 4)
+		_, err = r.Seek(0, io.SeekStart)
+		if err != nil {
+			return nil, false, err
+		}
+		_, err = io.ReadFull(r, magic)
+		if err != nil {
+			return nil, false, err
+		}
+		if binary.LittleEndian.Uint32(magic) != zstdMagic {
+			return nil, false, nil
+		}
+	}
+
+	return st, true, nil
+}
+
+// checkFrame makes sure a frame decompressed to the size in the seek
+// table.
+func (st *seekTable) checkFrame(index int, plain []byte) error {
+	expected := st.plainOffsets[index+1] - st.plainOffsets[index]
+	if int64(len(plain)) != expected {
+		return fmt.Errorf(
+			"frame %d decompressed to %d bytes instead of %d",
+			index, len(plain), expected,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,423 @@
+package encrypted
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// defaultChunkSize is how much of a file is sealed in each frame.
+const defaultChunkSize = 64 * 1024
+
+type FileEncryptedOption func(*FileEncrypted)
+
+func WithLogger(logger logging.Logger) FileEncryptedOption {
+	return func(fe *FileEncrypted) {
+		fe.logger = logger
+	}
+}
+
+// WithChunkSize sets how much of a file is sealed in each frame, which
+// is how much needs to be decrypted to read any part of it.
+func WithChunkSize(chunkSize int) FileEncryptedOption {
+	return func(fe *FileEncrypted) {
+		fe.chunkSize = uint32(chunkSize)
+	}
+}
+
+// ParseKey decodes a base64 encoded AES key, which needs to be 16, 24 or
+// 32 bytes for AES-128, AES-192 or AES-256.
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key: %s", err)
+	}
+
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("key is %d bytes instead of 16, 24 or 32", len(key))
+	}
+}
+
+// FileEncrypted is a storage.File that encrypts files before they're kept
+// by another storage.File. Files are encrypted with the current key and
+// name the key they were encrypted with, so earlier keys can be kept to
+// read files until they're encrypted again with Reencrypt. Files that
+// were kept before encryption was used are read as they are.
+type FileEncrypted struct {
+	logger logging.Logger
+
+	file      storage.File
+	chunkSize uint32
+
+	keyID string
+	aeads map[string]cipher.AEAD
+
+	lock sync.RWMutex
+	// temps are the files opened for writing by this process, which know
+	// the size of their contents before they're encrypted.
+	temps map[uuid.UUID]*openEncryptedFile
+}
+
+var _ storage.File = &FileEncrypted{}
+
+// NewFileEncrypted encrypts files kept by file with the keys, by their
+// IDs, using the key with keyID for new files.
+func NewFileEncrypted(
+	file storage.File,
+	keys map[string][]byte,
+	keyID string,
+	opts ...FileEncryptedOption,
+) (*FileEncrypted, error) {
+	fe := &FileEncrypted{
+		logger: logging.NewNilLogger(),
+
+		file:      file,
+		chunkSize: defaultChunkSize,
+
+		keyID: keyID,
+		aeads: map[string]cipher.AEAD{},
+		temps: map[uuid.UUID]*openEncryptedFile{},
+	}
+
+	for _, opt := range opts {
+		opt(fe)
+	}
+
+	if fe.chunkSize == 0 {
+		return nil, fmt.Errorf("chunk size must be more than 0")
+	}
+
+	for id, key := range keys {
+		if id == "" || len(id) > maxKeyIDSize {
+			return nil, fmt.Errorf("key ID '%s' must be 1 to %d bytes", id, maxKeyIDSize)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %s", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %s", id, err)
+		}
+
+		fe.aeads[id] = aead
+	}
+
+	if _, ok := fe.aeads[keyID]; !ok {
+		return nil, fmt.Errorf("key %s doesn't exist", keyID)
+	}
+
+	return fe, nil
+}
+
+// KeyID returns the ID of the key new files are encrypted with.
+func (fe *FileEncrypted) KeyID() string {
+	return fe.keyID
+}
+
+// Unwrap returns the engine the encrypted files are kept in.
+func (fe *FileEncrypted) Unwrap() storage.File {
+	return fe.file
+}
+
+func (fe *FileEncrypted) aead(keyID string) (cipher.AEAD, error) {
+	aead, ok := fe.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("file is encrypted with unknown key %s", keyID)
+	}
+
+	return aead, nil
+}
+
+func (fe *FileEncrypted) OpenFile(id uuid.UUID) (storage.OpenFile, error) {
+	of, err := fe.file.OpenFile(id)
+	if err != nil {
+		return nil, err
+	}
+
+	h, ok, err := readHeader(of)
+	if err != nil {
+		of.Close()
+		return nil, err
+	} else if !ok {
+		// Kept before encryption was used
+		_, err = of.Seek(0, io.SeekStart)
+		if err != nil {
+			of.Close()
+			return nil, err
+		}
+
+		return of, nil
+	}
+
+	oef, err := newOpenEncryptedReadFile(fe, of, h)
+	if err != nil {
+		of.Close()
+		return nil, err
+	}
+
+	return oef, nil
+}
+
+func (fe *FileEncrypted) OpenTempFile(handleID uuid.UUID) (storage.OpenFile, error) {
+	h := &header{
+		chunkSize: fe.chunkSize,
+		keyID:     fe.keyID,
+	}
+	_, err := rand.Read(h.noncePrefix[:])
+	if err != nil {
+		return nil, err
+	}
+
+	of, err := fe.file.OpenTempFile(handleID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = of.Write(h.marshal())
+	if err != nil {
+		of.Drop()
+		return nil, err
+	}
+
+	oef := newOpenEncryptedWriteFile(fe, of, h, handleID)
+
+	fe.lock.Lock()
+	fe.temps[handleID] = oef
+	fe.lock.Unlock()
+
+	return oef, nil
+}
+
+func (fe *FileEncrypted) removeTemp(handleID uuid.UUID) {
+	fe.lock.Lock()
+	defer fe.lock.Unlock()
+
+	delete(fe.temps, handleID)
+}
+
+// readFileHeader reads the header of a claimed file, returning false if
+// it isn't encrypted.
+func (fe *FileEncrypted) readFileHeader(id uuid.UUID) (*header, bool, error) {
+	r, err := fe.file.ReadFile(id)
+	if err != nil {
+		return nil, false, err
+	}
+	defer r.Close()
+
+	return readHeader(r)
+}
+
+func (fe *FileEncrypted) ReadFile(id uuid.UUID) (io.ReadCloser, error) {
+	return fe.ReadFileFromOffset(id, 0)
+}
+
+func (fe *FileEncrypted) ReadFileFromOffset(
+	id uuid.UUID,
+	offset uint64,
+) (io.ReadCloser, error) {
+	h, ok, err := fe.readFileHeader(id)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return fe.file.ReadFileFromOffset(id, offset)
+	}
+
+	aead, err := fe.aead(h.keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	index := int64(offset / uint64(h.chunkSize))
+	r, err := fe.file.ReadFileFromOffset(id, uint64(h.frameOffset(aead, index)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &frameReader{
+		r:     r,
+		h:     h,
+		aead:  aead,
+		index: index,
+		skip:  int(offset % uint64(h.chunkSize)),
+	}, nil
+}
+
+func (fe *FileEncrypted) ListBlobs() ([]uuid.UUID, error) {
+	return fe.file.ListBlobs()
+}
+
+func (fe *FileEncrypted) RemoveBlob(id uuid.UUID) error {
+	return fe.file.RemoveBlob(id)
+}
+
+func (fe *FileEncrypted) ListTempFiles() ([]*records.TempFile, error) {
+	temps, err := fe.file.ListTempFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	// Files opened by this process report the size of what's been
+	// written to them rather than what's been encrypted so far.
+	fe.lock.RLock()
+	open := make(map[uuid.UUID]*openEncryptedFile, len(fe.temps))
+	for handleID, oef := range fe.temps {
+		open[handleID] = oef
+	}
+	fe.lock.RUnlock()
+
+	for _, temp := range temps {
+		if oef, ok := open[temp.ID]; ok {
+			temp.Size = oef.writtenSize()
+		}
+	}
+
+	return temps, nil
+}
+
+func (fe *FileEncrypted) RemoveTempFile(handleID uuid.UUID) error {
+	return fe.file.RemoveTempFile(handleID)
+}
+
+// BlobKeyID returns the ID of the key a claimed file is encrypted with,
+// or an empty string if it isn't encrypted.
+func (fe *FileEncrypted) BlobKeyID(id uuid.UUID) (string, error) {
+	of, err := fe.file.OpenFile(id)
+	if err != nil {
+		return "", err
+	}
+	defer of.Close()
+
+	h, ok, err := readHeader(of)
+	if err != nil {
+		return "", err
+	} else if !ok {
+		return "", nil
+	}
+
+	return h.keyID, nil
+}
+
+// Reencrypt encrypts a claimed file again with the current key, or for
+// the first time if it wasn't encrypted.
+func (fe *FileEncrypted) Reencrypt(id uuid.UUID) error {
+	src, err := fe.OpenFile(id)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	handleID, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	dst, err := fe.OpenTempFile(handleID)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		dst.Drop()
+		return err
+	}
+
+	return dst.Claim(id)
+}
+
+// frameReader decrypts a file read from the start of a frame. It reads
+// a frame ahead to know whether the frame it's decrypting is the last.
+type frameReader struct {
+	r    io.ReadCloser
+	h    *header
+	aead cipher.AEAD
+
+	index int64
+	// skip is how much of the first frame to skip to get to the offset
+	// the file was read from.
+	skip int
+
+	next  []byte
+	plain []byte
+	done  bool
+}
+
+// readSealed reads the next sealed frame, which is empty at the end of
+// the file.
+func (fr *frameReader) readSealed() ([]byte, error) {
+	buf := make([]byte, fr.h.frameSize(fr.aead))
+	n, err := io.ReadFull(fr.r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return buf[:n], nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+func (fr *frameReader) Read(b []byte) (int, error) {
+	for len(fr.plain) == 0 {
+		if fr.done {
+			return 0, io.EOF
+		}
+
+		sealed := fr.next
+		if sealed == nil {
+			var err error
+			sealed, err = fr.readSealed()
+			if err != nil {
+				return 0, err
+			}
+		}
+		if len(sealed) == 0 {
+			// Read from past the end of the file
+			fr.done = true
+			return 0, io.EOF
+		}
+
+		next, err := fr.readSealed()
+		if err != nil {
+			return 0, err
+		}
+		fr.next = next
+		last := len(next) == 0
+
+		plain, err := fr.h.open(fr.aead, fr.index, last, sealed)
+		if err != nil {
+			return 0, err
+		}
+		fr.index++
+		fr.done = last
+
+		if fr.skip > len(plain) {
+			fr.skip = len(plain)
+		}
+		fr.plain = plain[fr.skip:]
+		fr.skip = 0
+	}
+
+	n := copy(b, fr.plain)
+	fr.plain = fr.plain[n:]
+
+	return n, nil
+}
+
+func (fr *frameReader) Close() error {
+	return fr.r.Close()
+}
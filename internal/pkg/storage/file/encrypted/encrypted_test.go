@@ -0,0 +1,214 @@
+package encrypted
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	fileMemory "github.com/aphistic/softcopy/internal/pkg/storage/file/memory"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+var (
+	testKeyA = bytes.Repeat([]byte{0xa}, 32)
+	testKeyB = bytes.Repeat([]byte{0xb}, 16)
+)
+
+func newTestFileEncrypted(
+	t *testing.T,
+	file storage.File,
+	keyID string,
+	opts ...FileEncryptedOption,
+) *FileEncrypted {
+	fe, err := NewFileEncrypted(
+		file,
+		map[string][]byte{"a": testKeyA, "b": testKeyB},
+		keyID,
+		opts...,
+	)
+	require.NoError(t, err)
+
+	return fe
+}
+
+func writeTest(t *testing.T, f storage.File, id uuid.UUID, data string) {
+	of, err := f.OpenTempFile(uuid.New())
+	require.NoError(t, err)
+	_, err = io.Copy(of, strings.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, of.Claim(id))
+}
+
+func readTest(t *testing.T, f storage.File, id uuid.UUID) string {
+	of, err := f.OpenFile(id)
+	require.NoError(t, err)
+	defer of.Close()
+
+	data, err := ioutil.ReadAll(of)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestFileEncrypted(t *testing.T) {
+	t.Run("conformance", func(t *testing.T) {
+		storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+			return newTestFileEncrypted(t, fileMemory.NewFileMemory(), "a")
+		})
+	})
+	t.Run("invalid keys", func(t *testing.T) {
+		_, err := NewFileEncrypted(fileMemory.NewFileMemory(), map[string][]byte{"a": testKeyA}, "b")
+		assert.Error(t, err)
+		_, err = NewFileEncrypted(fileMemory.NewFileMemory(), map[string][]byte{"a": []byte("short")}, "a")
+		assert.Error(t, err)
+
+		_, err = ParseKey("c2hvcnQ=")
+		assert.Error(t, err)
+		key, err := ParseKey("CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgo=\n")
+		require.NoError(t, err)
+		assert.Equal(t, testKeyA, key)
+	})
+	t.Run("keeps files encrypted", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		fe := newTestFileEncrypted(t, mem, "a")
+
+		id := uuid.New()
+		writeTest(t, fe, id, "hello world")
+
+		assert.NotContains(t, readTest(t, mem, id), "hello world")
+		assert.Equal(t, "hello world", readTest(t, fe, id))
+	})
+	t.Run("random access", func(t *testing.T) {
+		fe := newTestFileEncrypted(t, fileMemory.NewFileMemory(), "a", WithChunkSize(4))
+
+		id := uuid.New()
+		writeTest(t, fe, id, "hello world!")
+
+		of, err := fe.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		size, err := of.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		assert.EqualValues(t, 12, size)
+
+		buf := make([]byte, 5)
+		_, err = of.Seek(3, io.SeekStart)
+		require.NoError(t, err)
+		_, err = io.ReadFull(of, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "lo wo", string(buf))
+
+		for offset, expected := range map[uint64]string{
+			0:  "hello world!",
+			4:  "o world!",
+			6:  "world!",
+			11: "!",
+			12: "",
+			20: "",
+		} {
+			r, err := fe.ReadFileFromOffset(id, offset)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, expected, string(data), "offset %d", offset)
+		}
+	})
+	t.Run("writes many frames at once", func(t *testing.T) {
+		fe := newTestFileEncrypted(t, fileMemory.NewFileMemory(), "a", WithChunkSize(4))
+
+		contents := strings.Repeat("0123456789", 100)
+		of, err := fe.OpenTempFile(uuid.New())
+		require.NoError(t, err)
+		_, err = of.Write([]byte(contents[:995]))
+		require.NoError(t, err)
+		_, err = of.Write([]byte(contents[995:]))
+		require.NoError(t, err)
+		id := uuid.New()
+		require.NoError(t, of.Claim(id))
+
+		assert.Equal(t, contents, readTest(t, fe, id))
+	})
+	t.Run("detects changes", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		fe := newTestFileEncrypted(t, mem, "a", WithChunkSize(4))
+
+		id := uuid.New()
+		writeTest(t, fe, id, "hello world!")
+		sealed := readTest(t, mem, id)
+
+		// Cut off the last frame
+		writeTest(t, mem, id, sealed[:len(sealed)-4-16])
+		_, err := ioutil.ReadAll(mustOpen(t, fe, id))
+		assert.Error(t, err)
+		r, err := fe.ReadFile(id)
+		require.NoError(t, err)
+		_, err = ioutil.ReadAll(r)
+		assert.Error(t, err)
+
+		// Change the contents
+		changed := []byte(sealed)
+		changed[len(changed)-1] ^= 1
+		writeTest(t, mem, id, string(changed))
+		_, err = ioutil.ReadAll(mustOpen(t, fe, id))
+		assert.Error(t, err)
+	})
+	t.Run("rotates keys", func(t *testing.T) {
+		mem := fileMemory.NewFileMemory()
+		idPlain := uuid.New()
+		idA := uuid.New()
+		writeTest(t, mem, idPlain, "kept before encryption")
+		writeTest(t, newTestFileEncrypted(t, mem, "a"), idA, "hello world")
+
+		fe := newTestFileEncrypted(t, mem, "b")
+		assert.Equal(t, "kept before encryption", readTest(t, fe, idPlain))
+		assert.Equal(t, "hello world", readTest(t, fe, idA))
+
+		keyID, err := fe.BlobKeyID(idPlain)
+		require.NoError(t, err)
+		assert.Equal(t, "", keyID)
+		keyID, err = fe.BlobKeyID(idA)
+		require.NoError(t, err)
+		assert.Equal(t, "a", keyID)
+
+		require.NoError(t, fe.Reencrypt(idPlain))
+		require.NoError(t, fe.Reencrypt(idA))
+
+		for id, expected := range map[uuid.UUID]string{
+			idPlain: "kept before encryption",
+			idA:     "hello world",
+		} {
+			keyID, err := fe.BlobKeyID(id)
+			require.NoError(t, err)
+			assert.Equal(t, "b", keyID)
+			assert.Equal(t, expected, readTest(t, fe, id))
+		}
+
+		onlyA, err := NewFileEncrypted(mem, map[string][]byte{"a": testKeyA}, "a")
+		require.NoError(t, err)
+		_, err = onlyA.OpenFile(idA)
+		assert.Error(t, err)
+
+		temps, err := fe.ListTempFiles()
+		require.NoError(t, err)
+		assert.Len(t, temps, 0)
+	})
+}
+
+func mustOpen(t *testing.T, f storage.File, id uuid.UUID) storage.OpenFile {
+	of, err := f.OpenFile(id)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		of.Close()
+	})
+
+	return of
+}
@@ -0,0 +1,301 @@
+package encrypted
+
+import (
+	"crypto/cipher"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type openEncryptedFile struct {
+	fileEncrypted *FileEncrypted
+
+	mode   records.FileMode
+	file   storage.OpenFile
+	h      *header
+	aead   cipher.AEAD
+	offset int64
+
+	// frames is the number of frames in a file opened for reading, and
+	// size is the size of its decrypted contents. frame is the index of
+	// the decrypted frame in plain, or -1 if nothing is decrypted yet.
+	frames int64
+	size   int64
+	frame  int64
+	plain  []byte
+
+	// lock guards the contents of a file opened for writing, which are
+	// also read when listing temp files. sealed is the size of the
+	// contents that have been sealed, buf holds what's been written after
+	// them. A frame is only sealed once it's been written past, so there's
+	// always something left in buf to seal as the last frame.
+	lock     sync.Mutex
+	handleID uuid.UUID
+	sealed   int64
+	buf      []byte
+}
+
+func newOpenEncryptedReadFile(
+	fe *FileEncrypted,
+	file storage.OpenFile,
+	h *header,
+) (*openEncryptedFile, error) {
+	aead, err := fe.aead(h.keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSize, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	frames, size, err := h.plainSize(aead, fileSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &openEncryptedFile{
+		fileEncrypted: fe,
+
+		mode: records.FILE_MODE_READ,
+		file: file,
+		h:    h,
+		aead: aead,
+
+		frames: frames,
+		size:   size,
+		frame:  -1,
+	}, nil
+}
+
+func newOpenEncryptedWriteFile(
+	fe *FileEncrypted,
+	file storage.OpenFile,
+	h *header,
+	handleID uuid.UUID,
+) *openEncryptedFile {
+	return &openEncryptedFile{
+		fileEncrypted: fe,
+
+		mode: records.FILE_MODE_WRITE,
+		file: file,
+		h:    h,
+		aead: fe.aeads[h.keyID],
+
+		frame:    -1,
+		handleID: handleID,
+	}
+}
+
+func (oef *openEncryptedFile) Seek(offset int64, whence int) (int64, error) {
+	oef.lock.Lock()
+	defer oef.lock.Unlock()
+
+	size := oef.size
+	if oef.mode == records.FILE_MODE_WRITE {
+		size = oef.sealed + int64(len(oef.buf))
+	}
+
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = oef.offset + offset
+	case io.SeekEnd:
+		newOffset = size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if newOffset < 0 {
+		return 0, fmt.Errorf("negative offset")
+	}
+	if oef.mode == records.FILE_MODE_WRITE && newOffset < oef.sealed {
+		return 0, fmt.Errorf("can't seek to data that's already been encrypted")
+	}
+
+	oef.offset = newOffset
+
+	return newOffset, nil
+}
+
+func (oef *openEncryptedFile) Read(b []byte) (int, error) {
+	if oef.mode != records.FILE_MODE_READ {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+
+	if oef.offset >= oef.size {
+		return 0, io.EOF
+	}
+
+	chunkSize := int64(oef.h.chunkSize)
+	index := oef.offset / chunkSize
+	if index != oef.frame {
+		err := oef.readFrame(index)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, oef.plain[oef.offset-index*chunkSize:])
+	oef.offset += int64(n)
+
+	return n, nil
+}
+
+// readFrame reads and decrypts the frame with the index.
+func (oef *openEncryptedFile) readFrame(index int64) error {
+	last := index == oef.frames-1
+
+	sealedSize := oef.h.frameSize(oef.aead)
+	if last {
+		sealedSize = oef.size - index*int64(oef.h.chunkSize) + int64(oef.aead.Overhead())
+	}
+
+	_, err := oef.file.Seek(oef.h.frameOffset(oef.aead, index), io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	sealed := make([]byte, sealedSize)
+	_, err = io.ReadFull(oef.file, sealed)
+	if err != nil {
+		return err
+	}
+
+	oef.plain, err = oef.h.open(oef.aead, index, last, sealed)
+	if err != nil {
+		oef.frame = -1
+		return err
+	}
+	oef.frame = index
+
+	return nil
+}
+
+func (oef *openEncryptedFile) Write(b []byte) (int, error) {
+	if oef.mode != records.FILE_MODE_WRITE {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+
+	oef.lock.Lock()
+	defer oef.lock.Unlock()
+
+	// Writing past the end of the file fills the gap with zeros,
+	// the same as a sparse file on disk.
+	bufOffset := oef.offset - oef.sealed
+	end := bufOffset + int64(len(b))
+	if end > int64(len(oef.buf)) {
+		oef.buf = append(oef.buf, make([]byte, end-int64(len(oef.buf)))...)
+	}
+
+	n := copy(oef.buf[bufOffset:], b)
+	oef.offset += int64(n)
+
+	// Seal full frames once they've been written past, files are almost
+	// always written in order so they won't be written to again. What's
+	// left is moved to the start of the buffer once they're all sealed.
+	chunkSize := int(oef.h.chunkSize)
+	start := 0
+	var err error
+	for len(oef.buf)-start > chunkSize && oef.offset-oef.sealed >= int64(chunkSize) {
+		err = oef.writeFrame(false, oef.buf[start:start+chunkSize])
+		if err != nil {
+			break
+		}
+
+		start += chunkSize
+	}
+	if start > 0 {
+		oef.buf = oef.buf[:copy(oef.buf, oef.buf[start:])]
+	}
+
+	return n, err
+}
+
+// writeFrame seals the next frame and writes it to the file. The caller
+// must hold the lock.
+func (oef *openEncryptedFile) writeFrame(last bool, plain []byte) error {
+	index := oef.sealed / int64(oef.h.chunkSize)
+	if index > math.MaxUint32 {
+		return fmt.Errorf("file is too large to encrypt")
+	}
+
+	_, err := oef.file.Write(oef.h.seal(oef.aead, index, last, plain))
+	if err != nil {
+		return err
+	}
+	oef.sealed += int64(len(plain))
+
+	return nil
+}
+
+// Flush flushes the frames that have been sealed, the rest are sealed
+// when the file is claimed.
+func (oef *openEncryptedFile) Flush() error {
+	return oef.file.Flush()
+}
+
+func (oef *openEncryptedFile) Close() error {
+	oef.plain = nil
+	return oef.file.Close()
+}
+
+func (oef *openEncryptedFile) Claim(id uuid.UUID) error {
+	if oef.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	oef.lock.Lock()
+	defer oef.lock.Unlock()
+
+	oef.fileEncrypted.removeTemp(oef.handleID)
+
+	chunkSize := int(oef.h.chunkSize)
+	for len(oef.buf) > chunkSize {
+		err := oef.writeFrame(false, oef.buf[:chunkSize])
+		if err != nil {
+			return err
+		}
+		oef.buf = oef.buf[chunkSize:]
+	}
+
+	err := oef.writeFrame(true, oef.buf)
+	if err != nil {
+		return err
+	}
+	oef.buf = nil
+
+	return oef.file.Claim(id)
+}
+
+func (oef *openEncryptedFile) Drop() error {
+	if oef.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	oef.lock.Lock()
+	defer oef.lock.Unlock()
+
+	oef.fileEncrypted.removeTemp(oef.handleID)
+	oef.buf = nil
+
+	return oef.file.Drop()
+}
+
+// writtenSize is the size of what's been written to a file opened for
+// writing.
+func (oef *openEncryptedFile) writtenSize() uint64 {
+	oef.lock.Lock()
+	defer oef.lock.Unlock()
+
+	return uint64(oef.sealed) + uint64(len(oef.buf))
+}
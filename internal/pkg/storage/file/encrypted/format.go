@@ -0,0 +1,150 @@
+package encrypted
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Encrypted files start with a header naming the key they're encrypted
+// with, followed by the contents split into frames of chunkSize bytes
+// that are each sealed with AES-GCM, so any part of a file can be read
+// without decrypting the rest of it:
+//
+//	magic        [8]byte
+//	chunk size   uint32
+//	nonce prefix [8]byte
+//	key ID len   uint8
+//	key ID       [key ID len]byte
+//	frames       ([chunk size]byte sealed, the last one can be shorter)
+//
+// The nonce of each frame is the file's random nonce prefix followed by
+// the frame's index. Every frame is authenticated along with the header,
+// its index and whether it's the last frame, so frames can't be changed,
+// reordered, or cut off the end without it being noticed.
+const (
+	magic = "\x00scenc1\x00"
+
+	noncePrefixSize = 8
+	// headerSize is the size of the header without the key ID.
+	headerSize = len(magic) + 4 + noncePrefixSize + 1
+
+	maxKeyIDSize = 255
+)
+
+type header struct {
+	chunkSize   uint32
+	noncePrefix [noncePrefixSize]byte
+	keyID       string
+}
+
+func (h *header) size() int64 {
+	return int64(headerSize + len(h.keyID))
+}
+
+func (h *header) marshal() []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString(magic)
+	binary.Write(buf, binary.BigEndian, h.chunkSize)
+	buf.Write(h.noncePrefix[:])
+	buf.WriteByte(byte(len(h.keyID)))
+	buf.WriteString(h.keyID)
+
+	return buf.Bytes()
+}
+
+// readHeader reads the header from the start of a file, returning false
+// if the file isn't encrypted.
+func readHeader(r io.Reader) (*header, bool, error) {
+	buf := make([]byte, headerSize)
+	_, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	if string(buf[:len(magic)]) != magic {
+		return nil, false, nil
+	}
+	buf = buf[len(magic):]
+
+	h := &header{
+		chunkSize: binary.BigEndian.Uint32(buf[0:4]),
+	}
+	copy(h.noncePrefix[:], buf[4:4+noncePrefixSize])
+	if h.chunkSize == 0 {
+		return nil, false, fmt.Errorf("invalid chunk size")
+	}
+
+	keyID := make([]byte, buf[4+noncePrefixSize])
+	_, err = io.ReadFull(r, keyID)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not read key ID: %s", err)
+	}
+	h.keyID = string(keyID)
+
+	return h, true, nil
+}
+
+// frameSize is the size of a sealed frame that isn't the last one.
+func (h *header) frameSize(aead cipher.AEAD) int64 {
+	return int64(h.chunkSize) + int64(aead.Overhead())
+}
+
+// frameOffset is where a frame starts in the file.
+func (h *header) frameOffset(aead cipher.AEAD, index int64) int64 {
+	return h.size() + index*h.frameSize(aead)
+}
+
+func (h *header) nonce(aead cipher.AEAD, index int64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, h.noncePrefix[:])
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], uint32(index))
+
+	return nonce
+}
+
+func (h *header) additionalData(index int64, last bool) []byte {
+	ad := h.marshal()
+	ad = append(ad, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(ad[len(ad)-5:], uint32(index))
+	if last {
+		ad[len(ad)-1] = 1
+	}
+
+	return ad
+}
+
+func (h *header) seal(aead cipher.AEAD, index int64, last bool, plain []byte) []byte {
+	return aead.Seal(nil, h.nonce(aead, index), plain, h.additionalData(index, last))
+}
+
+func (h *header) open(aead cipher.AEAD, index int64, last bool, sealed []byte) ([]byte, error) {
+	plain, err := aead.Open(nil, h.nonce(aead, index), sealed, h.additionalData(index, last))
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt frame %d: %s", index, err)
+	}
+
+	return plain, nil
+}
+
+// plainSize returns the number of frames and the size of the decrypted
+// contents of a file with the size.
+func (h *header) plainSize(aead cipher.AEAD, fileSize int64) (int64, int64, error) {
+	framesSize := fileSize - h.size()
+	overhead := int64(aead.Overhead())
+	if framesSize < overhead {
+		return 0, 0, fmt.Errorf("encrypted file is truncated")
+	}
+
+	frames := (framesSize + h.frameSize(aead) - 1) / h.frameSize(aead)
+	lastSize := framesSize - (frames-1)*h.frameSize(aead)
+	if lastSize < overhead {
+		return 0, 0, fmt.Errorf("encrypted file is truncated")
+	}
+
+	return frames, (frames-1)*int64(h.chunkSize) + lastSize - overhead, nil
+}
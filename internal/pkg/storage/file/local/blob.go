@@ -0,0 +1,155 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+const tempDir = "tmp"
+
+// parseIDName returns the ID in a file name like <id><ext>.
+func parseIDName(name string, ext string) (uuid.UUID, bool) {
+	if !strings.HasSuffix(name, ext) {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(strings.TrimSuffix(name, ext))
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// readDirIfExists reads a directory, treating a missing directory as an
+// empty one.
+func readDirIfExists(dir string) ([]os.FileInfo, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return infos, err
+}
+
+func removeIfExists(filePath string) error {
+	err := os.Remove(filePath)
+	if os.IsNotExist(err) {
+		return scerrors.ErrNotFound
+	}
+
+	return err
+}
+
+func (fl *FileLocal) ListBlobs() ([]uuid.UUID, error) {
+	if fl.layout == LayoutContent {
+		return listIDFiles(path.Join(fl.basePath, refsDir), refExt)
+	}
+
+	return listIDFiles(fl.basePath, datExt)
+}
+
+// listIDFiles lists the IDs of files named <id><ext> under root. They're
+// stored two directories deep using the first two characters of their
+// ID, so only those directories need to be read.
+func listIDFiles(root string, ext string) ([]uuid.UUID, error) {
+	res := []uuid.UUID{}
+
+	outerInfos, err := readDirIfExists(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, outerInfo := range outerInfos {
+		if !outerInfo.IsDir() || len(outerInfo.Name()) != 1 {
+			continue
+		}
+		outerPath := path.Join(root, outerInfo.Name())
+
+		innerInfos, err := readDirIfExists(outerPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, innerInfo := range innerInfos {
+			if !innerInfo.IsDir() || len(innerInfo.Name()) != 1 {
+				continue
+			}
+
+			blobInfos, err := readDirIfExists(path.Join(outerPath, innerInfo.Name()))
+			if err != nil {
+				return nil, err
+			}
+			for _, blobInfo := range blobInfos {
+				if blobInfo.IsDir() {
+					continue
+				}
+
+				id, ok := parseIDName(blobInfo.Name(), ext)
+				if !ok {
+					continue
+				}
+				res = append(res, id)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (fl *FileLocal) RemoveBlob(id uuid.UUID) error {
+	if fl.layout == LayoutContent {
+		fl.logger.Debug("removing %s", id)
+		return fl.removeContent(id)
+	}
+
+	filePath := fl.idPath(id)
+
+	fl.logger.Debug("removing %s at %s", id, filePath)
+
+	return removeIfExists(filePath)
+}
+
+func (fl *FileLocal) ListTempFiles() ([]*records.TempFile, error) {
+	infos, err := readDirIfExists(path.Join(fl.basePath, tempDir))
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*records.TempFile{}
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+
+		id, ok := parseIDName(info.Name(), datExt)
+		if !ok {
+			continue
+		}
+
+		res = append(res, &records.TempFile{
+			ID:       id,
+			Size:     uint64(info.Size()),
+			Modified: info.ModTime(),
+		})
+	}
+
+	return res, nil
+}
+
+func (fl *FileLocal) RemoveTempFile(handleID uuid.UUID) error {
+	filePath := path.Join(
+		fl.basePath,
+		tempDir,
+		handleID.String()+datExt,
+	)
+
+	fl.logger.Debug("removing temp handle %s at %s", handleID, filePath)
+
+	return removeIfExists(filePath)
+}
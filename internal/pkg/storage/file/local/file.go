@@ -65,12 +65,17 @@ func (olf *openLocalFile) Claim(id uuid.UUID) error {
 		return err
 	}
 
-	claimPath := path.Join(
-		olf.fileLocal.basePath,
-		id.String()[0:1],
-		id.String()[1:2],
-		id.String()+".dat",
-	)
+	fl := olf.fileLocal
+	if fl.layout == LayoutContent {
+		info, err := describeFile(olf.f.Name())
+		if err != nil {
+			return err
+		}
+
+		return fl.addContent(olf.f.Name(), info, id)
+	}
+
+	claimPath := fl.idPath(id)
 
 	claimDir := path.Dir(claimPath)
 
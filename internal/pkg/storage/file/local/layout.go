@@ -0,0 +1,421 @@
+package local
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/extract"
+)
+
+// Layout is how claimed files are arranged under the base path.
+type Layout string
+
+const (
+	// LayoutID keeps each claimed file at <id[0]>/<id[1]>/<id>.dat using
+	// the ID it was claimed with, so only the metadata storage knows the
+	// hash of its contents.
+	LayoutID Layout = "id"
+	// LayoutContent keeps each claimed file under the SHA-256 of its
+	// contents at <hash[0:2]>/<hash[2:4]>/<hash>.blob, next to a
+	// <hash>.json sidecar describing it, so the files can be read and
+	// verified without the metadata storage. Claimed IDs refer to the
+	// contents with refs/<id[0]>/<id[1]>/<id>.ref files holding the hash.
+	LayoutContent Layout = "content"
+)
+
+const (
+	// layoutFile records the layout of the files in the base path. Files
+	// from before layouts could be chosen don't have one and use LayoutID.
+	layoutFile = "layout"
+	refsDir    = "refs"
+
+	datExt     = ".dat"
+	refExt     = ".ref"
+	blobExt    = ".blob"
+	sidecarExt = ".json"
+)
+
+func ParseLayout(name string) (Layout, error) {
+	switch Layout(name) {
+	case LayoutID, LayoutContent:
+		return Layout(name), nil
+	default:
+		return "", fmt.Errorf("unknown layout '%s'", name)
+	}
+}
+
+// readLayout returns the layout recorded in basePath, if there is one.
+func readLayout(basePath string) (Layout, bool, error) {
+	data, err := ioutil.ReadFile(path.Join(basePath, layoutFile))
+	if os.IsNotExist(err) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	layout, err := ParseLayout(strings.TrimSpace(string(data)))
+	if err != nil {
+		return "", false, err
+	}
+
+	return layout, true, nil
+}
+
+func writeLayout(basePath string, layout Layout) error {
+	return writeFileAtomic(path.Join(basePath, layoutFile), []byte(string(layout)+"\n"))
+}
+
+// writeFileAtomic writes a file by renaming a complete temporary file over
+// it, so it's never left half written.
+func writeFileAtomic(filePath string, data []byte) error {
+	err := ensureDirectory(path.Dir(filePath))
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(path.Dir(filePath), ".softcopy-")
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	err = os.Rename(f.Name(), filePath)
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return nil
+}
+
+// checkLayout makes sure the files in the base path use the layout the
+// engine was created with, so files aren't missed by looking for them in
+// the wrong place.
+func (fl *FileLocal) checkLayout() error {
+	stored, ok, err := readLayout(fl.basePath)
+	if err != nil {
+		return fmt.Errorf("could not read layout: %s", err)
+	}
+
+	if !ok {
+		if fl.layout == LayoutID {
+			return nil
+		}
+
+		ids, err := listIDFiles(fl.basePath, datExt)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return writeLayout(fl.basePath, fl.layout)
+		}
+		stored = LayoutID
+	}
+
+	if stored != fl.layout {
+		return fmt.Errorf(
+			"files in %s use the %s layout instead of %s, migrate them first",
+			fl.basePath, stored, fl.layout,
+		)
+	}
+
+	return nil
+}
+
+func (fl *FileLocal) idPath(id uuid.UUID) string {
+	return path.Join(
+		fl.basePath,
+		id.String()[0:1],
+		id.String()[1:2],
+		id.String()+datExt,
+	)
+}
+
+func (fl *FileLocal) refPath(id uuid.UUID) string {
+	return path.Join(
+		fl.basePath,
+		refsDir,
+		id.String()[0:1],
+		id.String()[1:2],
+		id.String()+refExt,
+	)
+}
+
+func (fl *FileLocal) contentPath(hash string, ext string) string {
+	return path.Join(
+		fl.basePath,
+		hash[0:2],
+		hash[2:4],
+		hash+ext,
+	)
+}
+
+// blobPath returns where the contents of a claimed file are kept.
+func (fl *FileLocal) blobPath(id uuid.UUID) (string, error) {
+	if fl.layout != LayoutContent {
+		return fl.idPath(id), nil
+	}
+
+	hash, err := fl.readRef(id)
+	if err != nil {
+		return "", err
+	}
+
+	return fl.contentPath(hash, blobExt), nil
+}
+
+func isHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+// readRef returns the hash of the contents a claimed ID refers to.
+func (fl *FileLocal) readRef(id uuid.UUID) (string, error) {
+	data, err := ioutil.ReadFile(fl.refPath(id))
+	if os.IsNotExist(err) {
+		return "", scerrors.ErrNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	hash := strings.TrimSpace(string(data))
+	if !isHash(hash) {
+		return "", fmt.Errorf("invalid ref for %s", id)
+	}
+
+	return hash, nil
+}
+
+// BlobInfo is the sidecar kept next to contents in the content layout.
+type BlobInfo struct {
+	SHA256   string `json:"sha256"`
+	Size     uint64 `json:"size"`
+	MimeType string `json:"mime_type"`
+	// IDs are the claimed IDs that refer to the contents.
+	IDs []uuid.UUID `json:"ids"`
+}
+
+// describeFile hashes a file and detects its type.
+func describeFile(filePath string) (*BlobInfo, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobInfo{
+		SHA256:   fmt.Sprintf("%x", h.Sum(nil)),
+		Size:     uint64(size),
+		MimeType: extract.DetectType("", f, size),
+	}, nil
+}
+
+// ReadBlobInfo returns the sidecar of the contents with the hash, which
+// is only kept in the content layout.
+func (fl *FileLocal) ReadBlobInfo(hash string) (*BlobInfo, error) {
+	if !isHash(hash) {
+		return nil, fmt.Errorf("invalid hash '%s'", hash)
+	}
+
+	data, err := ioutil.ReadFile(fl.contentPath(hash, sidecarExt))
+	if os.IsNotExist(err) {
+		return nil, scerrors.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	info := &BlobInfo{}
+	err = json.Unmarshal(data, info)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sidecar of %s: %s", hash, err)
+	}
+
+	return info, nil
+}
+
+func (fl *FileLocal) writeBlobInfo(info *BlobInfo) error {
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(fl.contentPath(info.SHA256, sidecarExt), append(data, '\n'))
+}
+
+// linkOrCopy hard links src to dst, copying it if it can't be linked.
+func linkOrCopy(src string, dst string) error {
+	err := ensureDirectory(path.Dir(dst))
+	if err != nil {
+		return err
+	}
+
+	err = os.Link(src, dst)
+	if err == nil || os.IsExist(err) {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := ioutil.TempFile(path.Dir(dst), ".softcopy-")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return err
+	}
+	err = out.Close()
+	if err != nil {
+		os.Remove(out.Name())
+		return err
+	}
+
+	return os.Rename(out.Name(), dst)
+}
+
+// addContent keeps the file at srcPath under its hash, unless the same
+// contents are already kept, and refers to it from the ID. The file at
+// srcPath is only removed once everything else is in place, so adding
+// it again after a failure finishes the job.
+func (fl *FileLocal) addContent(srcPath string, info *BlobInfo, id uuid.UUID) error {
+	fl.lock.Lock()
+	defer fl.lock.Unlock()
+
+	// Claiming an ID again replaces its contents
+	oldHash, err := fl.readRef(id)
+	if err != nil && err != scerrors.ErrNotFound {
+		return err
+	}
+
+	stored, err := fl.ReadBlobInfo(info.SHA256)
+	if err == scerrors.ErrNotFound {
+		err = linkOrCopy(srcPath, fl.contentPath(info.SHA256, blobExt))
+		if err != nil {
+			return err
+		}
+		stored = info
+	} else if err != nil {
+		return err
+	}
+
+	found := false
+	for _, storedID := range stored.IDs {
+		if storedID == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		stored.IDs = append(stored.IDs, id)
+	}
+
+	err = fl.writeBlobInfo(stored)
+	if err != nil {
+		return err
+	}
+
+	err = writeFileAtomic(fl.refPath(id), []byte(info.SHA256+"\n"))
+	if err != nil {
+		return err
+	}
+
+	if oldHash != "" && oldHash != info.SHA256 {
+		err = fl.unrefContent(oldHash, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.Remove(srcPath)
+}
+
+// removeContent removes the ref from the ID, and the contents it refers
+// to if nothing else refers to them. The ref is removed last so removing
+// it again after a failure finishes the job.
+func (fl *FileLocal) removeContent(id uuid.UUID) error {
+	fl.lock.Lock()
+	defer fl.lock.Unlock()
+
+	hash, err := fl.readRef(id)
+	if err != nil {
+		return err
+	}
+
+	err = fl.unrefContent(hash, id)
+	if err != nil {
+		return err
+	}
+
+	return removeIfExists(fl.refPath(id))
+}
+
+// unrefContent removes the ID from the contents with the hash, removing
+// the contents if nothing else refers to them. The caller must hold the
+// lock.
+func (fl *FileLocal) unrefContent(hash string, id uuid.UUID) error {
+	info, err := fl.ReadBlobInfo(hash)
+	if err != nil && err != scerrors.ErrNotFound {
+		return err
+	}
+
+	ids := []uuid.UUID{}
+	if info != nil {
+		for _, storedID := range info.IDs {
+			if storedID != id {
+				ids = append(ids, storedID)
+			}
+		}
+	}
+
+	if len(ids) > 0 {
+		info.IDs = ids
+		return fl.writeBlobInfo(info)
+	}
+
+	for _, ext := range []string{blobExt, sidecarExt} {
+		err = removeIfExists(fl.contentPath(hash, ext))
+		if err != nil && err != scerrors.ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+)
+
+// helloHash is the SHA-256 of "hello world".
+const helloHash = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+
+func claimTest(t *testing.T, fl *FileLocal, id uuid.UUID, data string) {
+	of, err := fl.OpenTempFile(uuid.New())
+	require.NoError(t, err)
+	_, err = of.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, of.Claim(id))
+}
+
+func readTest(t *testing.T, fl *FileLocal, id uuid.UUID) string {
+	of, err := fl.OpenFile(id)
+	require.NoError(t, err)
+	defer of.Close()
+
+	data, err := ioutil.ReadAll(of)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestContentLayout(t *testing.T) {
+	t.Run("keeps contents under their hash", func(t *testing.T) {
+		basePath := newTestBasePath(t)
+		fl, err := NewFileLocal(basePath, WithLayout(LayoutContent))
+		require.NoError(t, err)
+
+		id := uuid.New()
+		claimTest(t, fl, id, "hello world")
+
+		data, err := ioutil.ReadFile(path.Join(basePath, "b9", "4d", helloHash+".blob"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+
+		info, err := fl.ReadBlobInfo(helloHash)
+		require.NoError(t, err)
+		assert.Equal(t, &BlobInfo{
+			SHA256:   helloHash,
+			Size:     11,
+			MimeType: "text/plain",
+			IDs:      []uuid.UUID{id},
+		}, info)
+
+		layout, ok, err := readLayout(basePath)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, LayoutContent, layout)
+	})
+	t.Run("shares contents", func(t *testing.T) {
+		fl, err := NewFileLocal(newTestBasePath(t), WithLayout(LayoutContent))
+		require.NoError(t, err)
+
+		idA := uuid.New()
+		idB := uuid.New()
+		claimTest(t, fl, idA, "hello world")
+		claimTest(t, fl, idB, "hello world")
+
+		info, err := fl.ReadBlobInfo(helloHash)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{idA, idB}, info.IDs)
+
+		require.NoError(t, fl.RemoveBlob(idA))
+		assert.Equal(t, "hello world", readTest(t, fl, idB))
+
+		require.NoError(t, fl.RemoveBlob(idB))
+		_, err = fl.ReadBlobInfo(helloHash)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = os.Stat(fl.contentPath(helloHash, blobExt))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("refuses other layouts", func(t *testing.T) {
+		basePath := newTestBasePath(t)
+		fl, err := NewFileLocal(basePath)
+		require.NoError(t, err)
+		claimTest(t, fl, uuid.New(), "hello world")
+
+		_, err = NewFileLocal(basePath, WithLayout(LayoutContent))
+		assert.Error(t, err)
+
+		basePath = newTestBasePath(t)
+		_, err = NewFileLocal(basePath, WithLayout(LayoutContent))
+		require.NoError(t, err)
+
+		_, err = NewFileLocal(basePath)
+		assert.Error(t, err)
+	})
+}
+
+func TestMigrateLayout(t *testing.T) {
+	basePath := newTestBasePath(t)
+	fl, err := NewFileLocal(basePath)
+	require.NoError(t, err)
+
+	idA := uuid.New()
+	idB := uuid.New()
+	claimTest(t, fl, idA, "hello world")
+	claimTest(t, fl, idB, "goodbye")
+
+	ids, err := MigrateLayout(basePath, LayoutContent, true, logging.NewNilLogger())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{idA, idB}, ids)
+	_, err = NewFileLocal(basePath, WithLayout(LayoutContent))
+	assert.Error(t, err)
+
+	ids, err = MigrateLayout(basePath, LayoutContent, false, logging.NewNilLogger())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{idA, idB}, ids)
+
+	fl, err = NewFileLocal(basePath, WithLayout(LayoutContent))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", readTest(t, fl, idA))
+	assert.Equal(t, "goodbye", readTest(t, fl, idB))
+	_, err = os.Stat(fl.idPath(idA))
+	assert.True(t, os.IsNotExist(err))
+
+	ids, err = MigrateLayout(basePath, LayoutID, false, logging.NewNilLogger())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{idA, idB}, ids)
+
+	fl, err = NewFileLocal(basePath)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", readTest(t, fl, idA))
+	assert.Equal(t, "goodbye", readTest(t, fl, idB))
+	_, err = fl.ReadBlobInfo(helloHash)
+	assert.Equal(t, scerrors.ErrNotFound, err)
+}
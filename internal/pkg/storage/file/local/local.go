@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"sync"
 
 	"github.com/google/uuid"
 
@@ -39,10 +40,22 @@ func WithLogger(logger logging.Logger) FileLocalOption {
 	}
 }
 
+// WithLayout sets how claimed files are arranged, LayoutID by default.
+func WithLayout(layout Layout) FileLocalOption {
+	return func(fl *FileLocal) {
+		fl.layout = layout
+	}
+}
+
 type FileLocal struct {
 	logger logging.Logger
 
 	basePath string
+	layout   Layout
+
+	// lock guards the sidecars and refs of the content layout, which are
+	// shared by every file claimed with the same contents.
+	lock sync.Mutex
 }
 
 func NewFileLocal(basePath string, opts ...FileLocalOption) (*FileLocal, error) {
@@ -64,23 +77,29 @@ func NewFileLocal(basePath string, opts ...FileLocalOption) (*FileLocal, error)
 	}
 
 	fl := &FileLocal{
+		logger: logging.NewNilLogger(),
+
 		basePath: basePath,
+		layout:   LayoutID,
 	}
 
 	for _, opt := range opts {
 		opt(fl)
 	}
 
+	err = fl.checkLayout()
+	if err != nil {
+		return nil, err
+	}
+
 	return fl, nil
 }
 
 func (fl *FileLocal) OpenFile(id uuid.UUID) (storage.OpenFile, error) {
-	filePath := path.Join(
-		fl.basePath,
-		id.String()[0:1],
-		id.String()[1:2],
-		id.String()+".dat",
-	)
+	filePath, err := fl.blobPath(id)
+	if err != nil {
+		return nil, err
+	}
 
 	fl.logger.Debug("opening %s for read at %s", id, filePath)
 
@@ -97,8 +116,8 @@ func (fl *FileLocal) OpenFile(id uuid.UUID) (storage.OpenFile, error) {
 func (fl *FileLocal) OpenTempFile(handleID uuid.UUID) (storage.OpenFile, error) {
 	filePath := path.Join(
 		fl.basePath,
-		"tmp",
-		handleID.String()+".dat",
+		tempDir,
+		handleID.String()+datExt,
 	)
 
 	fl.logger.Debug("opening temp handle %s at %s", handleID, filePath)
@@ -119,22 +138,18 @@ func (fl *FileLocal) OpenTempFile(handleID uuid.UUID) (storage.OpenFile, error)
 	return of, nil
 }
 
-func (fl *FileLocal) ReadFile(filePath string) (io.ReadCloser, error) {
-	readPath := path.Join(fl.basePath, filePath)
-
-	f, err := os.Open(readPath)
-	if err != nil {
-		return nil, err
-	}
-
-	return f, nil
+func (fl *FileLocal) ReadFile(id uuid.UUID) (io.ReadCloser, error) {
+	return fl.ReadFileFromOffset(id, 0)
 }
 
 func (fl *FileLocal) ReadFileFromOffset(
-	filePath string,
+	id uuid.UUID,
 	offset uint64,
 ) (io.ReadCloser, error) {
-	readPath := path.Join(fl.basePath, filePath)
+	readPath, err := fl.blobPath(id)
+	if err != nil {
+		return nil, err
+	}
 
 	f, err := os.OpenFile(readPath, os.O_RDONLY, 0644)
 	if err != nil {
@@ -0,0 +1,40 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+func newTestBasePath(t *testing.T) string {
+	basePath, err := ioutil.TempDir("", "softcopy-local-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(basePath)
+	})
+
+	return basePath
+}
+
+func TestFileLocal(t *testing.T) {
+	storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+		fl, err := NewFileLocal(newTestBasePath(t))
+		require.NoError(t, err)
+
+		return fl
+	})
+}
+
+func TestFileLocalContentLayout(t *testing.T) {
+	storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+		fl, err := NewFileLocal(newTestBasePath(t), WithLayout(LayoutContent))
+		require.NoError(t, err)
+
+		return fl
+	})
+}
@@ -0,0 +1,103 @@
+package local
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/google/uuid"
+
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+)
+
+// MigrateLayout rearranges the claimed files in basePath into the layout,
+// returning the IDs of the files that were moved, or would be moved if
+// dryRun is set. Nothing else can use the files while they're migrated.
+// Every file is only removed from the old layout once it's in the new
+// one, so a migration that fails can be run again to finish it.
+func MigrateLayout(
+	basePath string,
+	layout Layout,
+	dryRun bool,
+	logger logging.Logger,
+) ([]uuid.UUID, error) {
+	fi, err := os.Stat(basePath)
+	if err != nil {
+		return nil, err
+	} else if !fi.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", basePath)
+	}
+
+	fl := &FileLocal{
+		logger: logger,
+
+		basePath: basePath,
+		layout:   layout,
+	}
+
+	var ids []uuid.UUID
+	var migrate func(uuid.UUID) error
+	switch layout {
+	case LayoutContent:
+		ids, err = listIDFiles(basePath, datExt)
+		migrate = fl.migrateToContent
+	case LayoutID:
+		ids, err = listIDFiles(path.Join(basePath, refsDir), refExt)
+		migrate = fl.migrateToID
+	default:
+		return nil, fmt.Errorf("unknown layout '%s'", layout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return ids, nil
+	}
+
+	for _, id := range ids {
+		err = migrate(id)
+		if err != nil {
+			return nil, fmt.Errorf("could not migrate %s: %s", id, err)
+		}
+	}
+
+	err = writeLayout(basePath, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (fl *FileLocal) migrateToContent(id uuid.UUID) error {
+	idPath := fl.idPath(id)
+
+	info, err := describeFile(idPath)
+	if err != nil {
+		return err
+	}
+
+	fl.logger.Debug("moving %s from %s to %s", id, idPath, fl.contentPath(info.SHA256, blobExt))
+
+	return fl.addContent(idPath, info, id)
+}
+
+func (fl *FileLocal) migrateToID(id uuid.UUID) error {
+	hash, err := fl.readRef(id)
+	if err != nil {
+		return err
+	}
+
+	blobPath := fl.contentPath(hash, blobExt)
+	idPath := fl.idPath(id)
+
+	fl.logger.Debug("moving %s from %s to %s", id, blobPath, idPath)
+
+	err = linkOrCopy(blobPath, idPath)
+	if err != nil {
+		return err
+	}
+
+	return fl.removeContent(id)
+}
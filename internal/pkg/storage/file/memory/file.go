@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type openMemoryFile struct {
+	fileMemory *FileMemory
+
+	mode   records.FileMode
+	data   []byte
+	offset int64
+	closed bool
+
+	// tempID and modified track files opened for writing so they can be
+	// listed as temporary files until they're claimed or dropped.
+	tempID   uuid.UUID
+	modified time.Time
+}
+
+func newOpenMemoryFile(data []byte, mode records.FileMode, fm *FileMemory) *openMemoryFile {
+	return &openMemoryFile{
+		fileMemory: fm,
+
+		mode:     mode,
+		data:     data,
+		modified: time.Now(),
+	}
+}
+
+func (omf *openMemoryFile) Seek(offset int64, whence int) (int64, error) {
+	if omf.closed {
+		return 0, fmt.Errorf("file already closed")
+	}
+
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = omf.offset + offset
+	case io.SeekEnd:
+		newOffset = int64(len(omf.data)) + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if newOffset < 0 {
+		return 0, fmt.Errorf("negative offset")
+	}
+
+	omf.offset = newOffset
+
+	return newOffset, nil
+}
+
+func (omf *openMemoryFile) Read(b []byte) (int, error) {
+	if omf.mode != records.FILE_MODE_READ {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+	if omf.closed {
+		return 0, fmt.Errorf("file already closed")
+	}
+
+	if omf.offset >= int64(len(omf.data)) {
+		return 0, io.EOF
+	}
+
+	n := copy(b, omf.data[omf.offset:])
+	omf.offset += int64(n)
+
+	return n, nil
+}
+
+func (omf *openMemoryFile) Write(b []byte) (int, error) {
+	if omf.mode != records.FILE_MODE_WRITE {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+	if omf.closed {
+		return 0, fmt.Errorf("file already closed")
+	}
+
+	// Writing past the end of the file fills the gap with zeros,
+	// the same as a sparse file on disk.
+	end := omf.offset + int64(len(b))
+	if end > int64(len(omf.data)) {
+		grown := make([]byte, end)
+		copy(grown, omf.data)
+		omf.data = grown
+	}
+
+	n := copy(omf.data[omf.offset:], b)
+	omf.offset += int64(n)
+	omf.modified = time.Now()
+
+	return n, nil
+}
+
+func (omf *openMemoryFile) Flush() error {
+	return nil
+}
+
+func (omf *openMemoryFile) Close() error {
+	omf.closed = true
+	return nil
+}
+
+func (omf *openMemoryFile) Claim(id uuid.UUID) error {
+	if omf.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	err := omf.Close()
+	if err != nil {
+		return err
+	}
+
+	omf.fileMemory.putBlob(blobPath(id), omf.data)
+	omf.fileMemory.removeTemp(omf.tempID)
+	omf.data = nil
+
+	return nil
+}
+
+func (omf *openMemoryFile) Drop() error {
+	if omf.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	err := omf.Close()
+	if err != nil {
+		return err
+	}
+
+	omf.fileMemory.removeTemp(omf.tempID)
+	omf.data = nil
+
+	return nil
+}
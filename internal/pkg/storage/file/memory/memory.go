@@ -0,0 +1,173 @@
+package memory
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func blobPath(id uuid.UUID) string {
+	return path.Join(
+		id.String()[0:1],
+		id.String()[1:2],
+		id.String()+".dat",
+	)
+}
+
+// FileMemory is a storage.File that keeps all file contents in memory
+// using the same path layout as the local file storage. It's intended
+// for tests and ephemeral servers, nothing is persisted when the process
+// exits.
+type FileMemory struct {
+	lock sync.RWMutex
+
+	blobs map[string][]byte
+	temps map[uuid.UUID]*openMemoryFile
+}
+
+var _ storage.File = &FileMemory{}
+
+func NewFileMemory() *FileMemory {
+	return &FileMemory{
+		blobs: map[string][]byte{},
+		temps: map[uuid.UUID]*openMemoryFile{},
+	}
+}
+
+func (fm *FileMemory) getBlob(filePath string) ([]byte, error) {
+	fm.lock.RLock()
+	defer fm.lock.RUnlock()
+
+	data, ok := fm.blobs[path.Clean(filePath)]
+	if !ok {
+		return nil, &os.PathError{
+			Op:   "open",
+			Path: filePath,
+			Err:  os.ErrNotExist,
+		}
+	}
+
+	return data, nil
+}
+
+func (fm *FileMemory) putBlob(filePath string, data []byte) {
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
+
+	fm.blobs[path.Clean(filePath)] = data
+}
+
+func (fm *FileMemory) OpenFile(id uuid.UUID) (storage.OpenFile, error) {
+	data, err := fm.getBlob(blobPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return newOpenMemoryFile(data, records.FILE_MODE_READ, fm), nil
+}
+
+func (fm *FileMemory) OpenTempFile(handleID uuid.UUID) (storage.OpenFile, error) {
+	of := newOpenMemoryFile(nil, records.FILE_MODE_WRITE, fm)
+	of.tempID = handleID
+
+	fm.lock.Lock()
+	fm.temps[handleID] = of
+	fm.lock.Unlock()
+
+	return of, nil
+}
+
+func (fm *FileMemory) removeTemp(handleID uuid.UUID) {
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
+
+	delete(fm.temps, handleID)
+}
+
+func (fm *FileMemory) ReadFile(id uuid.UUID) (io.ReadCloser, error) {
+	return fm.ReadFileFromOffset(id, 0)
+}
+
+func (fm *FileMemory) ReadFileFromOffset(
+	id uuid.UUID,
+	offset uint64,
+) (io.ReadCloser, error) {
+	data, err := fm.getBlob(blobPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(data)
+	_, err = r.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.NopCloser(r), nil
+}
+
+func (fm *FileMemory) ListBlobs() ([]uuid.UUID, error) {
+	fm.lock.RLock()
+	defer fm.lock.RUnlock()
+
+	res := []uuid.UUID{}
+	for filePath := range fm.blobs {
+		id, err := uuid.Parse(strings.TrimSuffix(path.Base(filePath), ".dat"))
+		if err != nil {
+			continue
+		}
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
+func (fm *FileMemory) RemoveBlob(id uuid.UUID) error {
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
+
+	if _, ok := fm.blobs[blobPath(id)]; !ok {
+		return scerrors.ErrNotFound
+	}
+	delete(fm.blobs, blobPath(id))
+
+	return nil
+}
+
+func (fm *FileMemory) ListTempFiles() ([]*records.TempFile, error) {
+	fm.lock.RLock()
+	defer fm.lock.RUnlock()
+
+	res := []*records.TempFile{}
+	for id, of := range fm.temps {
+		res = append(res, &records.TempFile{
+			ID:       id,
+			Size:     uint64(len(of.data)),
+			Modified: of.modified,
+		})
+	}
+
+	return res, nil
+}
+
+func (fm *FileMemory) RemoveTempFile(handleID uuid.UUID) error {
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
+
+	if _, ok := fm.temps[handleID]; !ok {
+		return scerrors.ErrNotFound
+	}
+	delete(fm.temps, handleID)
+
+	return nil
+}
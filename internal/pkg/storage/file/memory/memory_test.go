@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+func TestFileMemory(t *testing.T) {
+	storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+		return NewFileMemory()
+	})
+}
@@ -0,0 +1,184 @@
+package s3
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v6"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// parseDatName returns the ID in a file name like <id>.dat.
+func parseDatName(name string) (uuid.UUID, bool) {
+	if !strings.HasSuffix(name, ".dat") {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(strings.TrimSuffix(name, ".dat"))
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// listPrefix is the prefix to list keys under a directory with.
+func (fs *FileS3) listPrefix(dir string) string {
+	prefix := fs.key(dir)
+	if prefix == "" || prefix == "." {
+		return ""
+	}
+	return prefix + "/"
+}
+
+func (fs *FileS3) ListBlobs() ([]uuid.UUID, error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	prefix := fs.listPrefix("")
+
+	res := []uuid.UUID{}
+	for info := range fs.client.Client.ListObjectsV2(fs.bucket, prefix, true, doneCh) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+
+		// Claimed files are stored under the first two characters of
+		// their ID, anything else in the bucket isn't a blob.
+		parts := strings.Split(strings.TrimPrefix(info.Key, prefix), "/")
+		if len(parts) != 3 || len(parts[0]) != 1 || len(parts[1]) != 1 {
+			continue
+		}
+
+		id, ok := parseDatName(parts[2])
+		if !ok || fs.blobKey(id) != info.Key {
+			continue
+		}
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
+func (fs *FileS3) RemoveBlob(id uuid.UUID) error {
+	key := fs.blobKey(id)
+
+	fs.logger.Debug("removing %s at %s", id, key)
+
+	_, err := fs.client.StatObject(fs.bucket, key, minio.StatObjectOptions{})
+	if isNotFound(err) {
+		return scerrors.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return fs.client.RemoveObject(fs.bucket, key)
+}
+
+// ListTempFiles lists the uploads of files that were opened for writing,
+// along with any uploads that were completed but not copied to their
+// claimed key.
+func (fs *FileS3) ListTempFiles() ([]*records.TempFile, error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	prefix := fs.listPrefix(tempDir)
+
+	fs.lock.RLock()
+	open := map[uuid.UUID]*openS3File{}
+	for id, of := range fs.temps {
+		open[id] = of
+	}
+	fs.lock.RUnlock()
+
+	res := []*records.TempFile{}
+	for upload := range fs.client.ListIncompleteUploads(fs.bucket, prefix, true, doneCh) {
+		if upload.Err != nil {
+			return nil, upload.Err
+		}
+
+		id, ok := parseDatName(path.Base(upload.Key))
+		if !ok {
+			continue
+		}
+
+		if of, ok := open[id]; ok {
+			// Parts of files still being written are only uploaded
+			// once they fill up, so this process knows their size.
+			res = append(res, of.tempFile())
+			continue
+		}
+
+		res = append(res, &records.TempFile{
+			ID:       id,
+			Size:     uint64(upload.Size),
+			Modified: upload.Initiated,
+		})
+	}
+
+	for info := range fs.client.Client.ListObjectsV2(fs.bucket, prefix, true, doneCh) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+
+		id, ok := parseDatName(path.Base(info.Key))
+		if !ok {
+			continue
+		}
+
+		res = append(res, &records.TempFile{
+			ID:       id,
+			Size:     uint64(info.Size),
+			Modified: info.LastModified,
+		})
+	}
+
+	return res, nil
+}
+
+func (fs *FileS3) RemoveTempFile(handleID uuid.UUID) error {
+	key := fs.tempKey(handleID)
+
+	fs.logger.Debug("removing temp handle %s at %s", handleID, key)
+
+	fs.removeTemp(handleID)
+
+	found := false
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for upload := range fs.client.ListIncompleteUploads(fs.bucket, key, false, doneCh) {
+		if upload.Err != nil {
+			return upload.Err
+		}
+		if upload.Key != key {
+			continue
+		}
+
+		err := fs.client.AbortMultipartUpload(fs.bucket, key, upload.UploadID)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		found = true
+	}
+
+	_, err := fs.client.StatObject(fs.bucket, key, minio.StatObjectOptions{})
+	if err == nil {
+		err = fs.client.RemoveObject(fs.bucket, key)
+		if err != nil {
+			return err
+		}
+		found = true
+	} else if !isNotFound(err) {
+		return err
+	}
+
+	if !found {
+		return scerrors.ErrNotFound
+	}
+
+	return nil
+}
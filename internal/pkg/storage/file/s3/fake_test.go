@@ -0,0 +1,521 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeS3 is a stand-in for an S3 compatible server with a single bucket.
+// It only supports the requests FileS3 makes and doesn't check signatures.
+type fakeS3 struct {
+	lock sync.Mutex
+
+	bucket  string
+	objects map[string]*fakeObject
+	uploads map[string]*fakeUpload
+	nextID  int
+
+	// gets are the Range headers of object GETs, empty for whole objects.
+	gets []string
+}
+
+type fakeObject struct {
+	data     []byte
+	modified time.Time
+}
+
+type fakeUpload struct {
+	key       string
+	parts     map[int][]byte
+	initiated time.Time
+}
+
+func newFakeS3(bucket string) *fakeS3 {
+	return &fakeS3{
+		bucket:  bucket,
+		objects: map[string]*fakeObject{},
+		uploads: map[string]*fakeUpload{},
+	}
+}
+
+func etag(data []byte) string {
+	return fmt.Sprintf("\"%x\"", md5.Sum(data))
+}
+
+func (f *fakeS3) writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(v)
+}
+
+type fakeError struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string
+	Message string
+}
+
+func (f *fakeS3) writeError(w http.ResponseWriter, status int, code string) {
+	f.writeXML(w, status, &fakeError{Code: code, Message: code})
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if parts[0] != f.bucket {
+		f.writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key := ""
+	if len(parts) > 1 {
+		key = parts[1]
+	}
+	query := r.URL.Query()
+
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case key == "" && r.Method == http.MethodGet && query["uploads"] != nil:
+		f.listUploads(w, query.Get("prefix"))
+	case key == "" && r.Method == http.MethodGet:
+		f.listObjects(w, query.Get("prefix"))
+
+	case r.Method == http.MethodHead:
+		f.headObject(w, key)
+	case r.Method == http.MethodGet && query.Get("uploadId") != "":
+		f.listParts(w, key, query.Get("uploadId"))
+	case r.Method == http.MethodGet:
+		f.getObject(w, r, key)
+	case r.Method == http.MethodPut && query.Get("uploadId") != "":
+		f.putPart(w, r, key, query.Get("uploadId"), query.Get("partNumber"))
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		f.copyObject(w, r, key)
+	case r.Method == http.MethodPut:
+		f.putObject(w, r, key)
+	case r.Method == http.MethodPost && query["uploads"] != nil:
+		f.initiateUpload(w, key)
+	case r.Method == http.MethodPost && query.Get("uploadId") != "":
+		f.completeUpload(w, r, key, query.Get("uploadId"))
+	case r.Method == http.MethodDelete && query.Get("uploadId") != "":
+		f.abortUpload(w, key, query.Get("uploadId"))
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		f.writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+type fakeContents struct {
+	Key          string
+	LastModified time.Time
+	ETag         string
+	Size         int
+}
+
+type fakeListObjects struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string
+	Prefix      string
+	KeyCount    int
+	IsTruncated bool
+	Contents    []fakeContents
+}
+
+func (f *fakeS3) listObjects(w http.ResponseWriter, prefix string) {
+	res := &fakeListObjects{
+		Name:   f.bucket,
+		Prefix: prefix,
+	}
+	for key, obj := range f.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		res.Contents = append(res.Contents, fakeContents{
+			Key:          key,
+			LastModified: obj.modified,
+			ETag:         etag(obj.data),
+			Size:         len(obj.data),
+		})
+	}
+	sort.Slice(res.Contents, func(i, j int) bool {
+		return res.Contents[i].Key < res.Contents[j].Key
+	})
+	res.KeyCount = len(res.Contents)
+
+	f.writeXML(w, http.StatusOK, res)
+}
+
+type fakeUploadInfo struct {
+	Key       string
+	UploadId  string
+	Initiated time.Time
+}
+
+type fakeListUploads struct {
+	XMLName     xml.Name `xml:"ListMultipartUploadsResult"`
+	Bucket      string
+	Prefix      string
+	IsTruncated bool
+	Upload      []fakeUploadInfo
+}
+
+func (f *fakeS3) listUploads(w http.ResponseWriter, prefix string) {
+	res := &fakeListUploads{
+		Bucket: f.bucket,
+		Prefix: prefix,
+	}
+	for id, upload := range f.uploads {
+		if !strings.HasPrefix(upload.key, prefix) {
+			continue
+		}
+		res.Upload = append(res.Upload, fakeUploadInfo{
+			Key:       upload.key,
+			UploadId:  id,
+			Initiated: upload.initiated,
+		})
+	}
+	sort.Slice(res.Upload, func(i, j int) bool {
+		return res.Upload[i].Key < res.Upload[j].Key
+	})
+
+	f.writeXML(w, http.StatusOK, res)
+}
+
+type fakePart struct {
+	PartNumber   int
+	ETag         string
+	Size         int
+	LastModified time.Time
+}
+
+type fakeListParts struct {
+	XMLName     xml.Name `xml:"ListPartsResult"`
+	Bucket      string
+	Key         string
+	UploadId    string
+	IsTruncated bool
+	Part        []fakePart
+}
+
+func (f *fakeS3) upload(w http.ResponseWriter, key string, uploadID string) (*fakeUpload, bool) {
+	upload, ok := f.uploads[uploadID]
+	if !ok || upload.key != key {
+		f.writeError(w, http.StatusNotFound, "NoSuchUpload")
+		return nil, false
+	}
+	return upload, true
+}
+
+func (f *fakeS3) listParts(w http.ResponseWriter, key string, uploadID string) {
+	upload, ok := f.upload(w, key, uploadID)
+	if !ok {
+		return
+	}
+
+	res := &fakeListParts{
+		Bucket:   f.bucket,
+		Key:      key,
+		UploadId: uploadID,
+	}
+	for number, data := range upload.parts {
+		res.Part = append(res.Part, fakePart{
+			PartNumber:   number,
+			ETag:         etag(data),
+			Size:         len(data),
+			LastModified: upload.initiated,
+		})
+	}
+	sort.Slice(res.Part, func(i, j int) bool {
+		return res.Part[i].PartNumber < res.Part[j].PartNumber
+	})
+
+	f.writeXML(w, http.StatusOK, res)
+}
+
+func (f *fakeS3) writeObjectHeaders(w http.ResponseWriter, obj *fakeObject, size int) {
+	w.Header().Set("Content-Length", strconv.Itoa(size))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("ETag", etag(obj.data))
+	w.Header().Set("Last-Modified", obj.modified.UTC().Format(http.TimeFormat))
+}
+
+func (f *fakeS3) headObject(w http.ResponseWriter, key string) {
+	obj, ok := f.objects[key]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	f.writeObjectHeaders(w, obj, len(obj.data))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeS3) getObject(w http.ResponseWriter, r *http.Request, key string) {
+	rangeHeader := r.Header.Get("Range")
+	f.gets = append(f.gets, rangeHeader)
+
+	obj, ok := f.objects[key]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+
+	if rangeHeader == "" {
+		f.writeObjectHeaders(w, obj, len(obj.data))
+		w.WriteHeader(http.StatusOK)
+		w.Write(obj.data)
+		return
+	}
+
+	var start, end int
+	bounds := strings.SplitN(strings.TrimPrefix(rangeHeader, "bytes="), "-", 2)
+	start, err := strconv.Atoi(bounds[0])
+	if err != nil || len(bounds) != 2 || start >= len(obj.data) {
+		f.writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+		return
+	}
+	end = len(obj.data) - 1
+	if bounds[1] != "" {
+		end, err = strconv.Atoi(bounds[1])
+		if err != nil {
+			f.writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+	}
+
+	f.writeObjectHeaders(w, obj, end-start+1)
+	w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(obj.data)))
+	w.WriteHeader(http.StatusPartialContent)
+	w.Write(obj.data[start : end+1])
+}
+
+// readBody reads a request body, decoding the chunks of streaming
+// signed uploads.
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Header.Get("X-Amz-Content-Sha256") != "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		return ioutil.ReadAll(r.Body)
+	}
+
+	res := &bytes.Buffer{}
+	br := bufio.NewReader(r.Body)
+	for {
+		header, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.ParseInt(strings.SplitN(header, ";", 2)[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return res.Bytes(), nil
+		}
+
+		_, err = io.CopyN(res, br, size)
+		if err != nil {
+			return nil, err
+		}
+		_, err = br.Discard(2)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (f *fakeS3) putObject(w http.ResponseWriter, r *http.Request, key string) {
+	data, err := readBody(r)
+	if err != nil {
+		f.writeError(w, http.StatusBadRequest, "IncompleteBody")
+		return
+	}
+
+	f.objects[key] = &fakeObject{
+		data:     data,
+		modified: time.Now(),
+	}
+
+	w.Header().Set("ETag", etag(data))
+	w.WriteHeader(http.StatusOK)
+}
+
+type fakeCopyResult struct {
+	XMLName      xml.Name `xml:"CopyObjectResult"`
+	ETag         string
+	LastModified time.Time
+}
+
+func (f *fakeS3) copyObject(w http.ResponseWriter, r *http.Request, key string) {
+	source, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		f.writeError(w, http.StatusBadRequest, "InvalidArgument")
+		return
+	}
+	source = strings.TrimPrefix(strings.TrimPrefix(source, "/"), f.bucket+"/")
+
+	obj, ok := f.objects[source]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+
+	copied := &fakeObject{
+		data:     append([]byte{}, obj.data...),
+		modified: time.Now(),
+	}
+	f.objects[key] = copied
+
+	f.writeXML(w, http.StatusOK, &fakeCopyResult{
+		ETag:         etag(copied.data),
+		LastModified: copied.modified,
+	})
+}
+
+type fakeInitiateResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Bucket   string
+	Key      string
+	UploadId string
+}
+
+func (f *fakeS3) initiateUpload(w http.ResponseWriter, key string) {
+	f.nextID++
+	uploadID := strconv.Itoa(f.nextID)
+	f.uploads[uploadID] = &fakeUpload{
+		key:       key,
+		parts:     map[int][]byte{},
+		initiated: time.Now(),
+	}
+
+	f.writeXML(w, http.StatusOK, &fakeInitiateResult{
+		Bucket:   f.bucket,
+		Key:      key,
+		UploadId: uploadID,
+	})
+}
+
+func (f *fakeS3) putPart(w http.ResponseWriter, r *http.Request, key string, uploadID string, partNumber string) {
+	upload, ok := f.upload(w, key, uploadID)
+	if !ok {
+		return
+	}
+
+	number, err := strconv.Atoi(partNumber)
+	if err != nil {
+		f.writeError(w, http.StatusBadRequest, "InvalidArgument")
+		return
+	}
+	data, err := readBody(r)
+	if err != nil {
+		f.writeError(w, http.StatusBadRequest, "IncompleteBody")
+		return
+	}
+	upload.parts[number] = data
+
+	w.Header().Set("ETag", etag(data))
+	w.WriteHeader(http.StatusOK)
+}
+
+type fakeComplete struct {
+	Part []struct {
+		PartNumber int
+		ETag       string
+	}
+}
+
+type fakeCompleteResult struct {
+	XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+	Bucket  string
+	Key     string
+	ETag    string
+}
+
+func (f *fakeS3) completeUpload(w http.ResponseWriter, r *http.Request, key string, uploadID string) {
+	upload, ok := f.upload(w, key, uploadID)
+	if !ok {
+		return
+	}
+
+	complete := &fakeComplete{}
+	err := xml.NewDecoder(r.Body).Decode(complete)
+	if err != nil {
+		f.writeError(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+
+	data := []byte{}
+	for _, part := range complete.Part {
+		partData, ok := upload.parts[part.PartNumber]
+		if !ok || strings.Trim(etag(partData), "\"") != strings.Trim(part.ETag, "\"") {
+			f.writeError(w, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		data = append(data, partData...)
+	}
+
+	delete(f.uploads, uploadID)
+	f.objects[key] = &fakeObject{
+		data:     data,
+		modified: time.Now(),
+	}
+
+	f.writeXML(w, http.StatusOK, &fakeCompleteResult{
+		Bucket: f.bucket,
+		Key:    key,
+		ETag:   etag(data),
+	})
+}
+
+func (f *fakeS3) abortUpload(w http.ResponseWriter, key string, uploadID string) {
+	_, ok := f.upload(w, key, uploadID)
+	if !ok {
+		return
+	}
+
+	delete(f.uploads, uploadID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// objectKeys returns the keys of the stored objects, sorted.
+func (f *fakeS3) objectKeys() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	keys := []string{}
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (f *fakeS3) uploadCount() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return len(f.uploads)
+}
+
+func (f *fakeS3) objectGets() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]string{}, f.gets...)
+}
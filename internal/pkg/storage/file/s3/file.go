@@ -0,0 +1,321 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v6"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+type openS3File struct {
+	fileS3 *FileS3
+
+	mode   records.FileMode
+	key    string
+	offset int64
+	closed bool
+
+	// body reads the object from the offset when reading, it's opened
+	// on the first read after a seek so only what's read is downloaded.
+	size int64
+	body io.ReadCloser
+
+	// lock guards the upload state of files opened for writing, which is
+	// also read when listing temp files.
+	lock     sync.Mutex
+	handleID uuid.UUID
+	uploadID string
+	parts    []minio.CompletePart
+	// uploaded is the size of the uploaded parts, buf holds what's been
+	// written after them.
+	uploaded int64
+	buf      []byte
+	modified time.Time
+}
+
+func newOpenS3ReadFile(fs *FileS3, key string, size int64) *openS3File {
+	return &openS3File{
+		fileS3: fs,
+
+		mode: records.FILE_MODE_READ,
+		key:  key,
+		size: size,
+	}
+}
+
+func newOpenS3WriteFile(fs *FileS3, key string, handleID uuid.UUID, uploadID string) *openS3File {
+	return &openS3File{
+		fileS3: fs,
+
+		mode:     records.FILE_MODE_WRITE,
+		key:      key,
+		handleID: handleID,
+		uploadID: uploadID,
+		modified: time.Now(),
+	}
+}
+
+func (osf *openS3File) Seek(offset int64, whence int) (int64, error) {
+	if osf.closed {
+		return 0, fmt.Errorf("file already closed")
+	}
+
+	osf.lock.Lock()
+	defer osf.lock.Unlock()
+
+	size := osf.size
+	if osf.mode == records.FILE_MODE_WRITE {
+		size = osf.uploaded + int64(len(osf.buf))
+	}
+
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = osf.offset + offset
+	case io.SeekEnd:
+		newOffset = size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if newOffset < 0 {
+		return 0, fmt.Errorf("negative offset")
+	}
+	if osf.mode == records.FILE_MODE_WRITE && newOffset < osf.uploaded {
+		return 0, fmt.Errorf("can't seek to data that's already been uploaded")
+	}
+
+	if newOffset != osf.offset {
+		osf.closeBody()
+	}
+	osf.offset = newOffset
+
+	return newOffset, nil
+}
+
+func (osf *openS3File) closeBody() {
+	if osf.body != nil {
+		osf.body.Close()
+		osf.body = nil
+	}
+}
+
+func (osf *openS3File) Read(b []byte) (int, error) {
+	if osf.mode != records.FILE_MODE_READ {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+	if osf.closed {
+		return 0, fmt.Errorf("file already closed")
+	}
+
+	if osf.offset >= osf.size {
+		return 0, io.EOF
+	}
+
+	if osf.body == nil {
+		body, err := osf.fileS3.getRange(osf.key, osf.offset)
+		if err != nil {
+			return 0, err
+		}
+		osf.body = body
+	}
+
+	n, err := osf.body.Read(b)
+	osf.offset += int64(n)
+	if err == io.EOF && osf.offset < osf.size {
+		// The object is shorter than when it was opened
+		return n, io.ErrUnexpectedEOF
+	}
+
+	return n, err
+}
+
+func (osf *openS3File) Write(b []byte) (int, error) {
+	if osf.mode != records.FILE_MODE_WRITE {
+		return 0, scerrors.ErrInvalidModeAction
+	}
+	if osf.closed {
+		return 0, fmt.Errorf("file already closed")
+	}
+
+	osf.lock.Lock()
+	defer osf.lock.Unlock()
+
+	// Writing past the end of the file fills the gap with zeros,
+	// the same as a sparse file on disk.
+	bufOffset := osf.offset - osf.uploaded
+	end := bufOffset + int64(len(b))
+	if end > int64(len(osf.buf)) {
+		grown := make([]byte, end)
+		copy(grown, osf.buf)
+		osf.buf = grown
+	}
+
+	n := copy(osf.buf[bufOffset:], b)
+	osf.offset += int64(n)
+	osf.modified = time.Now()
+
+	// Upload full parts once they've been written past, files are almost
+	// always written in order so they won't be written to again.
+	partSize := osf.fileS3.partSize
+	for len(osf.buf) >= partSize && osf.offset-osf.uploaded >= int64(partSize) {
+		err := osf.uploadPart(osf.buf[:partSize])
+		if err != nil {
+			return n, err
+		}
+
+		osf.uploaded += int64(partSize)
+		osf.buf = append([]byte{}, osf.buf[partSize:]...)
+	}
+
+	return n, nil
+}
+
+// uploadPart uploads data as the next part. The caller must hold the lock.
+func (osf *openS3File) uploadPart(data []byte) error {
+	fs := osf.fileS3
+
+	partNumber := len(osf.parts) + 1
+	part, err := fs.client.PutObjectPart(
+		fs.bucket, osf.key, osf.uploadID,
+		partNumber,
+		bytes.NewReader(data), int64(len(data)),
+		"", "", nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	osf.parts = append(osf.parts, minio.CompletePart{
+		PartNumber: partNumber,
+		ETag:       part.ETag,
+	})
+
+	return nil
+}
+
+// Flush doesn't upload anything, parts are uploaded as they fill up and
+// the rest when the file is claimed.
+func (osf *openS3File) Flush() error {
+	return nil
+}
+
+func (osf *openS3File) Close() error {
+	osf.closed = true
+	osf.closeBody()
+	return nil
+}
+
+func (osf *openS3File) Claim(id uuid.UUID) error {
+	if osf.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	err := osf.Close()
+	if err != nil {
+		return err
+	}
+
+	osf.lock.Lock()
+	defer osf.lock.Unlock()
+
+	fs := osf.fileS3
+	claimKey := fs.blobKey(id)
+
+	if len(osf.parts) == 0 {
+		// Nothing's been uploaded yet, so put the file in place with a
+		// single request instead of completing and copying the upload.
+		_, err = fs.client.PutObject(
+			fs.bucket, claimKey,
+			bytes.NewReader(osf.buf), int64(len(osf.buf)),
+			"", "", nil, nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		return osf.abort()
+	}
+
+	if len(osf.buf) > 0 {
+		err = osf.uploadPart(osf.buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fs.client.CompleteMultipartUpload(fs.bucket, osf.key, osf.uploadID, osf.parts)
+	if err != nil {
+		return err
+	}
+	fs.removeTemp(osf.handleID)
+	osf.buf = nil
+
+	// Uploads can't be completed under a different key, so copy the
+	// completed upload to where claimed files are kept.
+	dst, err := minio.NewDestinationInfo(fs.bucket, claimKey, nil, nil)
+	if err != nil {
+		return err
+	}
+	err = fs.client.ComposeObject(dst, []minio.SourceInfo{
+		minio.NewSourceInfo(fs.bucket, osf.key, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	return fs.client.RemoveObject(fs.bucket, osf.key)
+}
+
+func (osf *openS3File) Drop() error {
+	if osf.mode != records.FILE_MODE_WRITE {
+		return scerrors.ErrInvalidModeAction
+	}
+
+	err := osf.Close()
+	if err != nil {
+		return err
+	}
+
+	osf.lock.Lock()
+	defer osf.lock.Unlock()
+
+	return osf.abort()
+}
+
+// abort aborts the upload and forgets the file. The caller must hold the
+// lock.
+func (osf *openS3File) abort() error {
+	fs := osf.fileS3
+
+	fs.removeTemp(osf.handleID)
+	osf.buf = nil
+
+	err := fs.client.AbortMultipartUpload(fs.bucket, osf.key, osf.uploadID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// tempFile describes the file while it's being written.
+func (osf *openS3File) tempFile() *records.TempFile {
+	osf.lock.Lock()
+	defer osf.lock.Unlock()
+
+	return &records.TempFile{
+		ID:       osf.handleID,
+		Size:     uint64(osf.uploaded) + uint64(len(osf.buf)),
+		Modified: osf.modified,
+	}
+}
@@ -0,0 +1,249 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v6"
+
+	"github.com/aphistic/softcopy/internal/pkg/logging"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+)
+
+const (
+	defaultRegion = "us-east-1"
+	// defaultPartSize is how much of a file being written is buffered
+	// before it's uploaded as a part.
+	defaultPartSize = 8 * 1024 * 1024
+	// minPartSize is the smallest part S3 accepts for every part but
+	// the last.
+	minPartSize = 5 * 1024 * 1024
+
+	tempDir = "tmp"
+)
+
+type FileS3Option func(*FileS3)
+
+func WithLogger(logger logging.Logger) FileS3Option {
+	return func(fs *FileS3) {
+		fs.logger = logger
+	}
+}
+
+// WithCredentials sets the access key used to sign requests, requests
+// are anonymous without it.
+func WithCredentials(accessKeyID string, secretAccessKey string) FileS3Option {
+	return func(fs *FileS3) {
+		fs.accessKeyID = accessKeyID
+		fs.secretAccessKey = secretAccessKey
+	}
+}
+
+func WithRegion(region string) FileS3Option {
+	return func(fs *FileS3) {
+		fs.region = region
+	}
+}
+
+// WithInsecure connects to the endpoint over HTTP instead of HTTPS.
+func WithInsecure() FileS3Option {
+	return func(fs *FileS3) {
+		fs.secure = false
+	}
+}
+
+// WithPrefix stores files under a prefix in the bucket so the bucket can
+// be shared with other things.
+func WithPrefix(prefix string) FileS3Option {
+	return func(fs *FileS3) {
+		fs.prefix = strings.Trim(prefix, "/")
+	}
+}
+
+// WithPartSize sets how much of a file being written is buffered before
+// it's uploaded as a part. It needs to be at least 5MiB.
+func WithPartSize(partSize int) FileS3Option {
+	return func(fs *FileS3) {
+		fs.partSize = partSize
+	}
+}
+
+// FileS3 is a storage.File that keeps files in an S3 compatible bucket
+// using the same key layout as the local file storage's paths. Files
+// being written are uploaded as multipart uploads under tmp/ and copied
+// to their final key when they're claimed.
+type FileS3 struct {
+	logger logging.Logger
+
+	accessKeyID     string
+	secretAccessKey string
+	region          string
+	secure          bool
+	prefix          string
+	partSize        int
+
+	client *minio.Core
+	bucket string
+
+	lock sync.RWMutex
+	// temps are the files opened for writing by this process, which
+	// know more about their size than the uploads in the bucket.
+	temps map[uuid.UUID]*openS3File
+}
+
+var _ storage.File = &FileS3{}
+
+func NewFileS3(endpoint string, bucket string, opts ...FileS3Option) (*FileS3, error) {
+	fs := &FileS3{
+		logger: logging.NewNilLogger(),
+
+		region:   defaultRegion,
+		secure:   true,
+		partSize: defaultPartSize,
+
+		bucket: bucket,
+		temps:  map[uuid.UUID]*openS3File{},
+	}
+
+	for _, opt := range opts {
+		opt(fs)
+	}
+
+	if fs.partSize < minPartSize {
+		return nil, fmt.Errorf("part size must be at least %d", minPartSize)
+	}
+
+	client, err := minio.NewWithRegion(
+		endpoint,
+		fs.accessKeyID, fs.secretAccessKey,
+		fs.secure, fs.region,
+	)
+	if err != nil {
+		return nil, err
+	}
+	fs.client = &minio.Core{Client: client}
+
+	exists, err := fs.client.BucketExists(bucket)
+	if err != nil {
+		return nil, fmt.Errorf("could not read bucket %s: %s", bucket, err)
+	} else if !exists {
+		return nil, fmt.Errorf("bucket %s doesn't exist", bucket)
+	}
+
+	return fs, nil
+}
+
+func (fs *FileS3) key(filePath string) string {
+	return path.Join(fs.prefix, filePath)
+}
+
+func (fs *FileS3) blobKey(id uuid.UUID) string {
+	return fs.key(path.Join(
+		id.String()[0:1],
+		id.String()[1:2],
+		id.String()+".dat",
+	))
+}
+
+func (fs *FileS3) tempKey(handleID uuid.UUID) string {
+	return fs.key(path.Join(tempDir, handleID.String()+".dat"))
+}
+
+func isNotFound(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchKey" || code == "NoSuchUpload"
+}
+
+func (fs *FileS3) OpenFile(id uuid.UUID) (storage.OpenFile, error) {
+	key := fs.blobKey(id)
+
+	fs.logger.Debug("opening %s for read at %s", id, key)
+
+	info, err := fs.client.StatObject(fs.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return newOpenS3ReadFile(fs, key, info.Size), nil
+}
+
+func (fs *FileS3) OpenTempFile(handleID uuid.UUID) (storage.OpenFile, error) {
+	key := fs.tempKey(handleID)
+
+	fs.logger.Debug("opening temp handle %s at %s", handleID, key)
+
+	uploadID, err := fs.client.NewMultipartUpload(fs.bucket, key, minio.PutObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	of := newOpenS3WriteFile(fs, key, handleID, uploadID)
+
+	fs.lock.Lock()
+	fs.temps[handleID] = of
+	fs.lock.Unlock()
+
+	return of, nil
+}
+
+func (fs *FileS3) removeTemp(handleID uuid.UUID) {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+
+	delete(fs.temps, handleID)
+}
+
+func (fs *FileS3) ReadFile(id uuid.UUID) (io.ReadCloser, error) {
+	return fs.ReadFileFromOffset(id, 0)
+}
+
+func (fs *FileS3) ReadFileFromOffset(
+	id uuid.UUID,
+	offset uint64,
+) (io.ReadCloser, error) {
+	r, err := fs.getRange(fs.blobKey(id), int64(offset))
+	if minio.ToErrorResponse(err).Code == "InvalidRange" {
+		// Reading from the end of the file or past it, which is empty
+		// rather than an error for local files.
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// getRange gets an object from the offset to the end, so only what's read
+// is downloaded.
+func (fs *FileS3) getRange(key string, offset int64) (io.ReadCloser, error) {
+	opts := minio.GetObjectOptions{}
+	if offset > 0 {
+		err := opts.SetRange(offset, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, _, _, err := fs.client.GetObject(fs.bucket, key, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// tempFile returns the temp file with the handle ID if it was opened by
+// this process.
+func (fs *FileS3) tempFile(handleID uuid.UUID) (*openS3File, bool) {
+	fs.lock.RLock()
+	defer fs.lock.RUnlock()
+
+	of, ok := fs.temps[handleID]
+	return of, ok
+}
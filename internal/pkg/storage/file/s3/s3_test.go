@@ -0,0 +1,171 @@
+package s3
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/storagetest"
+)
+
+const testBucket = "softcopy"
+
+func newTestFileS3(t *testing.T, opts ...FileS3Option) (*FileS3, *fakeS3) {
+	fake := newFakeS3(testBucket)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	opts = append([]FileS3Option{
+		WithCredentials("access", "secret"),
+		WithInsecure(),
+	}, opts...)
+	fs, err := NewFileS3(strings.TrimPrefix(srv.URL, "http://"), testBucket, opts...)
+	require.NoError(t, err)
+
+	return fs, fake
+}
+
+func TestFileS3(t *testing.T) {
+	t.Run("conformance", func(t *testing.T) {
+		storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+			fs, _ := newTestFileS3(t)
+			return fs
+		})
+	})
+	t.Run("conformance with prefix", func(t *testing.T) {
+		storagetest.RunFileTests(t, func(t *testing.T) storage.File {
+			fs, _ := newTestFileS3(t, WithPrefix("/softcopy/files/"))
+			return fs
+		})
+	})
+	t.Run("missing bucket", func(t *testing.T) {
+		srv := httptest.NewServer(newFakeS3(testBucket))
+		defer srv.Close()
+
+		_, err := NewFileS3(strings.TrimPrefix(srv.URL, "http://"), "missing", WithInsecure())
+		assert.Error(t, err)
+	})
+	t.Run("invalid part size", func(t *testing.T) {
+		srv := httptest.NewServer(newFakeS3(testBucket))
+		defer srv.Close()
+
+		endpoint := strings.TrimPrefix(srv.URL, "http://")
+		_, err := NewFileS3(endpoint, testBucket, WithInsecure(), WithPartSize(0))
+		assert.Error(t, err)
+		_, err = NewFileS3(endpoint, testBucket, WithInsecure(), WithPartSize(5*1024*1024-1))
+		assert.Error(t, err)
+		_, err = NewFileS3(endpoint, testBucket, WithInsecure(), WithPartSize(5*1024*1024))
+		assert.NoError(t, err)
+	})
+	t.Run("multipart upload", func(t *testing.T) {
+		fs, fake := newTestFileS3(t, WithPrefix("files"))
+		// Parts this small are only accepted by the fake S3
+		fs.partSize = 4
+
+		handleID := uuid.New()
+		of, err := fs.OpenTempFile(handleID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, fake.uploadCount())
+
+		for _, chunk := range []string{"hel", "lo w", "orld"} {
+			_, err = of.Write([]byte(chunk))
+			require.NoError(t, err)
+		}
+
+		temps, err := fs.ListTempFiles()
+		require.NoError(t, err)
+		require.Len(t, temps, 1)
+		assert.Equal(t, handleID, temps[0].ID)
+		assert.EqualValues(t, 11, temps[0].Size)
+
+		// The first two parts have been uploaded, so only the rest of
+		// the file can still be changed.
+		_, err = of.Seek(2, io.SeekStart)
+		assert.Error(t, err)
+		_, err = of.Seek(-2, io.SeekEnd)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("LD!"))
+		require.NoError(t, err)
+
+		id := uuid.New()
+		require.NoError(t, of.Claim(id))
+		assert.Equal(t, 0, fake.uploadCount())
+		assert.Equal(t, []string{fs.blobKey(id)}, fake.objectKeys())
+		assert.True(t, strings.HasPrefix(fs.blobKey(id), "files/"))
+
+		r, err := fs.ReadFile(id)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "hello worLD!", string(data))
+
+		temps, err = fs.ListTempFiles()
+		require.NoError(t, err)
+		assert.Len(t, temps, 0)
+	})
+	t.Run("abandoned uploads", func(t *testing.T) {
+		fs, fake := newTestFileS3(t)
+		fs.partSize = 4
+
+		handleID := uuid.New()
+		of, err := fs.OpenTempFile(handleID)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello world"))
+		require.NoError(t, err)
+		require.NoError(t, of.Close())
+
+		// Another process only sees the uploaded parts
+		other, err := NewFileS3(fs.client.EndpointURL().Host, testBucket, WithInsecure())
+		require.NoError(t, err)
+
+		temps, err := other.ListTempFiles()
+		require.NoError(t, err)
+		require.Len(t, temps, 1)
+		assert.Equal(t, handleID, temps[0].ID)
+		assert.EqualValues(t, 8, temps[0].Size)
+
+		require.NoError(t, other.RemoveTempFile(handleID))
+		assert.Equal(t, 0, fake.uploadCount())
+	})
+	t.Run("reads ranges", func(t *testing.T) {
+		fs, fake := newTestFileS3(t)
+
+		id := uuid.New()
+		of, err := fs.OpenTempFile(uuid.New())
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello world"))
+		require.NoError(t, err)
+		require.NoError(t, of.Claim(id))
+
+		of, err = fs.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		_, err = of.Seek(6, io.SeekStart)
+		require.NoError(t, err)
+		buf := make([]byte, 2)
+		_, err = io.ReadFull(of, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "wo", string(buf))
+		_, err = io.ReadFull(of, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "rl", string(buf))
+
+		r, err := fs.ReadFileFromOffset(id, 11)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Len(t, data, 0)
+
+		// Reading on from where the last read stopped reuses the request
+		assert.Equal(t, []string{"bytes=6-", "bytes=11-"}, fake.objectGets())
+	})
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// ListFilesOptions describe a page of files to list.
+type ListFilesOptions struct {
+	// Query limits the files to those matching it. A nil query lists all
+	// files.
+	Query query.Node
+
+	Sort       records.FileSort
+	Descending bool
+
+	// After is the last file of the previous page, only its ID and the
+	// value of the sort key are used. A nil file starts at the first page.
+	After *records.File
+	// Limit is the most files to return, or all files if it's less than 1.
+	Limit int
+}
+
+// FilePage is a page of listed files.
+type FilePage struct {
+	Files []*records.File
+	// Total is the number of files matching the query across all pages.
+	Total int
+	// More is set if there are files after this page.
+	More bool
+}
@@ -0,0 +1,170 @@
+// Package migration reports and changes the schema version of the SQL
+// data engines, whose migrations are run with sql-migrate.
+package migration
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// ErrNewerSchema is returned when the database has migrations applied
+// that aren't known, which means it was migrated by a newer version.
+var ErrNewerSchema = errors.New("database schema is newer than this version supports")
+
+// Migration is a schema migration and whether it's been applied.
+type Migration struct {
+	// ID is the name of the migration, such as 0005-file-fields.sql.
+	ID      string
+	Version int
+	Applied bool
+	// AppliedAt is when the migration was applied, if it has been.
+	AppliedAt time.Time
+	// Unknown is set for migrations that were applied by a newer
+	// version and aren't known by this one.
+	Unknown bool
+}
+
+// Status returns the known migrations and any unknown applied ones,
+// ordered by version.
+func Status(db *sql.DB, dialect string, source migrate.MigrationSource) ([]*Migration, error) {
+	known, err := source.FindMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := migrate.GetMigrationRecords(db, dialect)
+	if err != nil {
+		return nil, err
+	}
+	appliedAt := map[string]time.Time{}
+	for _, record := range applied {
+		appliedAt[record.Id] = record.AppliedAt
+	}
+
+	res := []*Migration{}
+	for _, m := range known {
+		at, ok := appliedAt[m.Id]
+		delete(appliedAt, m.Id)
+
+		res = append(res, &Migration{
+			ID:        m.Id,
+			Version:   int(m.VersionInt()),
+			Applied:   ok,
+			AppliedAt: at,
+		})
+	}
+	for id, at := range appliedAt {
+		m := &migrate.Migration{Id: id}
+		res = append(res, &Migration{
+			ID:        id,
+			Version:   int(m.VersionInt()),
+			Applied:   true,
+			AppliedAt: at,
+			Unknown:   true,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Version == res[j].Version {
+			return res[i].ID < res[j].ID
+		}
+		return res[i].Version < res[j].Version
+	})
+
+	return res, nil
+}
+
+// Current returns the version of the newest applied migration, or zero if
+// none have been applied.
+func Current(migrations []*Migration) int {
+	current := 0
+	for _, m := range migrations {
+		if m.Applied && m.Version > current {
+			current = m.Version
+		}
+	}
+	return current
+}
+
+// Latest returns the version of the newest known migration.
+func Latest(migrations []*Migration) int {
+	latest := 0
+	for _, m := range migrations {
+		if !m.Unknown && m.Version > latest {
+			latest = m.Version
+		}
+	}
+	return latest
+}
+
+// Check returns ErrNewerSchema if any of the migrations are unknown.
+func Check(migrations []*Migration) error {
+	for _, m := range migrations {
+		if m.Unknown {
+			return ErrNewerSchema
+		}
+	}
+	return nil
+}
+
+// Plan returns the direction and number of migrations to apply to get to
+// the version. It returns zero migrations if the schema is already at the
+// version.
+func Plan(migrations []*Migration, version int) (migrate.MigrationDirection, int, error) {
+	err := Check(migrations)
+	if err != nil {
+		return migrate.Up, 0, err
+	}
+
+	if version < 0 || version > Latest(migrations) {
+		return migrate.Up, 0, fmt.Errorf("unknown schema version %d", version)
+	}
+
+	current := Current(migrations)
+	up, down := 0, 0
+	for _, m := range migrations {
+		if !m.Applied && m.Version < current {
+			// sql-migrate would apply these along with any others,
+			// so don't guess what the result would be.
+			return migrate.Up, 0, fmt.Errorf(
+				"migration %s was skipped, the schema needs to be repaired by hand",
+				m.ID,
+			)
+		}
+
+		if !m.Applied && m.Version <= version {
+			up++
+		} else if m.Applied && m.Version > version {
+			down++
+		}
+	}
+
+	if down > 0 {
+		return migrate.Down, down, nil
+	}
+	return migrate.Up, up, nil
+}
+
+// To migrates the schema up or down to the version, returning the number
+// of migrations that were applied.
+func To(db *sql.DB, dialect string, source migrate.MigrationSource, version int) (int, error) {
+	migrations, err := Status(db, dialect, source)
+	if err != nil {
+		return 0, err
+	}
+
+	dir, count, err := Plan(migrations, version)
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	return migrate.ExecMax(db, dialect, source, dir, count)
+}
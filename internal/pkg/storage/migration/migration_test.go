@@ -0,0 +1,69 @@
+package migration
+
+import (
+	"testing"
+
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	// migrations returns three known migrations with the first applied
+	// ones applied.
+	migrations := func(applied int) []*Migration {
+		res := []*Migration{}
+		for version := 1; version <= 3; version++ {
+			res = append(res, &Migration{
+				Version: version,
+				Applied: version <= applied,
+			})
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		applied int
+		version int
+		dir     migrate.MigrationDirection
+		count   int
+	}{
+		{applied: 0, version: 3, dir: migrate.Up, count: 3},
+		{applied: 1, version: 2, dir: migrate.Up, count: 1},
+		{applied: 3, version: 1, dir: migrate.Down, count: 2},
+		{applied: 3, version: 0, dir: migrate.Down, count: 3},
+		{applied: 2, version: 2, dir: migrate.Up, count: 0},
+	} {
+		dir, count, err := Plan(migrations(tc.applied), tc.version)
+		require.NoError(t, err)
+		assert.Equal(t, tc.dir, dir, "applied %d to %d", tc.applied, tc.version)
+		assert.Equal(t, tc.count, count, "applied %d to %d", tc.applied, tc.version)
+	}
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, _, err := Plan(migrations(1), 4)
+		assert.Error(t, err)
+		_, _, err = Plan(migrations(1), -1)
+		assert.Error(t, err)
+	})
+	t.Run("newer schema", func(t *testing.T) {
+		ms := append(migrations(3), &Migration{
+			ID:      "0004-newer.sql",
+			Version: 4,
+			Applied: true,
+			Unknown: true,
+		})
+		assert.Equal(t, 3, Latest(ms))
+		assert.Equal(t, 4, Current(ms))
+
+		_, _, err := Plan(ms, 3)
+		assert.Equal(t, ErrNewerSchema, err)
+	})
+	t.Run("skipped migration", func(t *testing.T) {
+		ms := migrations(3)
+		ms[1].Applied = false
+
+		_, _, err := Plan(ms, 3)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,40 @@
+package records
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit actions recorded in the audit log.
+const (
+	AuditActionCreateFile     = "create_file"
+	AuditActionUpdateFile     = "update_file"
+	AuditActionUpdateFileDate = "update_file_date"
+	AuditActionUpdateFileTags = "update_file_tags"
+	AuditActionUpdateFileHash = "update_file_hash"
+	AuditActionSetFileFields  = "set_file_fields"
+	AuditActionLinkFiles      = "link_files"
+	AuditActionUnlinkFiles    = "unlink_files"
+	AuditActionRemoveFile     = "remove_file"
+	AuditActionRestoreFile    = "restore_file"
+	AuditActionPurgeFile      = "purge_file"
+	AuditActionCreateTags     = "create_tags"
+	AuditActionRenameTag      = "rename_tag"
+	AuditActionMergeTags      = "merge_tags"
+	AuditActionDeleteTag      = "delete_tag"
+)
+
+// AuditEntry records a single change made by an actor.
+type AuditEntry struct {
+	ID      int64
+	Created time.Time
+	// Actor is who made the change, such as a user name or an importer.
+	Actor  string
+	Action string
+	// FileID is the changed file, or uuid.Nil for changes that aren't
+	// to a single file, such as creating tags.
+	FileID uuid.UUID
+	Before string
+	After  string
+}
@@ -0,0 +1,12 @@
+package records
+
+// DuplicateGroup is a set of files that are copies of the same document.
+type DuplicateGroup struct {
+	// Hash is the contents the files share, or empty for near-duplicates
+	// whose contents differ, such as the same page scanned twice.
+	Hash string
+	// Distance is how different the most different near-duplicates in
+	// the group look, zero for files with the same contents.
+	Distance int
+	Files    []*File
+}
@@ -0,0 +1,194 @@
+package records
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// FieldDateLayout is the format date field values are written in.
+const FieldDateLayout = "2006-01-02"
+
+type FieldType int
+
+const (
+	FIELD_TYPE_UNKNOWN FieldType = 0
+	FIELD_TYPE_STRING  FieldType = 1
+	FIELD_TYPE_NUMBER  FieldType = 2
+	FIELD_TYPE_DATE    FieldType = 3
+	FIELD_TYPE_MONEY   FieldType = 4
+)
+
+var fieldTypeNames = map[FieldType]string{
+	FIELD_TYPE_STRING: "string",
+	FIELD_TYPE_NUMBER: "number",
+	FIELD_TYPE_DATE:   "date",
+	FIELD_TYPE_MONEY:  "money",
+}
+
+func (ft FieldType) String() string {
+	if name, ok := fieldTypeNames[ft]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseFieldType parses the name of a field type, such as money.
+func ParseFieldType(name string) (FieldType, error) {
+	for ft, ftName := range fieldTypeNames {
+		if strings.EqualFold(name, ftName) {
+			return ft, nil
+		}
+	}
+
+	return FIELD_TYPE_UNKNOWN, fmt.Errorf("unknown field type '%s'", name)
+}
+
+// Money is an amount of a currency, such as 12.34 USD.
+type Money struct {
+	// Amount is in hundredths of the currency's unit, such as cents.
+	Amount int64
+	// Currency is the ISO 4217 code of the currency, such as USD.
+	Currency string
+}
+
+// ParseMoney parses an amount with up to two decimal places followed by
+// a currency code, such as 12.34 USD.
+func ParseMoney(value string) (Money, error) {
+	parts := strings.Fields(value)
+	if len(parts) != 2 {
+		return Money{}, fmt.Errorf("invalid money '%s', expected an amount and currency", value)
+	}
+
+	amount, err := ParseMoneyAmount(parts[0])
+	if err != nil {
+		return Money{}, err
+	}
+
+	currency := strings.ToUpper(parts[1])
+	if len(currency) != 3 || strings.IndexFunc(currency, func(r rune) bool {
+		return r < 'A' || r > 'Z'
+	}) >= 0 {
+		return Money{}, fmt.Errorf("invalid currency '%s'", parts[1])
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseMoneyAmount parses an amount with up to two decimal places into
+// hundredths of a unit.
+func ParseMoneyAmount(value string) (int64, error) {
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("invalid amount '%s'", value)
+	}
+
+	// Reject amounts with more than two decimal places rather than
+	// silently rounding them.
+	cents := math.Round(amount * 100)
+	if math.Abs(amount*100-cents) > 1e-6 || math.Abs(cents) >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid amount '%s'", value)
+	}
+
+	return int64(cents), nil
+}
+
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/100, amount%100, m.Currency)
+}
+
+// Field is a custom field on a file, such as the vendor or amount of a
+// bill. Only the value matching the field's type is set.
+type Field struct {
+	Name string
+	Type FieldType
+
+	String string
+	Number float64
+	// Date is the date at midnight UTC.
+	Date  time.Time
+	Money Money
+}
+
+// ParseField creates a field of the given type from the text form of its
+// value.
+func ParseField(name string, typ FieldType, value string) (*Field, error) {
+	if !ValidFieldName(name) {
+		return nil, fmt.Errorf("invalid field name '%s'", name)
+	}
+
+	field := &Field{
+		Name: name,
+		Type: typ,
+	}
+
+	switch typ {
+	case FIELD_TYPE_STRING:
+		field.String = value
+	case FIELD_TYPE_NUMBER:
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("invalid number '%s'", value)
+		}
+		field.Number = number
+	case FIELD_TYPE_DATE:
+		date, err := time.Parse(FieldDateLayout, strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid date '%s', expected YYYY-MM-DD", value)
+		}
+		field.Date = date
+	case FIELD_TYPE_MONEY:
+		money, err := ParseMoney(value)
+		if err != nil {
+			return nil, err
+		}
+		field.Money = money
+	default:
+		return nil, fmt.Errorf("unknown field type %d", typ)
+	}
+
+	return field, nil
+}
+
+// Value returns the text form of the field's value, which can be parsed
+// again with ParseField.
+func (f *Field) Value() string {
+	switch f.Type {
+	case FIELD_TYPE_STRING:
+		return f.String
+	case FIELD_TYPE_NUMBER:
+		return strconv.FormatFloat(f.Number, 'f', -1, 64)
+	case FIELD_TYPE_DATE:
+		return f.Date.Format(FieldDateLayout)
+	case FIELD_TYPE_MONEY:
+		return f.Money.String()
+	default:
+		return ""
+	}
+}
+
+// ValidFieldName checks that the name isn't empty and only contains
+// letters, numbers, underscores and dashes so it can be used in queries.
+func ValidFieldName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -44,14 +44,83 @@ type File struct {
 	Filename     string
 	DocumentDate time.Time
 	Size         uint64
+	Title        string
+	Notes        string
+	// Revision is increased each time the file's attributes are changed,
+	// so updates can check nothing else changed them first.
+	Revision int
+	// Created is when the file was added.
+	Created time.Time
+	// Updated is when the file's attributes, tags, fields or contents
+	// last changed.
+	Updated time.Time
+	// Source describes what added the file, such as FileSourceFuse or the
+	// name of an importer like sftp:default.
+	Source string
+	// OriginalPath is the path the file had in its source, if known.
+	OriginalPath string
+}
+
+// TrashedFile is a file that has been removed but not purged yet.
+type TrashedFile struct {
+	File    *File
+	Deleted time.Time
 }
 
 func (f *File) String() string {
 	return f.DocumentDate.Format("2006-01-02") + "-" + f.Filename
 }
 
+// Sources of files that weren't added by an importer. Importers use their
+// names as the source.
+const (
+	// FileSourceGRPC is a file created through the gRPC API.
+	FileSourceGRPC = "grpc"
+	// FileSourceFuse is a file created through the FUSE filesystem.
+	FileSourceFuse = "fuse"
+)
+
+// Sources of file versions.
+const (
+	// VersionSourceWrite is a version written by opening the file in
+	// FILE_MODE_WRITE.
+	VersionSourceWrite = "write"
+	// VersionSourceRestore is a version created by restoring the contents
+	// of an earlier version.
+	VersionSourceRestore = "restore"
+)
+
+// FileVersion is the contents a file had at some point. Versions are
+// numbered from 1 and the newest version is the file's current contents.
+type FileVersion struct {
+	FileID  uuid.UUID
+	Version int
+	Hash    string
+	Size    uint64
+	Created time.Time
+	// Source describes what created the version, such as
+	// VersionSourceWrite.
+	Source string
+}
+
 type FileMetadata struct {
 	ID       uuid.UUID
 	Hash     string
 	FileSize uint64
+	// StoredSize is the size the contents take up in the files engine,
+	// which is smaller than FileSize when they're compressed.
+	StoredSize uint64
+	// ScrubStatus is what the last check of the contents against their
+	// hash found, which was at Scrubbed. Scrubbed is zero if they haven't
+	// been checked.
+	ScrubStatus ScrubStatus
+	Scrubbed    time.Time
+}
+
+// TempFile is a file opened for writing that hasn't been claimed or
+// dropped yet.
+type TempFile struct {
+	ID       uuid.UUID
+	Size     uint64
+	Modified time.Time
 }
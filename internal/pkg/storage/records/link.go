@@ -0,0 +1,65 @@
+package records
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkType is how the source of a link relates to its target.
+type LinkType int
+
+const (
+	LINK_TYPE_UNKNOWN LinkType = 0
+	// LINK_TYPE_ATTACHMENT_OF links a document, such as a receipt, to
+	// the document it was attached to.
+	LINK_TYPE_ATTACHMENT_OF LinkType = 1
+	// LINK_TYPE_SUPERSEDES links a document, such as a contract
+	// amendment, to the older document it replaces.
+	LINK_TYPE_SUPERSEDES LinkType = 2
+	LINK_TYPE_RELATED_TO LinkType = 3
+)
+
+var linkTypeNames = map[LinkType]string{
+	LINK_TYPE_ATTACHMENT_OF: "attachment-of",
+	LINK_TYPE_SUPERSEDES:    "supersedes",
+	LINK_TYPE_RELATED_TO:    "related-to",
+}
+
+func (lt LinkType) String() string {
+	if name, ok := linkTypeNames[lt]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseLinkType parses the name of a link type, such as attachment-of.
+func ParseLinkType(name string) (LinkType, error) {
+	for lt, ltName := range linkTypeNames {
+		if strings.EqualFold(name, ltName) {
+			return lt, nil
+		}
+	}
+
+	return LINK_TYPE_UNKNOWN, fmt.Errorf("unknown link type '%s'", name)
+}
+
+// FileLink is a typed link between two files, read as "source is a
+// type of target", such as a receipt being an attachment-of an invoice.
+type FileLink struct {
+	SourceID uuid.UUID
+	TargetID uuid.UUID
+	Type     LinkType
+	Created  time.Time
+}
+
+// OtherID returns the id of the file at the other end of the link from
+// the file with the given id.
+func (fl *FileLink) OtherID(id uuid.UUID) uuid.UUID {
+	if fl.SourceID == id {
+		return fl.TargetID
+	}
+	return fl.SourceID
+}
@@ -0,0 +1,42 @@
+package records
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FileSort is the key files are sorted by when listing them. Files with
+// the same key are ordered by ID so pages are stable.
+type FileSort int
+
+const (
+	FILE_SORT_DOCUMENT_DATE FileSort = 0
+	FILE_SORT_FILENAME      FileSort = 1
+	FILE_SORT_SIZE          FileSort = 2
+	FILE_SORT_CREATED       FileSort = 3
+)
+
+var fileSortNames = map[FileSort]string{
+	FILE_SORT_DOCUMENT_DATE: "date",
+	FILE_SORT_FILENAME:      "filename",
+	FILE_SORT_SIZE:          "size",
+	FILE_SORT_CREATED:       "created",
+}
+
+func (fs FileSort) String() string {
+	if name, ok := fileSortNames[fs]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseFileSort parses the name of a sort key, such as filename.
+func ParseFileSort(name string) (FileSort, error) {
+	for fs, fsName := range fileSortNames {
+		if strings.EqualFold(name, fsName) {
+			return fs, nil
+		}
+	}
+
+	return FILE_SORT_DOCUMENT_DATE, fmt.Errorf("unknown sort key '%s'", name)
+}
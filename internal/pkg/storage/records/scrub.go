@@ -0,0 +1,39 @@
+package records
+
+// ScrubStatus is what the last check of stored contents against their
+// hash found.
+type ScrubStatus int
+
+const (
+	// SCRUB_STATUS_UNKNOWN contents haven't been checked yet.
+	SCRUB_STATUS_UNKNOWN ScrubStatus = 0
+	SCRUB_STATUS_OK      ScrubStatus = 1
+	// SCRUB_STATUS_CORRUPT contents couldn't be read or didn't match
+	// their hash.
+	SCRUB_STATUS_CORRUPT ScrubStatus = 2
+	// SCRUB_STATUS_MISSING contents weren't found in the files engine.
+	SCRUB_STATUS_MISSING ScrubStatus = 3
+	// SCRUB_STATUS_REPAIRED contents were corrupt or missing and were
+	// restored from a backup.
+	SCRUB_STATUS_REPAIRED ScrubStatus = 4
+)
+
+var scrubStatusNames = map[ScrubStatus]string{
+	SCRUB_STATUS_OK:       "ok",
+	SCRUB_STATUS_CORRUPT:  "corrupt",
+	SCRUB_STATUS_MISSING:  "missing",
+	SCRUB_STATUS_REPAIRED: "repaired",
+}
+
+func (ss ScrubStatus) String() string {
+	if name, ok := scrubStatusNames[ss]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Damaged returns whether the contents can't be read as they were
+// written.
+func (ss ScrubStatus) Damaged() bool {
+	return ss == SCRUB_STATUS_CORRUPT || ss == SCRUB_STATUS_MISSING
+}
@@ -0,0 +1,7 @@
+package records
+
+type SearchHit struct {
+	File    *File
+	Rank    float64
+	Snippet string
+}
@@ -0,0 +1,39 @@
+package records
+
+// Stats summarizes what's stored. Files in the trash are only counted by
+// TrashedFiles.
+type Stats struct {
+	Files        int
+	TrashedFiles int
+	// InboxFiles is the number of files that are still unfiled.
+	InboxFiles int
+	Tags       int
+
+	// LogicalBytes is the size of every file's contents, counting contents
+	// shared by several files once for each of them.
+	LogicalBytes uint64
+	// StoredContents and StoredBytes are the number and size of the
+	// contents that are stored, each only once and after they're
+	// compressed or encrypted. They include the contents of earlier
+	// versions and files in the trash.
+	StoredContents int
+	StoredBytes    uint64
+
+	// Years are the number of files by document year, oldest first.
+	Years []*YearStats
+}
+
+type YearStats struct {
+	Year  int
+	Files int
+}
+
+// DedupSavings is how many bytes storing identical contents only once
+// saves, along with compressing them. It's zero when earlier versions and
+// the trash take up more space than that saves.
+func (s *Stats) DedupSavings() uint64 {
+	if s.LogicalBytes < s.StoredBytes {
+		return 0
+	}
+	return s.LogicalBytes - s.StoredBytes
+}
@@ -1,6 +1,14 @@
 package records
 
-import "github.com/google/uuid"
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// TagSeparator separates the parts of a hierarchical tag name, such as
+// finance/taxes/2020.
+const TagSeparator = "/"
 
 type TagIterator interface {
 	Tags() <-chan *TagItem
@@ -13,13 +21,65 @@ type TagItem struct {
 }
 
 type Tag struct {
-	ID       uuid.UUID
-	Name     string
+	ID uuid.UUID
+	// Name is the full path of the tag, including the names of its
+	// parents.
+	Name string
+	// ParentID is the ID of the tag's parent or uuid.Nil for top level
+	// tags.
+	ParentID uuid.UUID
 	Category *TagCategory
 	System   bool
 }
 
+// BaseName returns the last part of the tag's name, without its parents.
+func (t *Tag) BaseName() string {
+	return TagBaseName(t.Name)
+}
+
+// TagBaseName returns the last part of a tag name, without its parents.
+func TagBaseName(name string) string {
+	return name[strings.LastIndex(name, TagSeparator)+1:]
+}
+
+// TagParentName returns the name of the tag's parent, or an empty string
+// for top level tags.
+func TagParentName(name string) string {
+	idx := strings.LastIndex(name, TagSeparator)
+	if idx < 0 {
+		return ""
+	}
+	return name[:idx]
+}
+
+// TagAncestors returns the names of all the tag's parents, starting at
+// the top level. finance/taxes/2020 has the ancestors finance and
+// finance/taxes.
+func TagAncestors(name string) []string {
+	var res []string
+	for idx, r := range name {
+		if string(r) == TagSeparator {
+			res = append(res, name[:idx])
+		}
+	}
+	return res
+}
+
+// ValidTagName checks that the name isn't empty and none of the parts of
+// a hierarchical name are empty.
+func ValidTagName(name string) bool {
+	for _, part := range strings.Split(name, TagSeparator) {
+		if strings.TrimSpace(part) == "" {
+			return false
+		}
+	}
+	return true
+}
+
 type TagCategory struct {
 	ID   uuid.UUID
 	Name string
+	// Color is the color tags in the category are shown with, in the
+	// form #rrggbb. Empty uses the default color.
+	Color string
 }
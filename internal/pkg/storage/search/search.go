@@ -0,0 +1,142 @@
+// Package search contains helpers shared by storage engines for full-text
+// searching of document contents.
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// SnippetStart and SnippetEnd surround matched terms in snippets.
+	SnippetStart = "["
+	SnippetEnd   = "]"
+
+	// snippetContext is roughly how many characters of context are
+	// included around the first match in a snippet.
+	snippetContext = 60
+)
+
+// Terms splits a search query into lower case terms. Documents must contain
+// all of the terms to match.
+func Terms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// Score returns how well contents match the terms, or zero if they don't
+// contain all of them. Higher scores are better matches.
+func Score(contents string, terms []string) float64 {
+	if len(terms) < 1 {
+		return 0
+	}
+
+	words := Terms(contents)
+	if len(words) < 1 {
+		return 0
+	}
+
+	counts := map[string]int{}
+	for _, word := range words {
+		counts[word]++
+	}
+
+	score := 0.0
+	for _, term := range terms {
+		count, ok := counts[term]
+		if !ok {
+			return 0
+		}
+
+		score += float64(count)
+	}
+
+	return score / float64(len(words))
+}
+
+type word struct {
+	start int
+	end   int
+	match bool
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// Snippet returns a short piece of contents around the first matching term,
+// with each matched term marked by SnippetStart and SnippetEnd.
+func Snippet(contents string, terms []string) string {
+	termSet := map[string]bool{}
+	for _, term := range terms {
+		termSet[term] = true
+	}
+
+	var words []*word
+	var cur *word
+	for idx, r := range contents {
+		if isWordRune(r) {
+			if cur == nil {
+				cur = &word{start: idx}
+			}
+			continue
+		}
+
+		if cur != nil {
+			cur.end = idx
+			words = append(words, cur)
+			cur = nil
+		}
+	}
+	if cur != nil {
+		cur.end = len(contents)
+		words = append(words, cur)
+	}
+
+	first := -1
+	for idx, w := range words {
+		w.match = termSet[strings.ToLower(contents[w.start:w.end])]
+		if w.match && first < 0 {
+			first = idx
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	startIdx := first
+	for startIdx > 0 && words[first].start-words[startIdx-1].start <= snippetContext {
+		startIdx--
+	}
+	endIdx := first
+	for endIdx < len(words)-1 && words[endIdx+1].end-words[first].end <= snippetContext {
+		endIdx++
+	}
+
+	sb := &strings.Builder{}
+	if startIdx > 0 {
+		sb.WriteString("... ")
+	}
+
+	for idx := startIdx; idx <= endIdx; idx++ {
+		w := words[idx]
+		if idx > startIdx {
+			sb.WriteString(contents[words[idx-1].end:w.start])
+		}
+
+		if w.match {
+			sb.WriteString(SnippetStart)
+			sb.WriteString(contents[w.start:w.end])
+			sb.WriteString(SnippetEnd)
+		} else {
+			sb.WriteString(contents[w.start:w.end])
+		}
+	}
+
+	if endIdx < len(words)-1 {
+		sb.WriteString(" ...")
+	}
+
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
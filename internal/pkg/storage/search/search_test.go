@@ -0,0 +1,45 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, []string{"property", "tax", "2020"}, Terms("Property-Tax, 2020!"))
+	assert.Len(t, Terms("  "), 0)
+}
+
+func TestScore(t *testing.T) {
+	t.Run("all terms required", func(t *testing.T) {
+		assert.Zero(t, Score("property tax statement", []string{"property", "invoice"}))
+		assert.Zero(t, Score("property tax statement", []string{}))
+	})
+	t.Run("more matches score higher", func(t *testing.T) {
+		once := Score("tax statement for the year", []string{"tax"})
+		twice := Score("tax statement for the tax year", []string{"tax"})
+		assert.True(t, twice > once)
+	})
+}
+
+func TestSnippet(t *testing.T) {
+	t.Run("marks matches", func(t *testing.T) {
+		assert.Equal(t,
+			"the [Property] [tax] statement",
+			Snippet("the Property  tax\nstatement", []string{"tax", "property"}),
+		)
+	})
+	t.Run("trims long contents", func(t *testing.T) {
+		contents := strings.Repeat("lorem ipsum ", 50) + "invoice " + strings.Repeat("dolor sit ", 50)
+		snippet := Snippet(contents, []string{"invoice"})
+		assert.True(t, strings.HasPrefix(snippet, "... "))
+		assert.True(t, strings.HasSuffix(snippet, " ..."))
+		assert.Contains(t, snippet, "[invoice]")
+		assert.True(t, len(snippet) < 200)
+	})
+	t.Run("no match", func(t *testing.T) {
+		assert.Equal(t, "", Snippet("property tax", []string{"invoice"}))
+	})
+}
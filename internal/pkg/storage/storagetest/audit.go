@@ -0,0 +1,104 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func runDataAuditTests(t *testing.T, newData DataFactory) {
+	t.Run("add and get audit log", func(t *testing.T) {
+		d := newData(t)
+
+		entries, err := d.GetAuditLog(uuid.Nil, 0)
+		require.NoError(t, err)
+		assert.Len(t, entries, 0)
+
+		fileA := uuid.New()
+		fileB := uuid.New()
+		created := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+		require.NoError(t, d.AddAuditEntry(&records.AuditEntry{
+			Created: created,
+			Actor:   "alice",
+			Action:  records.AuditActionCreateFile,
+			FileID:  fileA,
+			After:   "a.pdf 2020-03-04",
+		}))
+		require.NoError(t, d.AddAuditEntry(&records.AuditEntry{
+			Created: created,
+			Actor:   "bob",
+			Action:  records.AuditActionCreateTags,
+			After:   "receipts",
+		}))
+		require.NoError(t, d.AddAuditEntry(&records.AuditEntry{
+			Created: created,
+			Actor:   "bob",
+			Action:  records.AuditActionUpdateFileDate,
+			FileID:  fileA,
+			Before:  "a.pdf 2020-03-04",
+			After:   "a.pdf 2020-03-05",
+		}))
+		require.NoError(t, d.AddAuditEntry(&records.AuditEntry{
+			Created: created,
+			Actor:   "carol",
+			Action:  records.AuditActionRemoveFile,
+			FileID:  fileB,
+			Before:  "b.pdf 2020-03-04",
+		}))
+
+		entries, err = d.GetAuditLog(uuid.Nil, 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		assert.Equal(t, records.AuditActionRemoveFile, entries[0].Action)
+		assert.Equal(t, records.AuditActionCreateTags, entries[2].Action)
+		assert.Equal(t, uuid.Nil, entries[2].FileID)
+		assert.Equal(t, records.AuditActionCreateFile, entries[3].Action)
+
+		entries, err = d.GetAuditLog(fileA, 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		entry := entries[0]
+		assert.NotZero(t, entry.ID)
+		assert.True(t, created.Equal(entry.Created))
+		assert.Equal(t, "bob", entry.Actor)
+		assert.Equal(t, records.AuditActionUpdateFileDate, entry.Action)
+		assert.Equal(t, fileA, entry.FileID)
+		assert.Equal(t, "a.pdf 2020-03-04", entry.Before)
+		assert.Equal(t, "a.pdf 2020-03-05", entry.After)
+		assert.Equal(t, records.AuditActionCreateFile, entries[1].Action)
+
+		entries, err = d.GetAuditLog(uuid.Nil, 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "carol", entries[0].Actor)
+		assert.Equal(t, records.AuditActionUpdateFileDate, entries[1].Action)
+
+		entries, err = d.GetAuditLog(fileA, 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, records.AuditActionUpdateFileDate, entries[0].Action)
+	})
+	t.Run("audit log is kept after purge", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.AddAuditEntry(&records.AuditEntry{
+			Created: time.Now(),
+			Action:  records.AuditActionPurgeFile,
+			FileID:  id,
+		}))
+		require.NoError(t, d.RemoveFile(id))
+		require.NoError(t, d.PurgeFile(id))
+
+		entries, err := d.GetAuditLog(id, 0)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+}
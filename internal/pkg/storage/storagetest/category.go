@@ -0,0 +1,163 @@
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// tagCategories returns the category of each tag from the iterator, keyed
+// by tag name.
+func tagCategories(t *testing.T, it records.TagIterator) map[string]*records.TagCategory {
+	defer it.Close()
+
+	res := map[string]*records.TagCategory{}
+	for item := range it.Tags() {
+		require.NoError(t, item.Error)
+		res[item.Tag.Name] = item.Tag.Category
+	}
+
+	return res
+}
+
+func runDataCategoryTests(t *testing.T, newData DataFactory) {
+	t.Run("create and get category", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateTagCategory("Business", "#00ff00")
+		require.NoError(t, err)
+
+		category, err := d.GetTagCategory(id)
+		require.NoError(t, err)
+		assert.Equal(t, &records.TagCategory{
+			ID:    id,
+			Name:  "Business",
+			Color: "#00ff00",
+		}, category)
+
+		_, err = d.CreateTagCategory("Business", "#0000ff")
+		assert.Equal(t, scerrors.ErrExists, err)
+
+		_, err = d.GetTagCategory(uuid.New())
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("all categories", func(t *testing.T) {
+		d := newData(t)
+
+		categories, err := d.AllTagCategories()
+		require.NoError(t, err)
+		assert.Len(t, categories, 0)
+
+		for _, name := range []string{"Personal", "Business"} {
+			_, err := d.CreateTagCategory(name, "")
+			require.NoError(t, err)
+		}
+
+		categories, err = d.AllTagCategories()
+		require.NoError(t, err)
+		require.Len(t, categories, 2)
+		assert.Equal(t, "Business", categories[0].Name)
+		assert.Equal(t, "Personal", categories[1].Name)
+	})
+	t.Run("update category", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateTagCategory("Business", "#00ff00")
+		require.NoError(t, err)
+		_, err = d.CreateTagCategory("Personal", "")
+		require.NoError(t, err)
+
+		err = d.UpdateTagCategory(&records.TagCategory{ID: id, Name: "Work", Color: "#ff0000"})
+		require.NoError(t, err)
+
+		category, err := d.GetTagCategory(id)
+		require.NoError(t, err)
+		assert.Equal(t, "Work", category.Name)
+		assert.Equal(t, "#ff0000", category.Color)
+
+		err = d.UpdateTagCategory(&records.TagCategory{ID: id, Name: "Personal"})
+		assert.Equal(t, scerrors.ErrExists, err)
+
+		err = d.UpdateTagCategory(&records.TagCategory{ID: uuid.New(), Name: "Other"})
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("set tag category", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "receipts"})
+		require.NoError(t, err)
+		fileID, err := d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"taxes", "receipts"})
+		require.NoError(t, err)
+
+		catID, err := d.CreateTagCategory("Business", "#00ff00")
+		require.NoError(t, err)
+		require.NoError(t, d.SetTagCategory("taxes", catID))
+
+		tag, err := d.FindTagByName("taxes")
+		require.NoError(t, err)
+		require.NotNil(t, tag.Category)
+		assert.Equal(t, catID, tag.Category.ID)
+		assert.Equal(t, "Business", tag.Category.Name)
+		assert.Equal(t, "#00ff00", tag.Category.Color)
+
+		tags, err := d.GetTags([]string{"taxes"})
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.NotNil(t, tags[0].Category)
+		assert.Equal(t, catID, tags[0].Category.ID)
+
+		// Category changes are reflected on the tag
+		require.NoError(t, d.UpdateTagCategory(&records.TagCategory{
+			ID: catID, Name: "Work", Color: "#ff0000",
+		}))
+
+		it, err := d.AllTags()
+		require.NoError(t, err)
+		categories := tagCategories(t, it)
+		require.NotNil(t, categories["taxes"])
+		assert.Equal(t, "Work", categories["taxes"].Name)
+		assert.Equal(t, "#ff0000", categories["taxes"].Color)
+		assert.Nil(t, categories["receipts"])
+
+		it, err = d.GetTagsForFile(fileID)
+		require.NoError(t, err)
+		categories = tagCategories(t, it)
+		require.NotNil(t, categories["taxes"])
+		assert.Equal(t, catID, categories["taxes"].ID)
+		assert.Nil(t, categories["receipts"])
+
+		require.NoError(t, d.SetTagCategory("taxes", uuid.Nil))
+		tag, err = d.FindTagByName("taxes")
+		require.NoError(t, err)
+		assert.Nil(t, tag.Category)
+
+		assert.Equal(t, scerrors.ErrNotFound, d.SetTagCategory("missing", catID))
+		assert.Equal(t, scerrors.ErrNotFound, d.SetTagCategory("taxes", uuid.New()))
+	})
+	t.Run("remove category", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes"})
+		require.NoError(t, err)
+
+		catID, err := d.CreateTagCategory("Business", "#00ff00")
+		require.NoError(t, err)
+		require.NoError(t, d.SetTagCategory("taxes", catID))
+
+		require.NoError(t, d.RemoveTagCategory(catID))
+
+		_, err = d.GetTagCategory(catID)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		tag, err := d.FindTagByName("taxes")
+		require.NoError(t, err)
+		assert.Nil(t, tag.Category)
+
+		assert.Equal(t, scerrors.ErrNotFound, d.RemoveTagCategory(catID))
+	})
+}
@@ -0,0 +1,1020 @@
+package storagetest
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+}
+
+func tagNames(t *testing.T, it records.TagIterator) []string {
+	defer it.Close()
+
+	names := []string{}
+	for item := range it.Tags() {
+		require.NoError(t, item.Error)
+		names = append(names, item.Tag.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func fileNames(files []*records.File) []string {
+	names := []string{}
+	for _, f := range files {
+		names = append(names, f.Filename)
+	}
+
+	return names
+}
+
+// RunDataTests runs the storage.Data conformance tests against engines
+// created by newData.
+func RunDataTests(t *testing.T, newData DataFactory) {
+	t.Run("files", func(t *testing.T) {
+		runDataFileTests(t, newData)
+	})
+	t.Run("tags", func(t *testing.T) {
+		runDataTagTests(t, newData)
+	})
+	t.Run("categories", func(t *testing.T) {
+		runDataCategoryTests(t, newData)
+	})
+	t.Run("fields", func(t *testing.T) {
+		runDataFieldTests(t, newData)
+	})
+	t.Run("versions", func(t *testing.T) {
+		runDataVersionTests(t, newData)
+	})
+	t.Run("metadata", func(t *testing.T) {
+		runDataMetadataTests(t, newData)
+	})
+	t.Run("search", func(t *testing.T) {
+		runDataSearchTests(t, newData)
+	})
+	t.Run("trash", func(t *testing.T) {
+		runDataTrashTests(t, newData)
+	})
+	t.Run("audit", func(t *testing.T) {
+		runDataAuditTests(t, newData)
+	})
+	t.Run("list", func(t *testing.T) {
+		runDataListTests(t, newData)
+	})
+	t.Run("links", func(t *testing.T) {
+		runDataLinkTests(t, newData)
+	})
+	t.Run("stats", func(t *testing.T) {
+		runDataStatsTests(t, newData)
+	})
+}
+
+func runDataFileTests(t *testing.T, newData DataFactory) {
+	t.Run("create and get file", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, id, f.ID)
+		assert.Equal(t, "receipt.pdf", f.Filename)
+		assert.True(t, date(2020, 3, 4).Equal(f.DocumentDate))
+		assert.Equal(t, "", f.Hash)
+		assert.EqualValues(t, 0, f.Size)
+	})
+	t.Run("create file with id", func(t *testing.T) {
+		d := newData(t)
+
+		id := uuid.New()
+		err := d.CreateFileWithID("receipt.pdf", date(2020, 3, 4), id)
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "receipt.pdf", f.Filename)
+	})
+	t.Run("create file with source", func(t *testing.T) {
+		d := newData(t)
+
+		before := time.Now().Add(-time.Second)
+		id, err := d.CreateFileWithSource(
+			"receipt.pdf", date(2020, 3, 4),
+			"sftp:default", "/scans/receipt.pdf",
+		)
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "receipt.pdf", f.Filename)
+		assert.Equal(t, "sftp:default", f.Source)
+		assert.Equal(t, "/scans/receipt.pdf", f.OriginalPath)
+		assert.True(t, f.Created.After(before))
+		assert.True(t, f.Created.Equal(f.Updated))
+
+		files, err := d.FindFilesWithTags([]string{consts.TagUnfiled})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"receipt.pdf"}, fileNames(files))
+
+		_, err = d.CreateFileWithSource("receipt.pdf", date(2020, 3, 4), "fuse", "")
+		require.NoError(t, err)
+
+		id, err = d.CreateFile("bill.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		f, err = d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "", f.Source)
+		assert.Equal(t, "", f.OriginalPath)
+	})
+	t.Run("changes update the updated time", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		_, err = d.CreateTags([]string{"receipts"})
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		created := f.Created
+		updated := f.Updated
+
+		for _, change := range []func() error{
+			func() error { return d.UpdateFileTags(id, []string{"receipts"}, nil) },
+			func() error { return d.UpdateFileDate(id, "bill.pdf", date(2020, 3, 5)) },
+			func() error { return d.UpdateFileHash(id, "abc123") },
+			func() error {
+				return d.SetFileFields(id, []*records.Field{
+					field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+				}, nil)
+			},
+		} {
+			time.Sleep(10 * time.Millisecond)
+			require.NoError(t, change())
+
+			f, err = d.GetFile(id)
+			require.NoError(t, err)
+			assert.True(t, f.Updated.After(updated))
+			assert.True(t, f.Created.Equal(created))
+			updated = f.Updated
+		}
+	})
+	t.Run("create file with unknown tag", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateFileWithTags("receipt.pdf", date(2020, 3, 4), []string{"missing"})
+		assert.Error(t, err)
+
+		files, err := d.FindFilesWithDate(date(2020, 3, 4))
+		require.NoError(t, err)
+		assert.Len(t, files, 0)
+	})
+	t.Run("get missing file", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.GetFile(uuid.New())
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("get file with date", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		f, err := d.GetFileWithDate("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		assert.Equal(t, id, f.ID)
+
+		_, err = d.GetFileWithDate("receipt.pdf", date(2020, 3, 5))
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		_, err = d.GetFileWithDate("", date(2020, 3, 4))
+		assert.Error(t, err)
+	})
+	t.Run("file dates", func(t *testing.T) {
+		d := newData(t)
+
+		for _, docDate := range []time.Time{
+			date(2019, 12, 31),
+			date(2020, 3, 4),
+			date(2020, 3, 4),
+			date(2020, 3, 20),
+			date(2020, 11, 1),
+		} {
+			_, err := d.CreateFile("file.pdf", docDate)
+			require.NoError(t, err)
+		}
+
+		years, err := d.GetFileYears()
+		require.NoError(t, err)
+		assert.Equal(t, []int{2019, 2020}, years)
+
+		months, err := d.GetFileMonths(2020)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 11}, months)
+
+		days, err := d.GetFileDays(2020, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []int{4, 20}, days)
+
+		days, err = d.GetFileDays(2018, 1)
+		require.NoError(t, err)
+		assert.Len(t, days, 0)
+	})
+	t.Run("all files", func(t *testing.T) {
+		d := newData(t)
+
+		for _, name := range []string{"c.pdf", "a.pdf", "b.pdf"} {
+			_, err := d.CreateFile(name, date(2020, 3, 4))
+			require.NoError(t, err)
+		}
+
+		it, err := d.AllFiles()
+		require.NoError(t, err)
+		defer it.Close()
+
+		names := []string{}
+		for item := range it.Files() {
+			require.NoError(t, item.Error)
+			names = append(names, item.File.Filename)
+		}
+		assert.Equal(t, []string{"a.pdf", "b.pdf", "c.pdf"}, names)
+	})
+	t.Run("all files closed early", func(t *testing.T) {
+		d := newData(t)
+
+		for _, name := range []string{"a.pdf", "b.pdf", "c.pdf"} {
+			_, err := d.CreateFile(name, date(2020, 3, 4))
+			require.NoError(t, err)
+		}
+
+		it, err := d.AllFiles()
+		require.NoError(t, err)
+
+		item := <-it.Files()
+		require.NoError(t, item.Error)
+		assert.Equal(t, "a.pdf", item.File.Filename)
+
+		require.NoError(t, it.Close())
+		require.NoError(t, it.Close())
+
+		// The iterator should stop and close its channel once closed
+		for range it.Files() {
+		}
+	})
+	t.Run("update file hash", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.CreateMetadataWithID("abc123", 1024, uuid.New()))
+		require.NoError(t, d.UpdateFileHash(id, "abc123"))
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", f.Hash)
+		assert.EqualValues(t, 1024, f.Size)
+
+		f, err = d.GetFileByHash("abc123")
+		require.NoError(t, err)
+		assert.Equal(t, id, f.ID)
+		assert.Equal(t, "receipt.pdf", f.Filename)
+		assert.Equal(t, "abc123", f.Hash)
+		assert.EqualValues(t, 1024, f.Size)
+
+		_, err = d.GetFileByHash("def456")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		err = d.UpdateFileHash(uuid.New(), "abc123")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("update file date", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		id, err := d.CreateFile("b.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		err = d.UpdateFileDate(id, "a.pdf", date(2020, 3, 4))
+		assert.Equal(t, scerrors.ErrExists, err)
+
+		err = d.UpdateFileDate(id, "c.pdf", date(2020, 3, 5))
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "c.pdf", f.Filename)
+		assert.True(t, date(2020, 3, 5).Equal(f.DocumentDate))
+
+		err = d.UpdateFileDate(uuid.New(), "d.pdf", date(2020, 3, 5))
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("update file", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.SetFileFields(id, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+		}, nil))
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "", f.Title)
+		assert.Equal(t, "", f.Notes)
+		assert.Equal(t, 2, f.Revision)
+
+		f.Filename = "b.pdf"
+		f.DocumentDate = date(2020, 3, 5)
+		f.Title = "Electric bill"
+		f.Notes = "Paid in full"
+		revision, err := d.UpdateFile(f, []*records.Field{
+			field(t, "amount", records.FIELD_TYPE_MONEY, "12.00 USD"),
+		}, []string{"vendor"})
+		require.NoError(t, err)
+		assert.Equal(t, 3, revision)
+
+		f, err = d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "b.pdf", f.Filename)
+		assert.True(t, date(2020, 3, 5).Equal(f.DocumentDate))
+		assert.Equal(t, "Electric bill", f.Title)
+		assert.Equal(t, "Paid in full", f.Notes)
+		assert.Equal(t, 3, f.Revision)
+
+		fields, err := d.GetFileFields(id)
+		require.NoError(t, err)
+		require.Len(t, fields, 1)
+		assert.Equal(t, "amount", fields[0].Name)
+	})
+	t.Run("update file with stale revision", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		require.NoError(t, d.UpdateFileDate(id, "b.pdf", date(2020, 3, 4)))
+
+		f.Title = "Stale"
+		_, err = d.UpdateFile(f, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+		}, nil)
+		assert.Equal(t, scerrors.ErrStaleRevision, err)
+
+		f, err = d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "b.pdf", f.Filename)
+		assert.Equal(t, "", f.Title)
+
+		fields, err := d.GetFileFields(id)
+		require.NoError(t, err)
+		assert.Len(t, fields, 0)
+	})
+	t.Run("content changes bump the revision", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+
+		_, err = d.AddFileVersion(id, "abc", records.VersionSourceWrite)
+		require.NoError(t, err)
+		require.NoError(t, d.UpdateFileHash(id, "def"))
+
+		updated, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, f.Revision+2, updated.Revision)
+
+		f.Title = "Stale"
+		_, err = d.UpdateFile(f, nil, nil)
+		assert.Equal(t, scerrors.ErrStaleRevision, err)
+	})
+	t.Run("update file conflicts", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		id, err := d.CreateFile("b.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		f.Filename = "a.pdf"
+		_, err = d.UpdateFile(f, nil, nil)
+		assert.Equal(t, scerrors.ErrExists, err)
+
+		_, err = d.UpdateFile(&records.File{
+			ID:           uuid.New(),
+			Filename:     "c.pdf",
+			DocumentDate: date(2020, 3, 4),
+			Revision:     1,
+		}, nil, nil)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		f.Filename = "c.pdf"
+		require.NoError(t, d.RemoveFile(id))
+		_, err = d.UpdateFile(f, nil, nil)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("remove file", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.RemoveFile(id))
+
+		_, err = d.GetFile(id)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		assert.Equal(t, scerrors.ErrNotFound, d.RemoveFile(id))
+
+		files, err := d.FindFilesWithTags([]string{consts.TagUnfiled})
+		require.NoError(t, err)
+		assert.Len(t, files, 0)
+	})
+	t.Run("find files with date", func(t *testing.T) {
+		d := newData(t)
+
+		for _, name := range []string{"b.pdf", "a.pdf"} {
+			_, err := d.CreateFile(name, date(2020, 3, 4))
+			require.NoError(t, err)
+		}
+		_, err := d.CreateFile("c.pdf", date(2020, 3, 5))
+		require.NoError(t, err)
+
+		files, err := d.FindFilesWithDate(date(2020, 3, 4))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"a.pdf", "b.pdf"}, fileNames(files))
+	})
+	t.Run("find files with tags", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes"})
+		require.NoError(t, err)
+
+		id, err := d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"taxes"})
+		require.NoError(t, err)
+		_, err = d.CreateFile("b.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		files, err := d.FindFilesWithTags([]string{"taxes"})
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, id, files[0].ID)
+
+		files, err = d.FindFilesWithTags([]string{})
+		require.NoError(t, err)
+		assert.Len(t, files, 0)
+	})
+	t.Run("find files with all tags", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "2020"})
+		require.NoError(t, err)
+
+		id, err := d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"taxes", "2020"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("b.pdf", date(2020, 3, 4), []string{"taxes"})
+		require.NoError(t, err)
+
+		files, err := d.FindFilesWithTags([]string{"taxes", "2020"})
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, id, files[0].ID)
+
+		files, err = d.FindFilesWithTags([]string{"taxes"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.pdf", "b.pdf"}, fileNames(files))
+	})
+	t.Run("query files", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "2020", "draft"})
+		require.NoError(t, err)
+
+		_, err = d.CreateFileWithTags("Return.pdf", date(2020, 3, 4), []string{"taxes", "2020"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("return-draft.pdf", date(2020, 2, 4), []string{"taxes", "2020", "draft"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("2019_return.pdf", date(2019, 12, 31), []string{"taxes"})
+		require.NoError(t, err)
+		_, err = d.CreateFile("invoice.pdf", time.Date(2020, 4, 1, 1, 0, 0, 0, time.FixedZone("", 3*60*60)))
+		require.NoError(t, err)
+
+		tests := map[string][]string{
+			"taxes AND 2020 NOT draft":       {"Return.pdf"},
+			"taxes OR " + consts.TagUnfiled:  {"2019_return.pdf", "Return.pdf", "invoice.pdf", "return-draft.pdf"},
+			"taxes (draft OR date:2019)":     {"2019_return.pdf", "return-draft.pdf"},
+			"NOT taxes":                      {"invoice.pdf"},
+			"name:RETURN NOT name:draft":     {"2019_return.pdf", "Return.pdf"},
+			"name:_":                         {"2019_return.pdf"},
+			"date:2020-03":                   {"Return.pdf", "invoice.pdf"},
+			"date:2020-02..2020-03":          {"Return.pdf", "invoice.pdf", "return-draft.pdf"},
+			"date:<2020-03-04":               {"2019_return.pdf", "return-draft.pdf"},
+			"date:>2020-03-04 OR date:<2020": {"2019_return.pdf", "invoice.pdf"},
+			"missing":                        {},
+		}
+
+		for q, expected := range tests {
+			node, err := query.Parse(q)
+			require.NoError(t, err, q)
+
+			files, err := d.QueryFiles(node)
+			require.NoError(t, err, q)
+			assert.Equal(t, expected, fileNames(files), q)
+		}
+	})
+	t.Run("find files with id prefix", func(t *testing.T) {
+		d := newData(t)
+
+		id := uuid.Must(uuid.Parse("abcdef00-0000-0000-0000-000000000001"))
+		require.NoError(t, d.CreateFileWithID("a.pdf", date(2020, 3, 4), id))
+		require.NoError(t, d.CreateFileWithID(
+			"b.pdf", date(2020, 3, 4),
+			uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000002")),
+		))
+
+		files, err := d.FindFilesWithIdPrefix("abcd")
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, id, files[0].ID)
+		assert.Equal(t, "a.pdf", files[0].Filename)
+	})
+}
+
+func runDataTagTests(t *testing.T, newData DataFactory) {
+	t.Run("unfiled system tag exists", func(t *testing.T) {
+		d := newData(t)
+
+		tag, err := d.FindTagByName(consts.TagUnfiled)
+		require.NoError(t, err)
+		assert.True(t, tag.System)
+
+		it, err := d.AllTags()
+		require.NoError(t, err)
+		assert.Equal(t, []string{consts.TagUnfiled}, tagNames(t, it))
+	})
+	t.Run("new files are unfiled", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		it, err := d.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{consts.TagUnfiled}, tagNames(t, it))
+	})
+	t.Run("create tags", func(t *testing.T) {
+		d := newData(t)
+
+		ids, err := d.CreateTags([]string{"taxes", "2020"})
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+
+		again, err := d.CreateTags([]string{"2020", "taxes"})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{ids[1], ids[0]}, again)
+
+		tag, err := d.FindTagByName("taxes")
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], tag.ID)
+		assert.False(t, tag.System)
+
+		it, err := d.AllTags()
+		require.NoError(t, err)
+		assert.Equal(t,
+			[]string{"2020", "taxes", consts.TagUnfiled},
+			tagNames(t, it),
+		)
+	})
+	t.Run("find missing tag", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.FindTagByName("missing")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("get tags", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "2020"})
+		require.NoError(t, err)
+
+		tags, err := d.GetTags([]string{"taxes", "2020"})
+		require.NoError(t, err)
+		assert.Len(t, tags, 2)
+
+		tags, err = d.GetTags([]string{})
+		require.NoError(t, err)
+		assert.Len(t, tags, 0)
+
+		_, err = d.GetTags([]string{"taxes", "missing"})
+		assert.Error(t, err)
+	})
+	t.Run("update file tags", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "2020"})
+		require.NoError(t, err)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		err = d.UpdateFileTags(id, []string{"taxes", "2020"}, []string{consts.TagUnfiled})
+		require.NoError(t, err)
+
+		it, err := d.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2020", "taxes"}, tagNames(t, it))
+
+		// Adding a tag the file already has is not an error
+		err = d.UpdateFileTags(id, []string{"taxes"}, []string{"2020"})
+		require.NoError(t, err)
+
+		it, err = d.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"taxes"}, tagNames(t, it))
+
+		err = d.UpdateFileTags(id, []string{"missing"}, nil)
+		assert.Error(t, err)
+	})
+	t.Run("rename tag", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "bills"})
+		require.NoError(t, err)
+		id, err := d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"taxes"})
+		require.NoError(t, err)
+
+		require.NoError(t, d.RenameTag("taxes", "tax-returns"))
+
+		_, err = d.FindTagByName("taxes")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		it, err := d.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"tax-returns"}, tagNames(t, it))
+
+		assert.Equal(t, scerrors.ErrExists, d.RenameTag("tax-returns", "bills"))
+		assert.Equal(t, scerrors.ErrNotFound, d.RenameTag("missing", "other"))
+		assert.Equal(t, scerrors.ErrNotPermitted, d.RenameTag(consts.TagUnfiled, "inbox"))
+	})
+	t.Run("merge tags", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"insurance", "Insurance"})
+		require.NoError(t, err)
+		both, err := d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"insurance", "Insurance"})
+		require.NoError(t, err)
+		upper, err := d.CreateFileWithTags("b.pdf", date(2020, 3, 4), []string{"Insurance"})
+		require.NoError(t, err)
+
+		require.NoError(t, d.MergeTags("Insurance", "insurance"))
+
+		_, err = d.FindTagByName("Insurance")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		for _, id := range []uuid.UUID{both, upper} {
+			it, err := d.GetTagsForFile(id)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"insurance"}, tagNames(t, it))
+		}
+
+		files, err := d.FindFilesWithTags([]string{"insurance"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.pdf", "b.pdf"}, fileNames(files))
+
+		assert.Equal(t, scerrors.ErrNotFound, d.MergeTags("missing", "insurance"))
+		assert.Equal(t, scerrors.ErrNotFound, d.MergeTags("insurance", "missing"))
+		assert.Equal(t, scerrors.ErrNotPermitted, d.MergeTags(consts.TagUnfiled, "insurance"))
+		assert.Equal(t, scerrors.ErrNotPermitted, d.MergeTags("insurance", consts.TagUnfiled))
+	})
+	t.Run("delete tag", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "unused"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"taxes"})
+		require.NoError(t, err)
+
+		require.NoError(t, d.DeleteTag("unused"))
+		_, err = d.FindTagByName("unused")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		assert.Equal(t, scerrors.ErrInUse, d.DeleteTag("taxes"))
+		assert.Equal(t, scerrors.ErrNotFound, d.DeleteTag("unused"))
+		assert.Equal(t, scerrors.ErrNotPermitted, d.DeleteTag(consts.TagUnfiled))
+	})
+	t.Run("hierarchical tags", func(t *testing.T) {
+		d := newData(t)
+
+		ids, err := d.CreateTags([]string{"finance/taxes/2020"})
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		tag, err := d.FindTagByName("finance/taxes/2020")
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], tag.ID)
+		assert.Equal(t, "2020", tag.BaseName())
+
+		parent, err := d.FindTagByName("finance/taxes")
+		require.NoError(t, err)
+		assert.Equal(t, parent.ID, tag.ParentID)
+
+		root, err := d.FindTagByName("finance")
+		require.NoError(t, err)
+		assert.Equal(t, root.ID, parent.ParentID)
+		assert.Equal(t, uuid.Nil, root.ParentID)
+
+		again, err := d.CreateTags([]string{"finance/taxes/2021"})
+		require.NoError(t, err)
+		tag, err = d.FindTagByName("finance/taxes/2021")
+		require.NoError(t, err)
+		assert.Equal(t, again[0], tag.ID)
+		assert.Equal(t, parent.ID, tag.ParentID)
+
+		it, err := d.AllTags()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"finance", "finance/taxes", "finance/taxes/2020", "finance/taxes/2021", consts.TagUnfiled,
+		}, tagNames(t, it))
+	})
+	t.Run("find files with descendant tags", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"finance/taxes/2020", "finance/bills", "financial"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"finance/taxes/2020"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("b.pdf", date(2020, 3, 4), []string{"finance/bills"})
+		require.NoError(t, err)
+		_, err = d.CreateFileWithTags("c.pdf", date(2020, 3, 4), []string{"financial"})
+		require.NoError(t, err)
+
+		files, err := d.FindFilesWithTags([]string{"finance"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.pdf", "b.pdf"}, fileNames(files))
+
+		files, err = d.FindFilesWithTags([]string{"finance/taxes"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.pdf"}, fileNames(files))
+
+		files, err = d.FindFilesWithTags([]string{"finance", "finance/bills"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b.pdf"}, fileNames(files))
+
+		node, err := query.Parse("finance NOT finance/taxes")
+		require.NoError(t, err)
+		files, err = d.QueryFiles(node)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b.pdf"}, fileNames(files))
+	})
+	t.Run("rename hierarchical tag", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"finance/taxes/2020", "finance/taxes/2021"})
+		require.NoError(t, err)
+		id, err := d.CreateFileWithTags("a.pdf", date(2020, 3, 4), []string{"finance/taxes/2020"})
+		require.NoError(t, err)
+
+		require.NoError(t, d.RenameTag("finance/taxes", "personal/tax-returns"))
+
+		it, err := d.AllTags()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"finance", "personal", "personal/tax-returns",
+			"personal/tax-returns/2020", "personal/tax-returns/2021", consts.TagUnfiled,
+		}, tagNames(t, it))
+
+		moved, err := d.FindTagByName("personal/tax-returns")
+		require.NoError(t, err)
+		personal, err := d.FindTagByName("personal")
+		require.NoError(t, err)
+		assert.Equal(t, personal.ID, moved.ParentID)
+
+		child, err := d.FindTagByName("personal/tax-returns/2020")
+		require.NoError(t, err)
+		assert.Equal(t, moved.ID, child.ParentID)
+
+		it, err = d.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"personal/tax-returns/2020"}, tagNames(t, it))
+
+		files, err := d.FindFilesWithTags([]string{"personal"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.pdf"}, fileNames(files))
+
+		assert.Equal(t, scerrors.ErrNotPermitted, d.RenameTag("personal", "personal/other"))
+	})
+	t.Run("tags with children", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"finance/taxes", "other"})
+		require.NoError(t, err)
+
+		assert.Equal(t, scerrors.ErrInUse, d.DeleteTag("finance"))
+		assert.Equal(t, scerrors.ErrInUse, d.MergeTags("finance", "other"))
+
+		require.NoError(t, d.DeleteTag("finance/taxes"))
+		require.NoError(t, d.DeleteTag("finance"))
+	})
+}
+
+func runDataMetadataTests(t *testing.T, newData DataFactory) {
+	t.Run("create and find metadata", func(t *testing.T) {
+		d := newData(t)
+
+		id := uuid.New()
+		require.NoError(t, d.CreateMetadataWithID("abc123", 1024, id))
+
+		md, err := d.FindMetadataByHash("abc123")
+		require.NoError(t, err)
+		assert.Equal(t, id, md.ID)
+		assert.Equal(t, "abc123", md.Hash)
+		assert.EqualValues(t, 1024, md.FileSize)
+		assert.EqualValues(t, 1024, md.StoredSize)
+	})
+	t.Run("create metadata with stored size", func(t *testing.T) {
+		d := newData(t)
+
+		id := uuid.New()
+		require.NoError(t, d.CreateMetadataWithStoredSize("abc123", 1024, 300, id))
+
+		md, err := d.FindMetadataByHash("abc123")
+		require.NoError(t, err)
+		assert.Equal(t, id, md.ID)
+		assert.EqualValues(t, 1024, md.FileSize)
+		assert.EqualValues(t, 300, md.StoredSize)
+
+		mds, err := d.AllMetadata()
+		require.NoError(t, err)
+		require.Len(t, mds, 1)
+		assert.EqualValues(t, 300, mds[0].StoredSize)
+
+		// Files still report the size of their contents
+		fileID, err := d.CreateFile("test.pdf", time.Now())
+		require.NoError(t, err)
+		require.NoError(t, d.UpdateFileHash(fileID, "abc123"))
+		f, err := d.GetFile(fileID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1024, f.Size)
+	})
+	t.Run("set metadata scrub result", func(t *testing.T) {
+		d := newData(t)
+
+		id := uuid.New()
+		require.NoError(t, d.CreateMetadataWithID("abc123", 1024, id))
+
+		md, err := d.FindMetadataByHash("abc123")
+		require.NoError(t, err)
+		assert.Equal(t, records.SCRUB_STATUS_UNKNOWN, md.ScrubStatus)
+		assert.True(t, md.Scrubbed.IsZero())
+
+		scrubbed := time.Date(2020, 9, 1, 12, 30, 0, 0, time.UTC)
+		require.NoError(t, d.SetMetadataScrubResult(id, records.SCRUB_STATUS_CORRUPT, scrubbed))
+
+		md, err = d.FindMetadataByHash("abc123")
+		require.NoError(t, err)
+		assert.Equal(t, records.SCRUB_STATUS_CORRUPT, md.ScrubStatus)
+		assert.True(t, scrubbed.Equal(md.Scrubbed))
+
+		mds, err := d.AllMetadata()
+		require.NoError(t, err)
+		require.Len(t, mds, 1)
+		assert.Equal(t, records.SCRUB_STATUS_CORRUPT, mds[0].ScrubStatus)
+
+		assert.Equal(
+			t,
+			scerrors.ErrNotFound,
+			d.SetMetadataScrubResult(uuid.New(), records.SCRUB_STATUS_OK, scrubbed),
+		)
+	})
+	t.Run("find missing metadata", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.FindMetadataByHash("abc123")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("duplicate hash", func(t *testing.T) {
+		d := newData(t)
+
+		require.NoError(t, d.CreateMetadataWithID("abc123", 1024, uuid.New()))
+		assert.Error(t, d.CreateMetadataWithID("abc123", 1024, uuid.New()))
+	})
+	t.Run("all metadata", func(t *testing.T) {
+		d := newData(t)
+
+		require.NoError(t, d.CreateMetadataWithID("def456", 20, uuid.New()))
+		require.NoError(t, d.CreateMetadataWithID("abc123", 10, uuid.New()))
+
+		mds, err := d.AllMetadata()
+		require.NoError(t, err)
+		require.Len(t, mds, 2)
+		assert.Equal(t, "abc123", mds[0].Hash)
+		assert.Equal(t, "def456", mds[1].Hash)
+	})
+	t.Run("find orphaned metadata", func(t *testing.T) {
+		d := newData(t)
+
+		current := createWithContents(t, d, "current.pdf", "current", "current")
+		trashed := createWithContents(t, d, "trashed.pdf", "trashed", "trashed")
+		require.NoError(t, d.RemoveFile(trashed))
+
+		// An earlier version's contents are kept so it can be restored.
+		_, err := d.AddFileVersion(current, "old", records.VersionSourceWrite)
+		require.NoError(t, err)
+		_, err = d.AddFileVersion(current, "current", records.VersionSourceWrite)
+		require.NoError(t, err)
+		require.NoError(t, d.CreateMetadataWithID("old", 3, uuid.New()))
+
+		orphanID := uuid.New()
+		require.NoError(t, d.CreateMetadataWithID("orphan", 6, orphanID))
+
+		mds, err := d.FindOrphanedMetadata()
+		require.NoError(t, err)
+		require.Len(t, mds, 1)
+		assert.Equal(t, orphanID, mds[0].ID)
+		assert.Equal(t, "orphan", mds[0].Hash)
+		assert.EqualValues(t, 6, mds[0].FileSize)
+
+		require.NoError(t, d.PurgeFile(trashed))
+
+		mds, err = d.FindOrphanedMetadata()
+		require.NoError(t, err)
+		require.Len(t, mds, 2)
+		assert.Equal(t, "orphan", mds[0].Hash)
+		assert.Equal(t, "trashed", mds[1].Hash)
+	})
+	t.Run("remove metadata", func(t *testing.T) {
+		d := newData(t)
+
+		createWithContents(t, d, "a.pdf", "used", "used contents")
+		usedMD, err := d.FindMetadataByHash("used")
+		require.NoError(t, err)
+		assert.Equal(t, scerrors.ErrInUse, d.RemoveMetadata(usedMD.ID))
+
+		orphanID := uuid.New()
+		require.NoError(t, d.CreateMetadataWithID("orphan", 6, orphanID))
+		require.NoError(t, d.SetFileContents("orphan", "orphan contents"))
+		require.NoError(t, d.RemoveMetadata(orphanID))
+		assert.Equal(t, scerrors.ErrNotFound, d.RemoveMetadata(orphanID))
+
+		_, err = d.FindMetadataByHash("orphan")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		// The contents can be indexed again if the same contents
+		// are stored later.
+		require.NoError(t, d.CreateMetadataWithID("orphan", 6, uuid.New()))
+		require.NoError(t, d.SetFileContents("orphan", "orphan contents"))
+	})
+	t.Run("find duplicates", func(t *testing.T) {
+		d := newData(t)
+
+		groups, err := d.FindDuplicates()
+		require.NoError(t, err)
+		assert.Len(t, groups, 0)
+
+		firstID := createWithContents(t, d, "a.pdf", "dup", "duplicate contents")
+		secondID := createWithContents(t, d, "b.pdf", "dup", "duplicate contents")
+		trashedID := createWithContents(t, d, "c.pdf", "dup", "duplicate contents")
+		require.NoError(t, d.RemoveFile(trashedID))
+		createWithContents(t, d, "d.pdf", "unique", "unique contents")
+		createWithContents(t, d, "e.pdf", "alone", "alone contents")
+		aloneTrashedID := createWithContents(t, d, "f.pdf", "alone", "alone contents")
+		require.NoError(t, d.RemoveFile(aloneTrashedID))
+		_, err = d.CreateFile("g.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		_, err = d.CreateFile("h.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		groups, err = d.FindDuplicates()
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		assert.Equal(t, "dup", groups[0].Hash)
+		require.Len(t, groups[0].Files, 2)
+		assert.ElementsMatch(
+			t,
+			[]uuid.UUID{firstID, secondID},
+			[]uuid.UUID{groups[0].Files[0].ID, groups[0].Files[1].ID},
+		)
+		assert.EqualValues(t, len("duplicate contents"), groups[0].Files[0].Size)
+	})
+}
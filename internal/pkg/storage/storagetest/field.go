@@ -0,0 +1,163 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func field(t *testing.T, name string, typ records.FieldType, value string) *records.Field {
+	f, err := records.ParseField(name, typ, value)
+	require.NoError(t, err)
+	return f
+}
+
+func runDataFieldTests(t *testing.T, newData DataFactory) {
+	t.Run("set and get fields", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("bill.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		fields, err := d.GetFileFields(id)
+		require.NoError(t, err)
+		assert.Len(t, fields, 0)
+
+		err = d.SetFileFields(id, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+			field(t, "pages", records.FIELD_TYPE_NUMBER, "2.5"),
+			field(t, "due", records.FIELD_TYPE_DATE, "2020-04-01"),
+			field(t, "amount", records.FIELD_TYPE_MONEY, "120.50 USD"),
+		}, nil)
+		require.NoError(t, err)
+
+		fields, err = d.GetFileFields(id)
+		require.NoError(t, err)
+		require.Len(t, fields, 4)
+
+		assert.Equal(t, "amount", fields[0].Name)
+		assert.Equal(t, records.FIELD_TYPE_MONEY, fields[0].Type)
+		assert.Equal(t, records.Money{Amount: 12050, Currency: "USD"}, fields[0].Money)
+		assert.Equal(t, "due", fields[1].Name)
+		assert.Equal(t, records.FIELD_TYPE_DATE, fields[1].Type)
+		assert.True(t, time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC).Equal(fields[1].Date))
+		assert.Equal(t, "pages", fields[2].Name)
+		assert.Equal(t, records.FIELD_TYPE_NUMBER, fields[2].Type)
+		assert.Equal(t, 2.5, fields[2].Number)
+		assert.Equal(t, "vendor", fields[3].Name)
+		assert.Equal(t, records.FIELD_TYPE_STRING, fields[3].Type)
+		assert.Equal(t, "Acme", fields[3].String)
+	})
+	t.Run("replace and remove fields", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("bill.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		err = d.SetFileFields(id, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+			field(t, "amount", records.FIELD_TYPE_MONEY, "120.50 USD"),
+		}, nil)
+		require.NoError(t, err)
+
+		err = d.SetFileFields(id, []*records.Field{
+			field(t, "amount", records.FIELD_TYPE_NUMBER, "7"),
+		}, []string{"vendor", "missing"})
+		require.NoError(t, err)
+
+		fields, err := d.GetFileFields(id)
+		require.NoError(t, err)
+		require.Len(t, fields, 1)
+		assert.Equal(t, "amount", fields[0].Name)
+		assert.Equal(t, records.FIELD_TYPE_NUMBER, fields[0].Type)
+		assert.Equal(t, 7.0, fields[0].Number)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.GetFileFields(uuid.New())
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		err = d.SetFileFields(uuid.New(), []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+		}, nil)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("remove file with fields", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("bill.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		err = d.SetFileFields(id, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme"),
+		}, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, d.RemoveFile(id))
+
+		_, err = d.GetFileFields(id)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("query fields", func(t *testing.T) {
+		d := newData(t)
+
+		power, err := d.CreateFile("power.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.SetFileFields(power, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "Acme Power"),
+			field(t, "amount", records.FIELD_TYPE_MONEY, "120.50 USD"),
+			field(t, "due", records.FIELD_TYPE_DATE, "2020-04-01"),
+		}, nil))
+
+		water, err := d.CreateFile("water.pdf", date(2020, 3, 5))
+		require.NoError(t, err)
+		require.NoError(t, d.SetFileFields(water, []*records.Field{
+			field(t, "vendor", records.FIELD_TYPE_STRING, "City Water"),
+			field(t, "amount", records.FIELD_TYPE_MONEY, "40 EUR"),
+			field(t, "due", records.FIELD_TYPE_DATE, "2020-03-15"),
+		}, nil))
+
+		report, err := d.CreateFile("report.pdf", date(2020, 3, 6))
+		require.NoError(t, err)
+		require.NoError(t, d.SetFileFields(report, []*records.Field{
+			field(t, "amount", records.FIELD_TYPE_NUMBER, "300"),
+			field(t, "vendor", records.FIELD_TYPE_STRING, "200"),
+		}, nil))
+
+		_, err = d.CreateFile("letter.pdf", date(2020, 3, 7))
+		require.NoError(t, err)
+
+		tests := map[string][]string{
+			"field:vendor":                  {"power.pdf", "report.pdf", "water.pdf"},
+			"NOT field:vendor":              {"letter.pdf"},
+			`field:vendor="acme power"`:     {"power.pdf"},
+			"field:vendor<b":                {"power.pdf", "report.pdf"},
+			"field:amount>100":              {"power.pdf", "report.pdf"},
+			`field:amount<"100 usd"`:        {},
+			`field:amount>="40 EUR"`:        {"water.pdf"},
+			"field:amount=120.5":            {"power.pdf"},
+			"field:due=2020-03":             {"water.pdf"},
+			"field:due>2020-03-15":          {"power.pdf"},
+			"field:due<=2020-04":            {"power.pdf", "water.pdf"},
+			"field:due<2020-03-15":          {},
+			"field:vendor=200 name:report":  {"report.pdf"},
+			"field:amount>100 date:2020-03": {"power.pdf", "report.pdf"},
+		}
+
+		for q, expected := range tests {
+			node, err := query.Parse(q)
+			require.NoError(t, err, q)
+
+			files, err := d.QueryFiles(node)
+			require.NoError(t, err, q)
+			assert.Equal(t, expected, fileNames(files), q)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package storagetest
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+)
+
+func writeTemp(t *testing.T, f storage.File, data string) storage.OpenFile {
+	of, err := f.OpenTempFile(uuid.New())
+	require.NoError(t, err)
+
+	n, err := of.Write([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.NoError(t, of.Flush())
+
+	return of
+}
+
+// RunFileTests runs the storage.File conformance tests against engines
+// created by newFile.
+func RunFileTests(t *testing.T, newFile FileFactory) {
+	t.Run("claim and open", func(t *testing.T) {
+		f := newFile(t)
+
+		id := uuid.New()
+		of := writeTemp(t, f, "hello world")
+		require.NoError(t, of.Claim(id))
+
+		of, err := f.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+	})
+	t.Run("seek while reading", func(t *testing.T) {
+		f := newFile(t)
+
+		id := uuid.New()
+		require.NoError(t, writeTemp(t, f, "hello world").Claim(id))
+
+		of, err := f.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		pos, err := of.Seek(6, io.SeekStart)
+		require.NoError(t, err)
+		assert.EqualValues(t, 6, pos)
+
+		buf := make([]byte, 3)
+		_, err = io.ReadFull(of, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "wor", string(buf))
+
+		pos, err = of.Seek(-5, io.SeekEnd)
+		require.NoError(t, err)
+		assert.EqualValues(t, 6, pos)
+
+		pos, err = of.Seek(1, io.SeekCurrent)
+		require.NoError(t, err)
+		assert.EqualValues(t, 7, pos)
+
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		assert.Equal(t, "orld", string(data))
+	})
+	t.Run("seek while writing", func(t *testing.T) {
+		f := newFile(t)
+
+		id := uuid.New()
+		of := writeTemp(t, f, "hello world")
+		_, err := of.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("HELLO"))
+		require.NoError(t, err)
+		require.NoError(t, of.Claim(id))
+
+		of, err = f.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		assert.Equal(t, "HELLO world", string(data))
+	})
+	t.Run("claim replaces", func(t *testing.T) {
+		f := newFile(t)
+
+		id := uuid.New()
+		require.NoError(t, writeTemp(t, f, "hello world").Claim(id))
+		require.NoError(t, writeTemp(t, f, "goodbye").Claim(id))
+
+		of, err := f.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		data, err := ioutil.ReadAll(of)
+		require.NoError(t, err)
+		assert.Equal(t, "goodbye", string(data))
+
+		blobs, err := f.ListBlobs()
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{id}, blobs)
+	})
+	t.Run("drop", func(t *testing.T) {
+		f := newFile(t)
+
+		of := writeTemp(t, f, "hello world")
+		require.NoError(t, of.Drop())
+	})
+	t.Run("open missing file", func(t *testing.T) {
+		f := newFile(t)
+
+		_, err := f.OpenFile(uuid.New())
+		assert.Error(t, err)
+	})
+	t.Run("invalid mode actions", func(t *testing.T) {
+		f := newFile(t)
+
+		of := writeTemp(t, f, "hello world")
+		_, err := of.Read(make([]byte, 5))
+		assert.Equal(t, scerrors.ErrInvalidModeAction, err)
+
+		id := uuid.New()
+		require.NoError(t, of.Claim(id))
+
+		of, err = f.OpenFile(id)
+		require.NoError(t, err)
+		defer of.Close()
+
+		_, err = of.Write([]byte("hello"))
+		assert.Equal(t, scerrors.ErrInvalidModeAction, err)
+		assert.Equal(t, scerrors.ErrInvalidModeAction, of.Claim(uuid.New()))
+		assert.Equal(t, scerrors.ErrInvalidModeAction, of.Drop())
+	})
+	t.Run("read file", func(t *testing.T) {
+		f := newFile(t)
+
+		id := uuid.New()
+		require.NoError(t, writeTemp(t, f, "hello world").Claim(id))
+
+		r, err := f.ReadFile(id)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "hello world", string(data))
+
+		r, err = f.ReadFileFromOffset(id, 6)
+		require.NoError(t, err)
+		data, err = ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "world", string(data))
+
+		_, err = f.ReadFile(uuid.New())
+		assert.Error(t, err)
+	})
+	t.Run("list and remove blobs", func(t *testing.T) {
+		f := newFile(t)
+
+		blobs, err := f.ListBlobs()
+		require.NoError(t, err)
+		assert.Len(t, blobs, 0)
+
+		idA := uuid.New()
+		idB := uuid.New()
+		require.NoError(t, writeTemp(t, f, "hello").Claim(idA))
+		require.NoError(t, writeTemp(t, f, "world").Claim(idB))
+
+		blobs, err = f.ListBlobs()
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{idA, idB}, blobs)
+
+		require.NoError(t, f.RemoveBlob(idA))
+		assert.Equal(t, scerrors.ErrNotFound, f.RemoveBlob(idA))
+
+		blobs, err = f.ListBlobs()
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{idB}, blobs)
+
+		_, err = f.OpenFile(idA)
+		assert.Error(t, err)
+	})
+	t.Run("list and remove temp files", func(t *testing.T) {
+		f := newFile(t)
+
+		temps, err := f.ListTempFiles()
+		require.NoError(t, err)
+		assert.Len(t, temps, 0)
+
+		id := uuid.New()
+		of, err := f.OpenTempFile(id)
+		require.NoError(t, err)
+		_, err = of.Write([]byte("hello"))
+		require.NoError(t, err)
+		require.NoError(t, of.Flush())
+
+		claimed := writeTemp(t, f, "claimed")
+		require.NoError(t, claimed.Claim(uuid.New()))
+		dropped := writeTemp(t, f, "dropped")
+		require.NoError(t, dropped.Drop())
+
+		temps, err = f.ListTempFiles()
+		require.NoError(t, err)
+		require.Len(t, temps, 1)
+		assert.Equal(t, id, temps[0].ID)
+		assert.EqualValues(t, 5, temps[0].Size)
+		assert.False(t, temps[0].Modified.IsZero())
+
+		require.NoError(t, f.RemoveTempFile(id))
+		assert.Equal(t, scerrors.ErrNotFound, f.RemoveTempFile(id))
+
+		temps, err = f.ListTempFiles()
+		require.NoError(t, err)
+		assert.Len(t, temps, 0)
+
+		blobs, err := f.ListBlobs()
+		require.NoError(t, err)
+		assert.Len(t, blobs, 1)
+	})
+}
@@ -0,0 +1,112 @@
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func runDataLinkTests(t *testing.T, newData DataFactory) {
+	// newLinkData creates an invoice, a receipt and a contract.
+	newLinkData := func(t *testing.T) (storage.Data, uuid.UUID, uuid.UUID, uuid.UUID) {
+		d := newData(t)
+
+		invoiceID, err := d.CreateFile("invoice.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		receiptID, err := d.CreateFile("receipt.pdf", date(2020, 3, 5))
+		require.NoError(t, err)
+		contractID, err := d.CreateFile("contract.pdf", date(2020, 1, 2))
+		require.NoError(t, err)
+
+		return d, invoiceID, receiptID, contractID
+	}
+
+	t.Run("create and get links", func(t *testing.T) {
+		d, invoiceID, receiptID, contractID := newLinkData(t)
+
+		require.NoError(t, d.CreateFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF))
+		require.NoError(t, d.CreateFileLink(invoiceID, contractID, records.LINK_TYPE_RELATED_TO))
+
+		links, err := d.GetFileLinks(invoiceID)
+		require.NoError(t, err)
+		require.Len(t, links, 2)
+		assert.Equal(t, receiptID, links[0].SourceID)
+		assert.Equal(t, invoiceID, links[0].TargetID)
+		assert.Equal(t, records.LINK_TYPE_ATTACHMENT_OF, links[0].Type)
+		assert.False(t, links[0].Created.IsZero())
+		assert.Equal(t, invoiceID, links[1].SourceID)
+		assert.Equal(t, contractID, links[1].TargetID)
+		assert.Equal(t, records.LINK_TYPE_RELATED_TO, links[1].Type)
+
+		links, err = d.GetFileLinks(receiptID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, invoiceID, links[0].OtherID(receiptID))
+	})
+	t.Run("duplicate link", func(t *testing.T) {
+		d, invoiceID, receiptID, _ := newLinkData(t)
+
+		require.NoError(t, d.CreateFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF))
+		err := d.CreateFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF)
+		assert.Equal(t, scerrors.ErrExists, err)
+
+		// The same files can be linked with a different type
+		require.NoError(t, d.CreateFileLink(receiptID, invoiceID, records.LINK_TYPE_RELATED_TO))
+	})
+	t.Run("missing files", func(t *testing.T) {
+		d, invoiceID, _, _ := newLinkData(t)
+
+		err := d.CreateFileLink(invoiceID, uuid.New(), records.LINK_TYPE_RELATED_TO)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		err = d.CreateFileLink(uuid.New(), invoiceID, records.LINK_TYPE_RELATED_TO)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		_, err = d.GetFileLinks(uuid.New())
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("remove link", func(t *testing.T) {
+		d, invoiceID, receiptID, _ := newLinkData(t)
+
+		require.NoError(t, d.CreateFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF))
+
+		err := d.RemoveFileLink(invoiceID, receiptID, records.LINK_TYPE_ATTACHMENT_OF)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		require.NoError(t, d.RemoveFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF))
+
+		links, err := d.GetFileLinks(invoiceID)
+		require.NoError(t, err)
+		assert.Len(t, links, 0)
+	})
+	t.Run("links to trashed files", func(t *testing.T) {
+		d, invoiceID, receiptID, contractID := newLinkData(t)
+
+		require.NoError(t, d.CreateFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF))
+		require.NoError(t, d.CreateFileLink(contractID, invoiceID, records.LINK_TYPE_RELATED_TO))
+
+		require.NoError(t, d.RemoveFile(receiptID))
+		links, err := d.GetFileLinks(invoiceID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, contractID, links[0].SourceID)
+
+		require.NoError(t, d.RestoreFile(receiptID))
+		links, err = d.GetFileLinks(invoiceID)
+		require.NoError(t, err)
+		assert.Len(t, links, 2)
+
+		require.NoError(t, d.RemoveFile(receiptID))
+		require.NoError(t, d.PurgeFile(receiptID))
+		err = d.RemoveFileLink(receiptID, invoiceID, records.LINK_TYPE_ATTACHMENT_OF)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		links, err = d.GetFileLinks(invoiceID)
+		require.NoError(t, err)
+		assert.Len(t, links, 1)
+	})
+}
@@ -0,0 +1,175 @@
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+// listAllPages lists every page of files with the options, checking each
+// page reports the same total, and returns the names of the files in order.
+func listAllPages(t *testing.T, d storage.Data, opts storage.ListFilesOptions) []string {
+	names := []string{}
+	total := -1
+	for {
+		page, err := d.ListFiles(&opts)
+		require.NoError(t, err)
+
+		if total < 0 {
+			total = page.Total
+		}
+		assert.Equal(t, total, page.Total)
+		if opts.Limit > 0 {
+			assert.True(t, len(page.Files) <= opts.Limit)
+		}
+
+		for _, f := range page.Files {
+			names = append(names, f.Filename)
+		}
+
+		if !page.More {
+			break
+		}
+		require.NotEmpty(t, page.Files)
+		opts.After = page.Files[len(page.Files)-1]
+	}
+
+	assert.Len(t, names, total)
+	return names
+}
+
+func runDataListTests(t *testing.T, newData DataFactory) {
+	// newListData creates files where each sort key gives a different
+	// order.
+	newListData := func(t *testing.T) storage.Data {
+		d := newData(t)
+
+		for _, f := range []struct {
+			name string
+			day  int
+			size uint64
+		}{
+			{name: "c.pdf", day: 1, size: 2048},
+			{name: "a.pdf", day: 3, size: 3072},
+			{name: "e.pdf", day: 2, size: 1024},
+			{name: "b.pdf", day: 5, size: 5120},
+			{name: "d.pdf", day: 4, size: 4096},
+		} {
+			id, err := d.CreateFile(f.name, date(2020, 3, f.day))
+			require.NoError(t, err)
+
+			hash := f.name + "-hash"
+			require.NoError(t, d.CreateMetadataWithID(hash, f.size, uuid.New()))
+			require.NoError(t, d.UpdateFileHash(id, hash))
+		}
+
+		return d
+	}
+
+	t.Run("sort keys", func(t *testing.T) {
+		d := newListData(t)
+
+		for _, tc := range []struct {
+			sort       records.FileSort
+			descending bool
+			expected   []string
+		}{
+			{records.FILE_SORT_DOCUMENT_DATE, false, []string{"c.pdf", "e.pdf", "a.pdf", "d.pdf", "b.pdf"}},
+			{records.FILE_SORT_DOCUMENT_DATE, true, []string{"b.pdf", "d.pdf", "a.pdf", "e.pdf", "c.pdf"}},
+			{records.FILE_SORT_FILENAME, false, []string{"a.pdf", "b.pdf", "c.pdf", "d.pdf", "e.pdf"}},
+			{records.FILE_SORT_FILENAME, true, []string{"e.pdf", "d.pdf", "c.pdf", "b.pdf", "a.pdf"}},
+			{records.FILE_SORT_SIZE, false, []string{"e.pdf", "c.pdf", "a.pdf", "d.pdf", "b.pdf"}},
+			{records.FILE_SORT_SIZE, true, []string{"b.pdf", "d.pdf", "a.pdf", "c.pdf", "e.pdf"}},
+		} {
+			for _, limit := range []int{0, 1, 2, 5} {
+				names := listAllPages(t, d, storage.ListFilesOptions{
+					Sort:       tc.sort,
+					Descending: tc.descending,
+					Limit:      limit,
+				})
+				assert.Equal(t, tc.expected, names, "%s descending=%t limit=%d", tc.sort, tc.descending, limit)
+			}
+		}
+	})
+	t.Run("sort by created", func(t *testing.T) {
+		d := newListData(t)
+
+		names := listAllPages(t, d, storage.ListFilesOptions{
+			Sort:  records.FILE_SORT_CREATED,
+			Limit: 2,
+		})
+		assert.ElementsMatch(t, []string{"a.pdf", "b.pdf", "c.pdf", "d.pdf", "e.pdf"}, names)
+
+		page, err := d.ListFiles(&storage.ListFilesOptions{
+			Sort: records.FILE_SORT_CREATED,
+		})
+		require.NoError(t, err)
+		for idx := 1; idx < len(page.Files); idx++ {
+			prev := page.Files[idx-1].Created.Unix()
+			assert.True(t, prev <= page.Files[idx].Created.Unix())
+		}
+	})
+	t.Run("pages with totals", func(t *testing.T) {
+		d := newListData(t)
+
+		page, err := d.ListFiles(&storage.ListFilesOptions{
+			Sort:  records.FILE_SORT_FILENAME,
+			Limit: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 5, page.Total)
+		assert.True(t, page.More)
+		require.Len(t, page.Files, 2)
+		assert.EqualValues(t, 1024*3, page.Files[0].Size)
+
+		page, err = d.ListFiles(&storage.ListFilesOptions{
+			Sort:  records.FILE_SORT_FILENAME,
+			After: page.Files[1],
+			Limit: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 5, page.Total)
+		assert.False(t, page.More)
+		require.Len(t, page.Files, 3)
+		assert.Equal(t, "c.pdf", page.Files[0].Filename)
+	})
+	t.Run("query and trash", func(t *testing.T) {
+		d := newListData(t)
+
+		file, err := d.GetFileWithDate("a.pdf", date(2020, 3, 3))
+		require.NoError(t, err)
+		require.NoError(t, d.RemoveFile(file.ID))
+
+		names := listAllPages(t, d, storage.ListFilesOptions{
+			Sort:  records.FILE_SORT_FILENAME,
+			Limit: 2,
+		})
+		assert.Equal(t, []string{"b.pdf", "c.pdf", "d.pdf", "e.pdf"}, names)
+
+		node, err := query.Parse("date:2020-03-02..2020-03-04")
+		require.NoError(t, err)
+		names = listAllPages(t, d, storage.ListFilesOptions{
+			Query: node,
+			Sort:  records.FILE_SORT_DOCUMENT_DATE,
+			Limit: 1,
+		})
+		assert.Equal(t, []string{"e.pdf", "d.pdf"}, names)
+	})
+	t.Run("empty", func(t *testing.T) {
+		d := newData(t)
+
+		page, err := d.ListFiles(&storage.ListFilesOptions{
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, page.Total)
+		assert.False(t, page.More)
+		assert.Len(t, page.Files, 0)
+	})
+}
@@ -0,0 +1,123 @@
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+	"github.com/aphistic/softcopy/internal/pkg/storage/search"
+)
+
+// createWithContents creates a file stored with the given hash and
+// indexed contents.
+func createWithContents(
+	t *testing.T,
+	d storage.Data,
+	filename string,
+	hash string,
+	contents string,
+) uuid.UUID {
+	id, err := d.CreateFile(filename, date(2020, 3, 4))
+	require.NoError(t, err)
+
+	_, err = d.FindMetadataByHash(hash)
+	if err != nil {
+		require.NoError(t, d.CreateMetadataWithID(hash, uint64(len(contents)), uuid.New()))
+		require.NoError(t, d.SetFileContents(hash, contents))
+	}
+	require.NoError(t, d.UpdateFileHash(id, hash))
+
+	return id
+}
+
+func hitNames(hits []*records.SearchHit) []string {
+	names := []string{}
+	for _, hit := range hits {
+		names = append(names, hit.File.Filename)
+	}
+
+	return names
+}
+
+func runDataSearchTests(t *testing.T, newData DataFactory) {
+	t.Run("search contents", func(t *testing.T) {
+		d := newData(t)
+
+		id := createWithContents(t, d, "tax.pdf", "abc123", "property tax statement for 2020")
+		createWithContents(t, d, "invoice.pdf", "def456", "invoice for services")
+
+		hits, err := d.SearchFiles("Tax")
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, id, hits[0].File.ID)
+		assert.Equal(t, "tax.pdf", hits[0].File.Filename)
+		assert.Equal(t, "abc123", hits[0].File.Hash)
+		assert.EqualValues(t, 31, hits[0].File.Size)
+		assert.Contains(t, hits[0].Snippet, search.SnippetStart+"tax"+search.SnippetEnd)
+	})
+	t.Run("all terms must match", func(t *testing.T) {
+		d := newData(t)
+
+		createWithContents(t, d, "tax.pdf", "abc123", "property tax statement for 2020")
+		createWithContents(t, d, "invoice.pdf", "def456", "invoice for services")
+
+		hits, err := d.SearchFiles("for")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"invoice.pdf", "tax.pdf"}, hitNames(hits))
+
+		hits, err = d.SearchFiles("invoice 2020")
+		require.NoError(t, err)
+		assert.Len(t, hits, 0)
+	})
+	t.Run("better matches rank first", func(t *testing.T) {
+		d := newData(t)
+
+		createWithContents(t, d, "a.pdf", "abc123",
+			"a letter that mentions tax only once among many other words",
+		)
+		createWithContents(t, d, "b.pdf", "def456", "tax tax tax statement")
+
+		hits, err := d.SearchFiles("tax")
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+		assert.Equal(t, []string{"b.pdf", "a.pdf"}, hitNames(hits))
+		assert.True(t, hits[0].Rank > hits[1].Rank)
+	})
+	t.Run("files sharing contents", func(t *testing.T) {
+		d := newData(t)
+
+		createWithContents(t, d, "a.pdf", "abc123", "property tax statement")
+		createWithContents(t, d, "b.pdf", "abc123", "property tax statement")
+
+		hits, err := d.SearchFiles("statement")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"a.pdf", "b.pdf"}, hitNames(hits))
+	})
+	t.Run("replace contents", func(t *testing.T) {
+		d := newData(t)
+
+		createWithContents(t, d, "a.pdf", "abc123", "property tax statement")
+		require.NoError(t, d.SetFileContents("abc123", "water bill"))
+
+		hits, err := d.SearchFiles("tax")
+		require.NoError(t, err)
+		assert.Len(t, hits, 0)
+
+		hits, err = d.SearchFiles("water")
+		require.NoError(t, err)
+		assert.Len(t, hits, 1)
+	})
+	t.Run("empty query", func(t *testing.T) {
+		d := newData(t)
+
+		createWithContents(t, d, "a.pdf", "abc123", "property tax statement")
+
+		hits, err := d.SearchFiles(" ! ")
+		require.NoError(t, err)
+		assert.Len(t, hits, 0)
+	})
+}
@@ -0,0 +1,79 @@
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func runDataStatsTests(t *testing.T, newData DataFactory) {
+	t.Run("empty", func(t *testing.T) {
+		d := newData(t)
+
+		stats, err := d.GetStats()
+		require.NoError(t, err)
+		assert.Equal(t, 0, stats.Files)
+		assert.Equal(t, 0, stats.InboxFiles)
+		assert.EqualValues(t, 0, stats.LogicalBytes)
+		assert.EqualValues(t, 0, stats.StoredBytes)
+		assert.Len(t, stats.Years, 0)
+	})
+	t.Run("get stats", func(t *testing.T) {
+		d := newData(t)
+
+		_, err := d.CreateTags([]string{"taxes", "receipts"})
+		require.NoError(t, err)
+
+		createWithContents(t, d, "a.pdf", "dup", "duplicate contents")
+		createWithContents(t, d, "b.pdf", "dup", "duplicate contents")
+		createWithContents(t, d, "c.pdf", "unique", "unique")
+		trashedID := createWithContents(t, d, "d.pdf", "trashed", "trashed contents")
+		require.NoError(t, d.RemoveFile(trashedID))
+
+		filedID, err := d.CreateFile("e.pdf", date(2018, 1, 2))
+		require.NoError(t, err)
+		require.NoError(t, d.UpdateFileTags(filedID, []string{"taxes"}, []string{consts.TagUnfiled}))
+		_, err = d.CreateFileWithTags("f.pdf", date(2018, 12, 31), []string{"receipts"})
+		require.NoError(t, err)
+
+		stats, err := d.GetStats()
+		require.NoError(t, err)
+		assert.Equal(t, 5, stats.Files)
+		assert.Equal(t, 1, stats.TrashedFiles)
+		assert.Equal(t, 3, stats.InboxFiles)
+		// unfiled, taxes and receipts
+		assert.Equal(t, 3, stats.Tags)
+		assert.EqualValues(t, 2*len("duplicate contents")+len("unique"), stats.LogicalBytes)
+		assert.Equal(t, 3, stats.StoredContents)
+		assert.EqualValues(
+			t,
+			len("duplicate contents")+len("unique")+len("trashed contents"),
+			stats.StoredBytes,
+		)
+		assert.EqualValues(t, len("duplicate contents")-len("trashed contents"), stats.DedupSavings())
+		assert.Equal(t, []*records.YearStats{
+			{Year: 2018, Files: 2},
+			{Year: 2020, Files: 3},
+		}, stats.Years)
+	})
+	t.Run("stored bytes are the stored size", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.CreateMetadataWithStoredSize("abc", 1000, 100, uuid.New()))
+		_, err = d.AddFileVersion(id, "abc", records.VersionSourceWrite)
+		require.NoError(t, err)
+
+		stats, err := d.GetStats()
+		require.NoError(t, err)
+		assert.EqualValues(t, 1000, stats.LogicalBytes)
+		assert.EqualValues(t, 100, stats.StoredBytes)
+		assert.EqualValues(t, 900, stats.DedupSavings())
+	})
+}
@@ -0,0 +1,19 @@
+// Package storagetest contains conformance tests that every storage.Data
+// and storage.File engine is expected to pass. Engines call RunDataTests or
+// RunFileTests from their own tests with a function that returns a fresh,
+// empty instance of the engine.
+package storagetest
+
+import (
+	"testing"
+
+	"github.com/aphistic/softcopy/internal/pkg/storage"
+)
+
+// DataFactory returns a new, empty storage.Data for a single test. Any
+// cleanup should be registered with t.Cleanup.
+type DataFactory func(t *testing.T) storage.Data
+
+// FileFactory returns a new, empty storage.File for a single test. Any
+// cleanup should be registered with t.Cleanup.
+type FileFactory func(t *testing.T) storage.File
@@ -0,0 +1,126 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aphistic/softcopy/internal/pkg/consts"
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/query"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func runDataTrashTests(t *testing.T, newData DataFactory) {
+	t.Run("removed files are hidden", func(t *testing.T) {
+		d := newData(t)
+
+		id := createWithContents(t, d, "receipt.pdf", "abc123", "grocery receipt")
+		_, err := d.CreateFile("other.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.RemoveFile(id))
+
+		_, err = d.GetFile(id)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = d.GetFileByHash("abc123")
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = d.GetFileWithDate("receipt.pdf", date(2020, 3, 4))
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = d.GetFileFields(id)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+
+		files, err := d.FindFilesWithDate(date(2020, 3, 4))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other.pdf"}, fileNames(files))
+
+		files, err = d.QueryFiles(query.AllTags(consts.TagUnfiled))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other.pdf"}, fileNames(files))
+
+		hits, err := d.SearchFiles("grocery")
+		require.NoError(t, err)
+		assert.Len(t, hits, 0)
+	})
+	t.Run("list trash", func(t *testing.T) {
+		d := newData(t)
+
+		trash, err := d.ListTrash()
+		require.NoError(t, err)
+		assert.Len(t, trash, 0)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		before := time.Now().Add(-time.Minute)
+		require.NoError(t, d.RemoveFile(id))
+
+		trash, err = d.ListTrash()
+		require.NoError(t, err)
+		require.Len(t, trash, 1)
+		assert.Equal(t, id, trash[0].File.ID)
+		assert.Equal(t, "receipt.pdf", trash[0].File.Filename)
+		assert.True(t, trash[0].Deleted.After(before))
+	})
+	t.Run("restore file", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFileWithTags("receipt.pdf", date(2020, 3, 4), []string{consts.TagUnfiled})
+		require.NoError(t, err)
+		_, err = d.AddFileVersion(id, "abc123", records.VersionSourceWrite)
+		require.NoError(t, err)
+		require.NoError(t, d.RemoveFile(id))
+		require.NoError(t, d.RestoreFile(id))
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "receipt.pdf", f.Filename)
+
+		tags, err := d.GetTagsForFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{consts.TagUnfiled}, tagNames(t, tags))
+
+		versions, err := d.GetFileVersions(id)
+		require.NoError(t, err)
+		assert.Len(t, versions, 1)
+
+		trash, err := d.ListTrash()
+		require.NoError(t, err)
+		assert.Len(t, trash, 0)
+
+		assert.Equal(t, scerrors.ErrNotFound, d.RestoreFile(id))
+		assert.Equal(t, scerrors.ErrNotFound, d.RestoreFile(uuid.New()))
+	})
+	t.Run("restore file conflict", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.RemoveFile(id))
+
+		_, err = d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		assert.Equal(t, scerrors.ErrExists, d.RestoreFile(id))
+	})
+	t.Run("purge file", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("receipt.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		assert.Equal(t, scerrors.ErrNotFound, d.PurgeFile(id))
+
+		require.NoError(t, d.RemoveFile(id))
+		require.NoError(t, d.PurgeFile(id))
+
+		trash, err := d.ListTrash()
+		require.NoError(t, err)
+		assert.Len(t, trash, 0)
+
+		assert.Equal(t, scerrors.ErrNotFound, d.RestoreFile(id))
+		assert.Equal(t, scerrors.ErrNotFound, d.PurgeFile(id))
+	})
+}
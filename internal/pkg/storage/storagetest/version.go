@@ -0,0 +1,106 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scerrors "github.com/aphistic/softcopy/internal/pkg/errors"
+	"github.com/aphistic/softcopy/internal/pkg/storage/records"
+)
+
+func runDataVersionTests(t *testing.T, newData DataFactory) {
+	t.Run("add versions", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		require.NoError(t, d.CreateMetadataWithID("abc123", 10, uuid.New()))
+
+		versions, err := d.GetFileVersions(id)
+		require.NoError(t, err)
+		assert.Len(t, versions, 0)
+
+		before := time.Now().Add(-time.Minute)
+
+		version, err := d.AddFileVersion(id, "abc123", records.VersionSourceWrite)
+		require.NoError(t, err)
+		assert.Equal(t, 1, version)
+
+		version, err = d.AddFileVersion(id, "def456", records.VersionSourceRestore)
+		require.NoError(t, err)
+		assert.Equal(t, 2, version)
+
+		f, err := d.GetFile(id)
+		require.NoError(t, err)
+		assert.Equal(t, "def456", f.Hash)
+
+		versions, err = d.GetFileVersions(id)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+
+		assert.Equal(t, id, versions[0].FileID)
+		assert.Equal(t, 1, versions[0].Version)
+		assert.Equal(t, "abc123", versions[0].Hash)
+		assert.EqualValues(t, 10, versions[0].Size)
+		assert.Equal(t, records.VersionSourceWrite, versions[0].Source)
+		assert.True(t, versions[0].Created.After(before))
+
+		assert.Equal(t, 2, versions[1].Version)
+		assert.Equal(t, "def456", versions[1].Hash)
+		assert.EqualValues(t, 0, versions[1].Size)
+		assert.Equal(t, records.VersionSourceRestore, versions[1].Source)
+
+		v, err := d.GetFileVersion(id, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", v.Hash)
+		assert.EqualValues(t, 10, v.Size)
+	})
+	t.Run("versions are per file", func(t *testing.T) {
+		d := newData(t)
+
+		idA, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		idB, err := d.CreateFile("b.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+
+		_, err = d.AddFileVersion(idA, "abc123", records.VersionSourceWrite)
+		require.NoError(t, err)
+		version, err := d.AddFileVersion(idB, "abc123", records.VersionSourceWrite)
+		require.NoError(t, err)
+		assert.Equal(t, 1, version)
+	})
+	t.Run("missing versions", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		_, err = d.AddFileVersion(id, "abc123", records.VersionSourceWrite)
+		require.NoError(t, err)
+
+		_, err = d.GetFileVersion(id, 2)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = d.GetFileVersion(uuid.New(), 1)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = d.GetFileVersions(uuid.New())
+		assert.Equal(t, scerrors.ErrNotFound, err)
+		_, err = d.AddFileVersion(uuid.New(), "abc123", records.VersionSourceWrite)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+	t.Run("remove file with versions", func(t *testing.T) {
+		d := newData(t)
+
+		id, err := d.CreateFile("a.pdf", date(2020, 3, 4))
+		require.NoError(t, err)
+		_, err = d.AddFileVersion(id, "abc123", records.VersionSourceWrite)
+		require.NoError(t, err)
+
+		require.NoError(t, d.RemoveFile(id))
+
+		_, err = d.GetFileVersion(id, 1)
+		assert.Equal(t, scerrors.ErrNotFound, err)
+	})
+}
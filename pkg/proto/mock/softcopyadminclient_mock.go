@@ -16,6 +16,21 @@ type MockSoftcopyAdminClient struct {
 	// AllFilesFunc is an instance of a mock function object controlling the
 	// behavior of the method AllFiles.
 	AllFilesFunc *SoftcopyAdminClientAllFilesFunc
+	// CollectGarbageFunc is an instance of a mock function object
+	// controlling the behavior of the method CollectGarbage.
+	CollectGarbageFunc *SoftcopyAdminClientCollectGarbageFunc
+	// FindDuplicatesFunc is an instance of a mock function object
+	// controlling the behavior of the method FindDuplicates.
+	FindDuplicatesFunc *SoftcopyAdminClientFindDuplicatesFunc
+	// GetScrubResultsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetScrubResults.
+	GetScrubResultsFunc *SoftcopyAdminClientGetScrubResultsFunc
+	// ReencryptFilesFunc is an instance of a mock function object
+	// controlling the behavior of the method ReencryptFiles.
+	ReencryptFilesFunc *SoftcopyAdminClientReencryptFilesFunc
+	// ScrubFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ScrubFiles.
+	ScrubFilesFunc *SoftcopyAdminClientScrubFilesFunc
 }
 
 // NewMockSoftcopyAdminClient creates a new mock of the SoftcopyAdminClient
@@ -28,6 +43,31 @@ func NewMockSoftcopyAdminClient() *MockSoftcopyAdminClient {
 				return nil, nil
 			},
 		},
+		CollectGarbageFunc: &SoftcopyAdminClientCollectGarbageFunc{
+			defaultHook: func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error) {
+				return nil, nil
+			},
+		},
+		FindDuplicatesFunc: &SoftcopyAdminClientFindDuplicatesFunc{
+			defaultHook: func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error) {
+				return nil, nil
+			},
+		},
+		GetScrubResultsFunc: &SoftcopyAdminClientGetScrubResultsFunc{
+			defaultHook: func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error) {
+				return nil, nil
+			},
+		},
+		ReencryptFilesFunc: &SoftcopyAdminClientReencryptFilesFunc{
+			defaultHook: func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error) {
+				return nil, nil
+			},
+		},
+		ScrubFilesFunc: &SoftcopyAdminClientScrubFilesFunc{
+			defaultHook: func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error) {
+				return nil, nil
+			},
+		},
 	}
 }
 
@@ -39,6 +79,21 @@ func NewMockSoftcopyAdminClientFrom(i proto.SoftcopyAdminClient) *MockSoftcopyAd
 		AllFilesFunc: &SoftcopyAdminClientAllFilesFunc{
 			defaultHook: i.AllFiles,
 		},
+		CollectGarbageFunc: &SoftcopyAdminClientCollectGarbageFunc{
+			defaultHook: i.CollectGarbage,
+		},
+		FindDuplicatesFunc: &SoftcopyAdminClientFindDuplicatesFunc{
+			defaultHook: i.FindDuplicates,
+		},
+		GetScrubResultsFunc: &SoftcopyAdminClientGetScrubResultsFunc{
+			defaultHook: i.GetScrubResults,
+		},
+		ReencryptFilesFunc: &SoftcopyAdminClientReencryptFilesFunc{
+			defaultHook: i.ReencryptFiles,
+		},
+		ScrubFilesFunc: &SoftcopyAdminClientScrubFilesFunc{
+			defaultHook: i.ScrubFiles,
+		},
 	}
 }
 
@@ -160,3 +215,612 @@ func (c SoftcopyAdminClientAllFilesFuncCall) Args() []interface{} {
 func (c SoftcopyAdminClientAllFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// SoftcopyAdminClientCollectGarbageFunc describes the behavior when the
+// CollectGarbage method of the parent MockSoftcopyAdminClient instance is
+// invoked.
+type SoftcopyAdminClientCollectGarbageFunc struct {
+	defaultHook func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error)
+	hooks       []func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error)
+	history     []SoftcopyAdminClientCollectGarbageFuncCall
+	mutex       sync.Mutex
+}
+
+// CollectGarbage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyAdminClient) CollectGarbage(v0 context.Context, v1 *proto.CollectGarbageRequest, v2 ...grpc.CallOption) (*proto.CollectGarbageResponse, error) {
+	r0, r1 := m.CollectGarbageFunc.nextHook()(v0, v1, v2...)
+	m.CollectGarbageFunc.appendCall(SoftcopyAdminClientCollectGarbageFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CollectGarbage
+// method of the parent MockSoftcopyAdminClient instance is invoked and the
+// hook queue is empty.
+func (f *SoftcopyAdminClientCollectGarbageFunc) SetDefaultHook(hook func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CollectGarbage method of the parent MockSoftcopyAdminClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyAdminClientCollectGarbageFunc) PushHook(hook func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyAdminClientCollectGarbageFunc) SetDefaultReturn(r0 *proto.CollectGarbageResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyAdminClientCollectGarbageFunc) PushReturn(r0 *proto.CollectGarbageResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyAdminClientCollectGarbageFunc) nextHook() func(context.Context, *proto.CollectGarbageRequest, ...grpc.CallOption) (*proto.CollectGarbageResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyAdminClientCollectGarbageFunc) appendCall(r0 SoftcopyAdminClientCollectGarbageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyAdminClientCollectGarbageFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyAdminClientCollectGarbageFunc) History() []SoftcopyAdminClientCollectGarbageFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyAdminClientCollectGarbageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyAdminClientCollectGarbageFuncCall is an object that describes an
+// invocation of method CollectGarbage on an instance of
+// MockSoftcopyAdminClient.
+type SoftcopyAdminClientCollectGarbageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.CollectGarbageRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.CollectGarbageResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyAdminClientCollectGarbageFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyAdminClientCollectGarbageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyAdminClientFindDuplicatesFunc describes the behavior when the
+// FindDuplicates method of the parent MockSoftcopyAdminClient instance is
+// invoked.
+type SoftcopyAdminClientFindDuplicatesFunc struct {
+	defaultHook func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error)
+	hooks       []func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error)
+	history     []SoftcopyAdminClientFindDuplicatesFuncCall
+	mutex       sync.Mutex
+}
+
+// FindDuplicates delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyAdminClient) FindDuplicates(v0 context.Context, v1 *proto.FindDuplicatesRequest, v2 ...grpc.CallOption) (*proto.FindDuplicatesResponse, error) {
+	r0, r1 := m.FindDuplicatesFunc.nextHook()(v0, v1, v2...)
+	m.FindDuplicatesFunc.appendCall(SoftcopyAdminClientFindDuplicatesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindDuplicates
+// method of the parent MockSoftcopyAdminClient instance is invoked and the
+// hook queue is empty.
+func (f *SoftcopyAdminClientFindDuplicatesFunc) SetDefaultHook(hook func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindDuplicates method of the parent MockSoftcopyAdminClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyAdminClientFindDuplicatesFunc) PushHook(hook func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyAdminClientFindDuplicatesFunc) SetDefaultReturn(r0 *proto.FindDuplicatesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyAdminClientFindDuplicatesFunc) PushReturn(r0 *proto.FindDuplicatesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyAdminClientFindDuplicatesFunc) nextHook() func(context.Context, *proto.FindDuplicatesRequest, ...grpc.CallOption) (*proto.FindDuplicatesResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyAdminClientFindDuplicatesFunc) appendCall(r0 SoftcopyAdminClientFindDuplicatesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyAdminClientFindDuplicatesFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyAdminClientFindDuplicatesFunc) History() []SoftcopyAdminClientFindDuplicatesFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyAdminClientFindDuplicatesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyAdminClientFindDuplicatesFuncCall is an object that describes an
+// invocation of method FindDuplicates on an instance of
+// MockSoftcopyAdminClient.
+type SoftcopyAdminClientFindDuplicatesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.FindDuplicatesRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.FindDuplicatesResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyAdminClientFindDuplicatesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyAdminClientFindDuplicatesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyAdminClientGetScrubResultsFunc describes the behavior when the
+// GetScrubResults method of the parent MockSoftcopyAdminClient instance is
+// invoked.
+type SoftcopyAdminClientGetScrubResultsFunc struct {
+	defaultHook func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error)
+	hooks       []func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error)
+	history     []SoftcopyAdminClientGetScrubResultsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetScrubResults delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyAdminClient) GetScrubResults(v0 context.Context, v1 *proto.GetScrubResultsRequest, v2 ...grpc.CallOption) (*proto.GetScrubResultsResponse, error) {
+	r0, r1 := m.GetScrubResultsFunc.nextHook()(v0, v1, v2...)
+	m.GetScrubResultsFunc.appendCall(SoftcopyAdminClientGetScrubResultsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetScrubResults
+// method of the parent MockSoftcopyAdminClient instance is invoked and the
+// hook queue is empty.
+func (f *SoftcopyAdminClientGetScrubResultsFunc) SetDefaultHook(hook func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetScrubResults method of the parent MockSoftcopyAdminClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyAdminClientGetScrubResultsFunc) PushHook(hook func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyAdminClientGetScrubResultsFunc) SetDefaultReturn(r0 *proto.GetScrubResultsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyAdminClientGetScrubResultsFunc) PushReturn(r0 *proto.GetScrubResultsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyAdminClientGetScrubResultsFunc) nextHook() func(context.Context, *proto.GetScrubResultsRequest, ...grpc.CallOption) (*proto.GetScrubResultsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyAdminClientGetScrubResultsFunc) appendCall(r0 SoftcopyAdminClientGetScrubResultsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyAdminClientGetScrubResultsFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyAdminClientGetScrubResultsFunc) History() []SoftcopyAdminClientGetScrubResultsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyAdminClientGetScrubResultsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyAdminClientGetScrubResultsFuncCall is an object that describes an
+// invocation of method GetScrubResults on an instance of
+// MockSoftcopyAdminClient.
+type SoftcopyAdminClientGetScrubResultsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetScrubResultsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetScrubResultsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyAdminClientGetScrubResultsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyAdminClientGetScrubResultsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyAdminClientReencryptFilesFunc describes the behavior when the
+// ReencryptFiles method of the parent MockSoftcopyAdminClient instance is
+// invoked.
+type SoftcopyAdminClientReencryptFilesFunc struct {
+	defaultHook func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error)
+	hooks       []func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error)
+	history     []SoftcopyAdminClientReencryptFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// ReencryptFiles delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyAdminClient) ReencryptFiles(v0 context.Context, v1 *proto.ReencryptFilesRequest, v2 ...grpc.CallOption) (*proto.ReencryptFilesResponse, error) {
+	r0, r1 := m.ReencryptFilesFunc.nextHook()(v0, v1, v2...)
+	m.ReencryptFilesFunc.appendCall(SoftcopyAdminClientReencryptFilesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReencryptFiles
+// method of the parent MockSoftcopyAdminClient instance is invoked and the
+// hook queue is empty.
+func (f *SoftcopyAdminClientReencryptFilesFunc) SetDefaultHook(hook func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReencryptFiles method of the parent MockSoftcopyAdminClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyAdminClientReencryptFilesFunc) PushHook(hook func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyAdminClientReencryptFilesFunc) SetDefaultReturn(r0 *proto.ReencryptFilesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyAdminClientReencryptFilesFunc) PushReturn(r0 *proto.ReencryptFilesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyAdminClientReencryptFilesFunc) nextHook() func(context.Context, *proto.ReencryptFilesRequest, ...grpc.CallOption) (*proto.ReencryptFilesResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyAdminClientReencryptFilesFunc) appendCall(r0 SoftcopyAdminClientReencryptFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyAdminClientReencryptFilesFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyAdminClientReencryptFilesFunc) History() []SoftcopyAdminClientReencryptFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyAdminClientReencryptFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyAdminClientReencryptFilesFuncCall is an object that describes an
+// invocation of method ReencryptFiles on an instance of
+// MockSoftcopyAdminClient.
+type SoftcopyAdminClientReencryptFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.ReencryptFilesRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.ReencryptFilesResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyAdminClientReencryptFilesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyAdminClientReencryptFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyAdminClientScrubFilesFunc describes the behavior when the
+// ScrubFiles method of the parent MockSoftcopyAdminClient instance is
+// invoked.
+type SoftcopyAdminClientScrubFilesFunc struct {
+	defaultHook func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error)
+	hooks       []func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error)
+	history     []SoftcopyAdminClientScrubFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// ScrubFiles delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyAdminClient) ScrubFiles(v0 context.Context, v1 *proto.ScrubFilesRequest, v2 ...grpc.CallOption) (*proto.ScrubFilesResponse, error) {
+	r0, r1 := m.ScrubFilesFunc.nextHook()(v0, v1, v2...)
+	m.ScrubFilesFunc.appendCall(SoftcopyAdminClientScrubFilesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ScrubFiles method of
+// the parent MockSoftcopyAdminClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyAdminClientScrubFilesFunc) SetDefaultHook(hook func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ScrubFiles method of the parent MockSoftcopyAdminClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyAdminClientScrubFilesFunc) PushHook(hook func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyAdminClientScrubFilesFunc) SetDefaultReturn(r0 *proto.ScrubFilesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyAdminClientScrubFilesFunc) PushReturn(r0 *proto.ScrubFilesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyAdminClientScrubFilesFunc) nextHook() func(context.Context, *proto.ScrubFilesRequest, ...grpc.CallOption) (*proto.ScrubFilesResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyAdminClientScrubFilesFunc) appendCall(r0 SoftcopyAdminClientScrubFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyAdminClientScrubFilesFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyAdminClientScrubFilesFunc) History() []SoftcopyAdminClientScrubFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyAdminClientScrubFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyAdminClientScrubFilesFuncCall is an object that describes an
+// invocation of method ScrubFiles on an instance of
+// MockSoftcopyAdminClient.
+type SoftcopyAdminClientScrubFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.ScrubFilesRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.ScrubFilesResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyAdminClientScrubFilesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyAdminClientScrubFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -19,9 +19,18 @@ type MockSoftcopyClient struct {
 	// CreateFileFunc is an instance of a mock function object controlling
 	// the behavior of the method CreateFile.
 	CreateFileFunc *SoftcopyClientCreateFileFunc
+	// CreateFileLinkFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateFileLink.
+	CreateFileLinkFunc *SoftcopyClientCreateFileLinkFunc
+	// CreateTagCategoryFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateTagCategory.
+	CreateTagCategoryFunc *SoftcopyClientCreateTagCategoryFunc
 	// CreateTagsFunc is an instance of a mock function object controlling
 	// the behavior of the method CreateTags.
 	CreateTagsFunc *SoftcopyClientCreateTagsFunc
+	// DeleteTagFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteTag.
+	DeleteTagFunc *SoftcopyClientDeleteTagFunc
 	// FindFilesWithDateFunc is an instance of a mock function object
 	// controlling the behavior of the method FindFilesWithDate.
 	FindFilesWithDateFunc *SoftcopyClientFindFilesWithDateFunc
@@ -37,42 +46,105 @@ type MockSoftcopyClient struct {
 	// FlushFileFunc is an instance of a mock function object controlling
 	// the behavior of the method FlushFile.
 	FlushFileFunc *SoftcopyClientFlushFileFunc
+	// GetAllTagCategoriesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAllTagCategories.
+	GetAllTagCategoriesFunc *SoftcopyClientGetAllTagCategoriesFunc
 	// GetAllTagsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetAllTags.
 	GetAllTagsFunc *SoftcopyClientGetAllTagsFunc
+	// GetAuditLogFunc is an instance of a mock function object controlling
+	// the behavior of the method GetAuditLog.
+	GetAuditLogFunc *SoftcopyClientGetAuditLogFunc
 	// GetFileFunc is an instance of a mock function object controlling the
 	// behavior of the method GetFile.
 	GetFileFunc *SoftcopyClientGetFileFunc
 	// GetFileDaysFunc is an instance of a mock function object controlling
 	// the behavior of the method GetFileDays.
 	GetFileDaysFunc *SoftcopyClientGetFileDaysFunc
+	// GetFileFieldsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetFileFields.
+	GetFileFieldsFunc *SoftcopyClientGetFileFieldsFunc
+	// GetFileLinksFunc is an instance of a mock function object controlling
+	// the behavior of the method GetFileLinks.
+	GetFileLinksFunc *SoftcopyClientGetFileLinksFunc
 	// GetFileMonthsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetFileMonths.
 	GetFileMonthsFunc *SoftcopyClientGetFileMonthsFunc
+	// GetFileVersionsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetFileVersions.
+	GetFileVersionsFunc *SoftcopyClientGetFileVersionsFunc
 	// GetFileWithDateFunc is an instance of a mock function object
 	// controlling the behavior of the method GetFileWithDate.
 	GetFileWithDateFunc *SoftcopyClientGetFileWithDateFunc
 	// GetFileYearsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetFileYears.
 	GetFileYearsFunc *SoftcopyClientGetFileYearsFunc
+	// GetStatsFunc is an instance of a mock function object controlling the
+	// behavior of the method GetStats.
+	GetStatsFunc *SoftcopyClientGetStatsFunc
 	// GetTagsForFileFunc is an instance of a mock function object
 	// controlling the behavior of the method GetTagsForFile.
 	GetTagsForFileFunc *SoftcopyClientGetTagsForFileFunc
+	// ListFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ListFiles.
+	ListFilesFunc *SoftcopyClientListFilesFunc
+	// ListTrashFunc is an instance of a mock function object controlling
+	// the behavior of the method ListTrash.
+	ListTrashFunc *SoftcopyClientListTrashFunc
+	// MergeTagsFunc is an instance of a mock function object controlling
+	// the behavior of the method MergeTags.
+	MergeTagsFunc *SoftcopyClientMergeTagsFunc
 	// OpenFileFunc is an instance of a mock function object controlling the
 	// behavior of the method OpenFile.
 	OpenFileFunc *SoftcopyClientOpenFileFunc
+	// PurgeFileFunc is an instance of a mock function object controlling
+	// the behavior of the method PurgeFile.
+	PurgeFileFunc *SoftcopyClientPurgeFileFunc
+	// QueryFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method QueryFiles.
+	QueryFilesFunc *SoftcopyClientQueryFilesFunc
 	// ReadFileFunc is an instance of a mock function object controlling the
 	// behavior of the method ReadFile.
 	ReadFileFunc *SoftcopyClientReadFileFunc
 	// RemoveFileFunc is an instance of a mock function object controlling
 	// the behavior of the method RemoveFile.
 	RemoveFileFunc *SoftcopyClientRemoveFileFunc
+	// RemoveFileLinkFunc is an instance of a mock function object
+	// controlling the behavior of the method RemoveFileLink.
+	RemoveFileLinkFunc *SoftcopyClientRemoveFileLinkFunc
+	// RemoveTagCategoryFunc is an instance of a mock function object
+	// controlling the behavior of the method RemoveTagCategory.
+	RemoveTagCategoryFunc *SoftcopyClientRemoveTagCategoryFunc
+	// RenameTagFunc is an instance of a mock function object controlling
+	// the behavior of the method RenameTag.
+	RenameTagFunc *SoftcopyClientRenameTagFunc
+	// RestoreFileFunc is an instance of a mock function object controlling
+	// the behavior of the method RestoreFile.
+	RestoreFileFunc *SoftcopyClientRestoreFileFunc
+	// RestoreFileVersionFunc is an instance of a mock function object
+	// controlling the behavior of the method RestoreFileVersion.
+	RestoreFileVersionFunc *SoftcopyClientRestoreFileVersionFunc
+	// SearchFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method SearchFiles.
+	SearchFilesFunc *SoftcopyClientSearchFilesFunc
+	// SetFileFieldsFunc is an instance of a mock function object
+	// controlling the behavior of the method SetFileFields.
+	SetFileFieldsFunc *SoftcopyClientSetFileFieldsFunc
+	// SetTagCategoryFunc is an instance of a mock function object
+	// controlling the behavior of the method SetTagCategory.
+	SetTagCategoryFunc *SoftcopyClientSetTagCategoryFunc
+	// UpdateFileFunc is an instance of a mock function object controlling
+	// the behavior of the method UpdateFile.
+	UpdateFileFunc *SoftcopyClientUpdateFileFunc
 	// UpdateFileDateFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateFileDate.
 	UpdateFileDateFunc *SoftcopyClientUpdateFileDateFunc
 	// UpdateFileTagsFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateFileTags.
 	UpdateFileTagsFunc *SoftcopyClientUpdateFileTagsFunc
+	// UpdateTagCategoryFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateTagCategory.
+	UpdateTagCategoryFunc *SoftcopyClientUpdateTagCategoryFunc
 	// WriteFileFunc is an instance of a mock function object controlling
 	// the behavior of the method WriteFile.
 	WriteFileFunc *SoftcopyClientWriteFileFunc
@@ -92,11 +164,26 @@ func NewMockSoftcopyClient() *MockSoftcopyClient {
 				return nil, nil
 			},
 		},
+		CreateFileLinkFunc: &SoftcopyClientCreateFileLinkFunc{
+			defaultHook: func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error) {
+				return nil, nil
+			},
+		},
+		CreateTagCategoryFunc: &SoftcopyClientCreateTagCategoryFunc{
+			defaultHook: func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error) {
+				return nil, nil
+			},
+		},
 		CreateTagsFunc: &SoftcopyClientCreateTagsFunc{
 			defaultHook: func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
 				return nil, nil
 			},
 		},
+		DeleteTagFunc: &SoftcopyClientDeleteTagFunc{
+			defaultHook: func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error) {
+				return nil, nil
+			},
+		},
 		FindFilesWithDateFunc: &SoftcopyClientFindFilesWithDateFunc{
 			defaultHook: func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
 				return nil, nil
@@ -122,11 +209,21 @@ func NewMockSoftcopyClient() *MockSoftcopyClient {
 				return nil, nil
 			},
 		},
+		GetAllTagCategoriesFunc: &SoftcopyClientGetAllTagCategoriesFunc{
+			defaultHook: func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error) {
+				return nil, nil
+			},
+		},
 		GetAllTagsFunc: &SoftcopyClientGetAllTagsFunc{
 			defaultHook: func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
 				return nil, nil
 			},
 		},
+		GetAuditLogFunc: &SoftcopyClientGetAuditLogFunc{
+			defaultHook: func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error) {
+				return nil, nil
+			},
+		},
 		GetFileFunc: &SoftcopyClientGetFileFunc{
 			defaultHook: func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
 				return nil, nil
@@ -137,11 +234,26 @@ func NewMockSoftcopyClient() *MockSoftcopyClient {
 				return nil, nil
 			},
 		},
+		GetFileFieldsFunc: &SoftcopyClientGetFileFieldsFunc{
+			defaultHook: func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error) {
+				return nil, nil
+			},
+		},
+		GetFileLinksFunc: &SoftcopyClientGetFileLinksFunc{
+			defaultHook: func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error) {
+				return nil, nil
+			},
+		},
 		GetFileMonthsFunc: &SoftcopyClientGetFileMonthsFunc{
 			defaultHook: func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
 				return nil, nil
 			},
 		},
+		GetFileVersionsFunc: &SoftcopyClientGetFileVersionsFunc{
+			defaultHook: func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error) {
+				return nil, nil
+			},
+		},
 		GetFileWithDateFunc: &SoftcopyClientGetFileWithDateFunc{
 			defaultHook: func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
 				return nil, nil
@@ -152,16 +264,46 @@ func NewMockSoftcopyClient() *MockSoftcopyClient {
 				return nil, nil
 			},
 		},
+		GetStatsFunc: &SoftcopyClientGetStatsFunc{
+			defaultHook: func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error) {
+				return nil, nil
+			},
+		},
 		GetTagsForFileFunc: &SoftcopyClientGetTagsForFileFunc{
 			defaultHook: func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
 				return nil, nil
 			},
 		},
+		ListFilesFunc: &SoftcopyClientListFilesFunc{
+			defaultHook: func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error) {
+				return nil, nil
+			},
+		},
+		ListTrashFunc: &SoftcopyClientListTrashFunc{
+			defaultHook: func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error) {
+				return nil, nil
+			},
+		},
+		MergeTagsFunc: &SoftcopyClientMergeTagsFunc{
+			defaultHook: func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error) {
+				return nil, nil
+			},
+		},
 		OpenFileFunc: &SoftcopyClientOpenFileFunc{
 			defaultHook: func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
 				return nil, nil
 			},
 		},
+		PurgeFileFunc: &SoftcopyClientPurgeFileFunc{
+			defaultHook: func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error) {
+				return nil, nil
+			},
+		},
+		QueryFilesFunc: &SoftcopyClientQueryFilesFunc{
+			defaultHook: func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error) {
+				return nil, nil
+			},
+		},
 		ReadFileFunc: &SoftcopyClientReadFileFunc{
 			defaultHook: func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
 				return nil, nil
@@ -172,6 +314,51 @@ func NewMockSoftcopyClient() *MockSoftcopyClient {
 				return nil, nil
 			},
 		},
+		RemoveFileLinkFunc: &SoftcopyClientRemoveFileLinkFunc{
+			defaultHook: func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error) {
+				return nil, nil
+			},
+		},
+		RemoveTagCategoryFunc: &SoftcopyClientRemoveTagCategoryFunc{
+			defaultHook: func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error) {
+				return nil, nil
+			},
+		},
+		RenameTagFunc: &SoftcopyClientRenameTagFunc{
+			defaultHook: func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error) {
+				return nil, nil
+			},
+		},
+		RestoreFileFunc: &SoftcopyClientRestoreFileFunc{
+			defaultHook: func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error) {
+				return nil, nil
+			},
+		},
+		RestoreFileVersionFunc: &SoftcopyClientRestoreFileVersionFunc{
+			defaultHook: func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error) {
+				return nil, nil
+			},
+		},
+		SearchFilesFunc: &SoftcopyClientSearchFilesFunc{
+			defaultHook: func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error) {
+				return nil, nil
+			},
+		},
+		SetFileFieldsFunc: &SoftcopyClientSetFileFieldsFunc{
+			defaultHook: func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error) {
+				return nil, nil
+			},
+		},
+		SetTagCategoryFunc: &SoftcopyClientSetTagCategoryFunc{
+			defaultHook: func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error) {
+				return nil, nil
+			},
+		},
+		UpdateFileFunc: &SoftcopyClientUpdateFileFunc{
+			defaultHook: func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error) {
+				return nil, nil
+			},
+		},
 		UpdateFileDateFunc: &SoftcopyClientUpdateFileDateFunc{
 			defaultHook: func(context.Context, *proto.UpdateFileDateRequest, ...grpc.CallOption) (*proto.UpdateFileDateResponse, error) {
 				return nil, nil
@@ -182,6 +369,11 @@ func NewMockSoftcopyClient() *MockSoftcopyClient {
 				return nil, nil
 			},
 		},
+		UpdateTagCategoryFunc: &SoftcopyClientUpdateTagCategoryFunc{
+			defaultHook: func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error) {
+				return nil, nil
+			},
+		},
 		WriteFileFunc: &SoftcopyClientWriteFileFunc{
 			defaultHook: func(context.Context, *proto.WriteFileRequest, ...grpc.CallOption) (*proto.WriteFileResponse, error) {
 				return nil, nil
@@ -201,9 +393,18 @@ func NewMockSoftcopyClientFrom(i proto.SoftcopyClient) *MockSoftcopyClient {
 		CreateFileFunc: &SoftcopyClientCreateFileFunc{
 			defaultHook: i.CreateFile,
 		},
+		CreateFileLinkFunc: &SoftcopyClientCreateFileLinkFunc{
+			defaultHook: i.CreateFileLink,
+		},
+		CreateTagCategoryFunc: &SoftcopyClientCreateTagCategoryFunc{
+			defaultHook: i.CreateTagCategory,
+		},
 		CreateTagsFunc: &SoftcopyClientCreateTagsFunc{
 			defaultHook: i.CreateTags,
 		},
+		DeleteTagFunc: &SoftcopyClientDeleteTagFunc{
+			defaultHook: i.DeleteTag,
+		},
 		FindFilesWithDateFunc: &SoftcopyClientFindFilesWithDateFunc{
 			defaultHook: i.FindFilesWithDate,
 		},
@@ -219,42 +420,105 @@ func NewMockSoftcopyClientFrom(i proto.SoftcopyClient) *MockSoftcopyClient {
 		FlushFileFunc: &SoftcopyClientFlushFileFunc{
 			defaultHook: i.FlushFile,
 		},
+		GetAllTagCategoriesFunc: &SoftcopyClientGetAllTagCategoriesFunc{
+			defaultHook: i.GetAllTagCategories,
+		},
 		GetAllTagsFunc: &SoftcopyClientGetAllTagsFunc{
 			defaultHook: i.GetAllTags,
 		},
+		GetAuditLogFunc: &SoftcopyClientGetAuditLogFunc{
+			defaultHook: i.GetAuditLog,
+		},
 		GetFileFunc: &SoftcopyClientGetFileFunc{
 			defaultHook: i.GetFile,
 		},
 		GetFileDaysFunc: &SoftcopyClientGetFileDaysFunc{
 			defaultHook: i.GetFileDays,
 		},
+		GetFileFieldsFunc: &SoftcopyClientGetFileFieldsFunc{
+			defaultHook: i.GetFileFields,
+		},
+		GetFileLinksFunc: &SoftcopyClientGetFileLinksFunc{
+			defaultHook: i.GetFileLinks,
+		},
 		GetFileMonthsFunc: &SoftcopyClientGetFileMonthsFunc{
 			defaultHook: i.GetFileMonths,
 		},
+		GetFileVersionsFunc: &SoftcopyClientGetFileVersionsFunc{
+			defaultHook: i.GetFileVersions,
+		},
 		GetFileWithDateFunc: &SoftcopyClientGetFileWithDateFunc{
 			defaultHook: i.GetFileWithDate,
 		},
 		GetFileYearsFunc: &SoftcopyClientGetFileYearsFunc{
 			defaultHook: i.GetFileYears,
 		},
+		GetStatsFunc: &SoftcopyClientGetStatsFunc{
+			defaultHook: i.GetStats,
+		},
 		GetTagsForFileFunc: &SoftcopyClientGetTagsForFileFunc{
 			defaultHook: i.GetTagsForFile,
 		},
+		ListFilesFunc: &SoftcopyClientListFilesFunc{
+			defaultHook: i.ListFiles,
+		},
+		ListTrashFunc: &SoftcopyClientListTrashFunc{
+			defaultHook: i.ListTrash,
+		},
+		MergeTagsFunc: &SoftcopyClientMergeTagsFunc{
+			defaultHook: i.MergeTags,
+		},
 		OpenFileFunc: &SoftcopyClientOpenFileFunc{
 			defaultHook: i.OpenFile,
 		},
+		PurgeFileFunc: &SoftcopyClientPurgeFileFunc{
+			defaultHook: i.PurgeFile,
+		},
+		QueryFilesFunc: &SoftcopyClientQueryFilesFunc{
+			defaultHook: i.QueryFiles,
+		},
 		ReadFileFunc: &SoftcopyClientReadFileFunc{
 			defaultHook: i.ReadFile,
 		},
 		RemoveFileFunc: &SoftcopyClientRemoveFileFunc{
 			defaultHook: i.RemoveFile,
 		},
+		RemoveFileLinkFunc: &SoftcopyClientRemoveFileLinkFunc{
+			defaultHook: i.RemoveFileLink,
+		},
+		RemoveTagCategoryFunc: &SoftcopyClientRemoveTagCategoryFunc{
+			defaultHook: i.RemoveTagCategory,
+		},
+		RenameTagFunc: &SoftcopyClientRenameTagFunc{
+			defaultHook: i.RenameTag,
+		},
+		RestoreFileFunc: &SoftcopyClientRestoreFileFunc{
+			defaultHook: i.RestoreFile,
+		},
+		RestoreFileVersionFunc: &SoftcopyClientRestoreFileVersionFunc{
+			defaultHook: i.RestoreFileVersion,
+		},
+		SearchFilesFunc: &SoftcopyClientSearchFilesFunc{
+			defaultHook: i.SearchFiles,
+		},
+		SetFileFieldsFunc: &SoftcopyClientSetFileFieldsFunc{
+			defaultHook: i.SetFileFields,
+		},
+		SetTagCategoryFunc: &SoftcopyClientSetTagCategoryFunc{
+			defaultHook: i.SetTagCategory,
+		},
+		UpdateFileFunc: &SoftcopyClientUpdateFileFunc{
+			defaultHook: i.UpdateFile,
+		},
 		UpdateFileDateFunc: &SoftcopyClientUpdateFileDateFunc{
 			defaultHook: i.UpdateFileDate,
 		},
 		UpdateFileTagsFunc: &SoftcopyClientUpdateFileTagsFunc{
 			defaultHook: i.UpdateFileTags,
 		},
+		UpdateTagCategoryFunc: &SoftcopyClientUpdateTagCategoryFunc{
+			defaultHook: i.UpdateTagCategory,
+		},
 		WriteFileFunc: &SoftcopyClientWriteFileFunc{
 			defaultHook: i.WriteFile,
 		},
@@ -499,35 +763,36 @@ func (c SoftcopyClientCreateFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientCreateTagsFunc describes the behavior when the CreateTags
-// method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientCreateTagsFunc struct {
-	defaultHook func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)
-	hooks       []func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)
-	history     []SoftcopyClientCreateTagsFuncCall
+// SoftcopyClientCreateFileLinkFunc describes the behavior when the
+// CreateFileLink method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientCreateFileLinkFunc struct {
+	defaultHook func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error)
+	hooks       []func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error)
+	history     []SoftcopyClientCreateFileLinkFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateTags delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) CreateTags(v0 context.Context, v1 *proto.CreateTagsRequest, v2 ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
-	r0, r1 := m.CreateTagsFunc.nextHook()(v0, v1, v2...)
-	m.CreateTagsFunc.appendCall(SoftcopyClientCreateTagsFuncCall{v0, v1, v2, r0, r1})
+// CreateFileLink delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) CreateFileLink(v0 context.Context, v1 *proto.CreateFileLinkRequest, v2 ...grpc.CallOption) (*proto.CreateFileLinkResponse, error) {
+	r0, r1 := m.CreateFileLinkFunc.nextHook()(v0, v1, v2...)
+	m.CreateFileLinkFunc.appendCall(SoftcopyClientCreateFileLinkFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CreateTags method of
-// the parent MockSoftcopyClient instance is invoked and the hook queue is
-// empty.
-func (f *SoftcopyClientCreateTagsFunc) SetDefaultHook(hook func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)) {
+// SetDefaultHook sets function that is called when the CreateFileLink
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientCreateFileLinkFunc) SetDefaultHook(hook func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateTags method of the parent MockSoftcopyClient instance inovkes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SoftcopyClientCreateTagsFunc) PushHook(hook func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)) {
+// CreateFileLink method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientCreateFileLinkFunc) PushHook(hook func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -535,21 +800,21 @@ func (f *SoftcopyClientCreateTagsFunc) PushHook(hook func(context.Context, *prot
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientCreateTagsFunc) SetDefaultReturn(r0 *proto.CreateTagsResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
+func (f *SoftcopyClientCreateFileLinkFunc) SetDefaultReturn(r0 *proto.CreateFileLinkResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientCreateTagsFunc) PushReturn(r0 *proto.CreateTagsResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
+func (f *SoftcopyClientCreateFileLinkFunc) PushReturn(r0 *proto.CreateFileLinkResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientCreateTagsFunc) nextHook() func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
+func (f *SoftcopyClientCreateFileLinkFunc) nextHook() func(context.Context, *proto.CreateFileLinkRequest, ...grpc.CallOption) (*proto.CreateFileLinkResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -562,38 +827,38 @@ func (f *SoftcopyClientCreateTagsFunc) nextHook() func(context.Context, *proto.C
 	return hook
 }
 
-func (f *SoftcopyClientCreateTagsFunc) appendCall(r0 SoftcopyClientCreateTagsFuncCall) {
+func (f *SoftcopyClientCreateFileLinkFunc) appendCall(r0 SoftcopyClientCreateFileLinkFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientCreateTagsFuncCall objects
-// describing the invocations of this function.
-func (f *SoftcopyClientCreateTagsFunc) History() []SoftcopyClientCreateTagsFuncCall {
+// History returns a sequence of SoftcopyClientCreateFileLinkFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientCreateFileLinkFunc) History() []SoftcopyClientCreateFileLinkFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientCreateTagsFuncCall, len(f.history))
+	history := make([]SoftcopyClientCreateFileLinkFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientCreateTagsFuncCall is an object that describes an
-// invocation of method CreateTags on an instance of MockSoftcopyClient.
-type SoftcopyClientCreateTagsFuncCall struct {
+// SoftcopyClientCreateFileLinkFuncCall is an object that describes an
+// invocation of method CreateFileLink on an instance of MockSoftcopyClient.
+type SoftcopyClientCreateFileLinkFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.CreateTagsRequest
+	Arg1 *proto.CreateFileLinkRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.CreateTagsResponse
+	Result0 *proto.CreateFileLinkResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -603,7 +868,7 @@ type SoftcopyClientCreateTagsFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientCreateTagsFuncCall) Args() []interface{} {
+func (c SoftcopyClientCreateFileLinkFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -614,41 +879,41 @@ func (c SoftcopyClientCreateTagsFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientCreateTagsFuncCall) Results() []interface{} {
+func (c SoftcopyClientCreateFileLinkFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientFindFilesWithDateFunc describes the behavior when the
-// FindFilesWithDate method of the parent MockSoftcopyClient instance is
+// SoftcopyClientCreateTagCategoryFunc describes the behavior when the
+// CreateTagCategory method of the parent MockSoftcopyClient instance is
 // invoked.
-type SoftcopyClientFindFilesWithDateFunc struct {
-	defaultHook func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)
-	hooks       []func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)
-	history     []SoftcopyClientFindFilesWithDateFuncCall
+type SoftcopyClientCreateTagCategoryFunc struct {
+	defaultHook func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error)
+	hooks       []func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error)
+	history     []SoftcopyClientCreateTagCategoryFuncCall
 	mutex       sync.Mutex
 }
 
-// FindFilesWithDate delegates to the next hook function in the queue and
+// CreateTagCategory delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) FindFilesWithDate(v0 context.Context, v1 *proto.FindFilesWithDateRequest, v2 ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
-	r0, r1 := m.FindFilesWithDateFunc.nextHook()(v0, v1, v2...)
-	m.FindFilesWithDateFunc.appendCall(SoftcopyClientFindFilesWithDateFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSoftcopyClient) CreateTagCategory(v0 context.Context, v1 *proto.CreateTagCategoryRequest, v2 ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error) {
+	r0, r1 := m.CreateTagCategoryFunc.nextHook()(v0, v1, v2...)
+	m.CreateTagCategoryFunc.appendCall(SoftcopyClientCreateTagCategoryFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FindFilesWithDate
+// SetDefaultHook sets function that is called when the CreateTagCategory
 // method of the parent MockSoftcopyClient instance is invoked and the hook
 // queue is empty.
-func (f *SoftcopyClientFindFilesWithDateFunc) SetDefaultHook(hook func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)) {
+func (f *SoftcopyClientCreateTagCategoryFunc) SetDefaultHook(hook func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FindFilesWithDate method of the parent MockSoftcopyClient instance
+// CreateTagCategory method of the parent MockSoftcopyClient instance
 // inovkes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *SoftcopyClientFindFilesWithDateFunc) PushHook(hook func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)) {
+func (f *SoftcopyClientCreateTagCategoryFunc) PushHook(hook func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -656,21 +921,21 @@ func (f *SoftcopyClientFindFilesWithDateFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientFindFilesWithDateFunc) SetDefaultReturn(r0 *proto.FindFilesWithDateResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+func (f *SoftcopyClientCreateTagCategoryFunc) SetDefaultReturn(r0 *proto.CreateTagCategoryResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientFindFilesWithDateFunc) PushReturn(r0 *proto.FindFilesWithDateResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+func (f *SoftcopyClientCreateTagCategoryFunc) PushReturn(r0 *proto.CreateTagCategoryResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientFindFilesWithDateFunc) nextHook() func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+func (f *SoftcopyClientCreateTagCategoryFunc) nextHook() func(context.Context, *proto.CreateTagCategoryRequest, ...grpc.CallOption) (*proto.CreateTagCategoryResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -683,39 +948,39 @@ func (f *SoftcopyClientFindFilesWithDateFunc) nextHook() func(context.Context, *
 	return hook
 }
 
-func (f *SoftcopyClientFindFilesWithDateFunc) appendCall(r0 SoftcopyClientFindFilesWithDateFuncCall) {
+func (f *SoftcopyClientCreateTagCategoryFunc) appendCall(r0 SoftcopyClientCreateTagCategoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientFindFilesWithDateFuncCall
+// History returns a sequence of SoftcopyClientCreateTagCategoryFuncCall
 // objects describing the invocations of this function.
-func (f *SoftcopyClientFindFilesWithDateFunc) History() []SoftcopyClientFindFilesWithDateFuncCall {
+func (f *SoftcopyClientCreateTagCategoryFunc) History() []SoftcopyClientCreateTagCategoryFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientFindFilesWithDateFuncCall, len(f.history))
+	history := make([]SoftcopyClientCreateTagCategoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientFindFilesWithDateFuncCall is an object that describes an
-// invocation of method FindFilesWithDate on an instance of
+// SoftcopyClientCreateTagCategoryFuncCall is an object that describes an
+// invocation of method CreateTagCategory on an instance of
 // MockSoftcopyClient.
-type SoftcopyClientFindFilesWithDateFuncCall struct {
+type SoftcopyClientCreateTagCategoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.FindFilesWithDateRequest
+	Arg1 *proto.CreateTagCategoryRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.FindFilesWithDateResponse
+	Result0 *proto.CreateTagCategoryResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -725,7 +990,7 @@ type SoftcopyClientFindFilesWithDateFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientFindFilesWithDateFuncCall) Args() []interface{} {
+func (c SoftcopyClientCreateTagCategoryFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -736,41 +1001,39 @@ func (c SoftcopyClientFindFilesWithDateFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientFindFilesWithDateFuncCall) Results() []interface{} {
+func (c SoftcopyClientCreateTagCategoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientFindFilesWithIdPrefixFunc describes the behavior when the
-// FindFilesWithIdPrefix method of the parent MockSoftcopyClient instance is
-// invoked.
-type SoftcopyClientFindFilesWithIdPrefixFunc struct {
-	defaultHook func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)
-	hooks       []func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)
-	history     []SoftcopyClientFindFilesWithIdPrefixFuncCall
+// SoftcopyClientCreateTagsFunc describes the behavior when the CreateTags
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientCreateTagsFunc struct {
+	defaultHook func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)
+	hooks       []func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)
+	history     []SoftcopyClientCreateTagsFuncCall
 	mutex       sync.Mutex
 }
 
-// FindFilesWithIdPrefix delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) FindFilesWithIdPrefix(v0 context.Context, v1 *proto.FindFilesWithIdPrefixRequest, v2 ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
-	r0, r1 := m.FindFilesWithIdPrefixFunc.nextHook()(v0, v1, v2...)
-	m.FindFilesWithIdPrefixFunc.appendCall(SoftcopyClientFindFilesWithIdPrefixFuncCall{v0, v1, v2, r0, r1})
+// CreateTags delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) CreateTags(v0 context.Context, v1 *proto.CreateTagsRequest, v2 ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
+	r0, r1 := m.CreateTagsFunc.nextHook()(v0, v1, v2...)
+	m.CreateTagsFunc.appendCall(SoftcopyClientCreateTagsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// FindFilesWithIdPrefix method of the parent MockSoftcopyClient instance is
-// invoked and the hook queue is empty.
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) SetDefaultHook(hook func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)) {
+// SetDefaultHook sets function that is called when the CreateTags method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientCreateTagsFunc) SetDefaultHook(hook func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FindFilesWithIdPrefix method of the parent MockSoftcopyClient instance
-// inovkes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) PushHook(hook func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)) {
+// CreateTags method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientCreateTagsFunc) PushHook(hook func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -778,21 +1041,21 @@ func (f *SoftcopyClientFindFilesWithIdPrefixFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) SetDefaultReturn(r0 *proto.FindFilesWithIdPrefixResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+func (f *SoftcopyClientCreateTagsFunc) SetDefaultReturn(r0 *proto.CreateTagsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) PushReturn(r0 *proto.FindFilesWithIdPrefixResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+func (f *SoftcopyClientCreateTagsFunc) PushReturn(r0 *proto.CreateTagsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) nextHook() func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+func (f *SoftcopyClientCreateTagsFunc) nextHook() func(context.Context, *proto.CreateTagsRequest, ...grpc.CallOption) (*proto.CreateTagsResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -805,39 +1068,38 @@ func (f *SoftcopyClientFindFilesWithIdPrefixFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) appendCall(r0 SoftcopyClientFindFilesWithIdPrefixFuncCall) {
+func (f *SoftcopyClientCreateTagsFunc) appendCall(r0 SoftcopyClientCreateTagsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientFindFilesWithIdPrefixFuncCall
-// objects describing the invocations of this function.
-func (f *SoftcopyClientFindFilesWithIdPrefixFunc) History() []SoftcopyClientFindFilesWithIdPrefixFuncCall {
+// History returns a sequence of SoftcopyClientCreateTagsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientCreateTagsFunc) History() []SoftcopyClientCreateTagsFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientFindFilesWithIdPrefixFuncCall, len(f.history))
+	history := make([]SoftcopyClientCreateTagsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientFindFilesWithIdPrefixFuncCall is an object that describes
-// an invocation of method FindFilesWithIdPrefix on an instance of
-// MockSoftcopyClient.
-type SoftcopyClientFindFilesWithIdPrefixFuncCall struct {
+// SoftcopyClientCreateTagsFuncCall is an object that describes an
+// invocation of method CreateTags on an instance of MockSoftcopyClient.
+type SoftcopyClientCreateTagsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.FindFilesWithIdPrefixRequest
+	Arg1 *proto.CreateTagsRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.FindFilesWithIdPrefixResponse
+	Result0 *proto.CreateTagsResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -847,7 +1109,7 @@ type SoftcopyClientFindFilesWithIdPrefixFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientFindFilesWithIdPrefixFuncCall) Args() []interface{} {
+func (c SoftcopyClientCreateTagsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -858,41 +1120,2796 @@ func (c SoftcopyClientFindFilesWithIdPrefixFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientFindFilesWithIdPrefixFuncCall) Results() []interface{} {
+func (c SoftcopyClientCreateTagsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientFindFilesWithTagsFunc describes the behavior when the
-// FindFilesWithTags method of the parent MockSoftcopyClient instance is
-// invoked.
-type SoftcopyClientFindFilesWithTagsFunc struct {
-	defaultHook func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)
-	hooks       []func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)
-	history     []SoftcopyClientFindFilesWithTagsFuncCall
+// SoftcopyClientDeleteTagFunc describes the behavior when the DeleteTag
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientDeleteTagFunc struct {
+	defaultHook func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error)
+	hooks       []func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error)
+	history     []SoftcopyClientDeleteTagFuncCall
 	mutex       sync.Mutex
 }
 
-// FindFilesWithTags delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) FindFilesWithTags(v0 context.Context, v1 *proto.FindFilesWithTagsRequest, v2 ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
-	r0, r1 := m.FindFilesWithTagsFunc.nextHook()(v0, v1, v2...)
+// DeleteTag delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) DeleteTag(v0 context.Context, v1 *proto.DeleteTagRequest, v2 ...grpc.CallOption) (*proto.DeleteTagResponse, error) {
+	r0, r1 := m.DeleteTagFunc.nextHook()(v0, v1, v2...)
+	m.DeleteTagFunc.appendCall(SoftcopyClientDeleteTagFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DeleteTag method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientDeleteTagFunc) SetDefaultHook(hook func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteTag method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientDeleteTagFunc) PushHook(hook func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientDeleteTagFunc) SetDefaultReturn(r0 *proto.DeleteTagResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientDeleteTagFunc) PushReturn(r0 *proto.DeleteTagResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientDeleteTagFunc) nextHook() func(context.Context, *proto.DeleteTagRequest, ...grpc.CallOption) (*proto.DeleteTagResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientDeleteTagFunc) appendCall(r0 SoftcopyClientDeleteTagFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientDeleteTagFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientDeleteTagFunc) History() []SoftcopyClientDeleteTagFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientDeleteTagFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientDeleteTagFuncCall is an object that describes an invocation
+// of method DeleteTag on an instance of MockSoftcopyClient.
+type SoftcopyClientDeleteTagFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.DeleteTagRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.DeleteTagResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientDeleteTagFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientDeleteTagFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientFindFilesWithDateFunc describes the behavior when the
+// FindFilesWithDate method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientFindFilesWithDateFunc struct {
+	defaultHook func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)
+	hooks       []func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)
+	history     []SoftcopyClientFindFilesWithDateFuncCall
+	mutex       sync.Mutex
+}
+
+// FindFilesWithDate delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) FindFilesWithDate(v0 context.Context, v1 *proto.FindFilesWithDateRequest, v2 ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+	r0, r1 := m.FindFilesWithDateFunc.nextHook()(v0, v1, v2...)
+	m.FindFilesWithDateFunc.appendCall(SoftcopyClientFindFilesWithDateFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindFilesWithDate
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientFindFilesWithDateFunc) SetDefaultHook(hook func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindFilesWithDate method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientFindFilesWithDateFunc) PushHook(hook func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientFindFilesWithDateFunc) SetDefaultReturn(r0 *proto.FindFilesWithDateResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientFindFilesWithDateFunc) PushReturn(r0 *proto.FindFilesWithDateResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientFindFilesWithDateFunc) nextHook() func(context.Context, *proto.FindFilesWithDateRequest, ...grpc.CallOption) (*proto.FindFilesWithDateResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientFindFilesWithDateFunc) appendCall(r0 SoftcopyClientFindFilesWithDateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientFindFilesWithDateFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientFindFilesWithDateFunc) History() []SoftcopyClientFindFilesWithDateFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientFindFilesWithDateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientFindFilesWithDateFuncCall is an object that describes an
+// invocation of method FindFilesWithDate on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientFindFilesWithDateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.FindFilesWithDateRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.FindFilesWithDateResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientFindFilesWithDateFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientFindFilesWithDateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientFindFilesWithIdPrefixFunc describes the behavior when the
+// FindFilesWithIdPrefix method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientFindFilesWithIdPrefixFunc struct {
+	defaultHook func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)
+	hooks       []func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)
+	history     []SoftcopyClientFindFilesWithIdPrefixFuncCall
+	mutex       sync.Mutex
+}
+
+// FindFilesWithIdPrefix delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) FindFilesWithIdPrefix(v0 context.Context, v1 *proto.FindFilesWithIdPrefixRequest, v2 ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+	r0, r1 := m.FindFilesWithIdPrefixFunc.nextHook()(v0, v1, v2...)
+	m.FindFilesWithIdPrefixFunc.appendCall(SoftcopyClientFindFilesWithIdPrefixFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// FindFilesWithIdPrefix method of the parent MockSoftcopyClient instance is
+// invoked and the hook queue is empty.
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) SetDefaultHook(hook func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindFilesWithIdPrefix method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) PushHook(hook func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) SetDefaultReturn(r0 *proto.FindFilesWithIdPrefixResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) PushReturn(r0 *proto.FindFilesWithIdPrefixResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) nextHook() func(context.Context, *proto.FindFilesWithIdPrefixRequest, ...grpc.CallOption) (*proto.FindFilesWithIdPrefixResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) appendCall(r0 SoftcopyClientFindFilesWithIdPrefixFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientFindFilesWithIdPrefixFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientFindFilesWithIdPrefixFunc) History() []SoftcopyClientFindFilesWithIdPrefixFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientFindFilesWithIdPrefixFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientFindFilesWithIdPrefixFuncCall is an object that describes
+// an invocation of method FindFilesWithIdPrefix on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientFindFilesWithIdPrefixFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.FindFilesWithIdPrefixRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.FindFilesWithIdPrefixResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientFindFilesWithIdPrefixFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientFindFilesWithIdPrefixFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientFindFilesWithTagsFunc describes the behavior when the
+// FindFilesWithTags method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientFindFilesWithTagsFunc struct {
+	defaultHook func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)
+	hooks       []func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)
+	history     []SoftcopyClientFindFilesWithTagsFuncCall
+	mutex       sync.Mutex
+}
+
+// FindFilesWithTags delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) FindFilesWithTags(v0 context.Context, v1 *proto.FindFilesWithTagsRequest, v2 ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+	r0, r1 := m.FindFilesWithTagsFunc.nextHook()(v0, v1, v2...)
 	m.FindFilesWithTagsFunc.appendCall(SoftcopyClientFindFilesWithTagsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FindFilesWithTags
-// method of the parent MockSoftcopyClient instance is invoked and the hook
-// queue is empty.
-func (f *SoftcopyClientFindFilesWithTagsFunc) SetDefaultHook(hook func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)) {
+// SetDefaultHook sets function that is called when the FindFilesWithTags
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientFindFilesWithTagsFunc) SetDefaultHook(hook func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindFilesWithTags method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientFindFilesWithTagsFunc) PushHook(hook func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientFindFilesWithTagsFunc) SetDefaultReturn(r0 *proto.FindFilesWithTagsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientFindFilesWithTagsFunc) PushReturn(r0 *proto.FindFilesWithTagsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientFindFilesWithTagsFunc) nextHook() func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientFindFilesWithTagsFunc) appendCall(r0 SoftcopyClientFindFilesWithTagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientFindFilesWithTagsFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientFindFilesWithTagsFunc) History() []SoftcopyClientFindFilesWithTagsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientFindFilesWithTagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientFindFilesWithTagsFuncCall is an object that describes an
+// invocation of method FindFilesWithTags on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientFindFilesWithTagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.FindFilesWithTagsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.FindFilesWithTagsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientFindFilesWithTagsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientFindFilesWithTagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientFindTagByNameFunc describes the behavior when the
+// FindTagByName method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientFindTagByNameFunc struct {
+	defaultHook func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)
+	hooks       []func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)
+	history     []SoftcopyClientFindTagByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// FindTagByName delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) FindTagByName(v0 context.Context, v1 *proto.FindTagByNameRequest, v2 ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+	r0, r1 := m.FindTagByNameFunc.nextHook()(v0, v1, v2...)
+	m.FindTagByNameFunc.appendCall(SoftcopyClientFindTagByNameFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindTagByName method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientFindTagByNameFunc) SetDefaultHook(hook func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindTagByName method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientFindTagByNameFunc) PushHook(hook func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientFindTagByNameFunc) SetDefaultReturn(r0 *proto.FindTagByNameResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientFindTagByNameFunc) PushReturn(r0 *proto.FindTagByNameResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientFindTagByNameFunc) nextHook() func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientFindTagByNameFunc) appendCall(r0 SoftcopyClientFindTagByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientFindTagByNameFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientFindTagByNameFunc) History() []SoftcopyClientFindTagByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientFindTagByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientFindTagByNameFuncCall is an object that describes an
+// invocation of method FindTagByName on an instance of MockSoftcopyClient.
+type SoftcopyClientFindTagByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.FindTagByNameRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.FindTagByNameResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientFindTagByNameFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientFindTagByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientFlushFileFunc describes the behavior when the FlushFile
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientFlushFileFunc struct {
+	defaultHook func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)
+	hooks       []func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)
+	history     []SoftcopyClientFlushFileFuncCall
+	mutex       sync.Mutex
+}
+
+// FlushFile delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) FlushFile(v0 context.Context, v1 *proto.FlushFileRequest, v2 ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+	r0, r1 := m.FlushFileFunc.nextHook()(v0, v1, v2...)
+	m.FlushFileFunc.appendCall(SoftcopyClientFlushFileFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FlushFile method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientFlushFileFunc) SetDefaultHook(hook func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FlushFile method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientFlushFileFunc) PushHook(hook func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientFlushFileFunc) SetDefaultReturn(r0 *proto.FlushFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientFlushFileFunc) PushReturn(r0 *proto.FlushFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientFlushFileFunc) nextHook() func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientFlushFileFunc) appendCall(r0 SoftcopyClientFlushFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientFlushFileFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientFlushFileFunc) History() []SoftcopyClientFlushFileFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientFlushFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientFlushFileFuncCall is an object that describes an invocation
+// of method FlushFile on an instance of MockSoftcopyClient.
+type SoftcopyClientFlushFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.FlushFileRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.FlushFileResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientFlushFileFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientFlushFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetAllTagCategoriesFunc describes the behavior when the
+// GetAllTagCategories method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientGetAllTagCategoriesFunc struct {
+	defaultHook func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error)
+	hooks       []func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error)
+	history     []SoftcopyClientGetAllTagCategoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAllTagCategories delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetAllTagCategories(v0 context.Context, v1 *proto.GetAllTagCategoriesRequest, v2 ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error) {
+	r0, r1 := m.GetAllTagCategoriesFunc.nextHook()(v0, v1, v2...)
+	m.GetAllTagCategoriesFunc.appendCall(SoftcopyClientGetAllTagCategoriesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAllTagCategories
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientGetAllTagCategoriesFunc) SetDefaultHook(hook func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAllTagCategories method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientGetAllTagCategoriesFunc) PushHook(hook func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetAllTagCategoriesFunc) SetDefaultReturn(r0 *proto.GetAllTagCategoriesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetAllTagCategoriesFunc) PushReturn(r0 *proto.GetAllTagCategoriesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetAllTagCategoriesFunc) nextHook() func(context.Context, *proto.GetAllTagCategoriesRequest, ...grpc.CallOption) (*proto.GetAllTagCategoriesResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetAllTagCategoriesFunc) appendCall(r0 SoftcopyClientGetAllTagCategoriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetAllTagCategoriesFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientGetAllTagCategoriesFunc) History() []SoftcopyClientGetAllTagCategoriesFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetAllTagCategoriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetAllTagCategoriesFuncCall is an object that describes an
+// invocation of method GetAllTagCategories on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientGetAllTagCategoriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetAllTagCategoriesRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetAllTagCategoriesResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetAllTagCategoriesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetAllTagCategoriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetAllTagsFunc describes the behavior when the GetAllTags
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetAllTagsFunc struct {
+	defaultHook func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)
+	hooks       []func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)
+	history     []SoftcopyClientGetAllTagsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAllTags delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetAllTags(v0 context.Context, v1 *proto.GetAllTagsRequest, v2 ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+	r0, r1 := m.GetAllTagsFunc.nextHook()(v0, v1, v2...)
+	m.GetAllTagsFunc.appendCall(SoftcopyClientGetAllTagsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAllTags method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientGetAllTagsFunc) SetDefaultHook(hook func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAllTags method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetAllTagsFunc) PushHook(hook func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetAllTagsFunc) SetDefaultReturn(r0 *proto.GetAllTagsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetAllTagsFunc) PushReturn(r0 *proto.GetAllTagsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetAllTagsFunc) nextHook() func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetAllTagsFunc) appendCall(r0 SoftcopyClientGetAllTagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetAllTagsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetAllTagsFunc) History() []SoftcopyClientGetAllTagsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetAllTagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetAllTagsFuncCall is an object that describes an
+// invocation of method GetAllTags on an instance of MockSoftcopyClient.
+type SoftcopyClientGetAllTagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetAllTagsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetAllTagsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetAllTagsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetAllTagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetAuditLogFunc describes the behavior when the GetAuditLog
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetAuditLogFunc struct {
+	defaultHook func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error)
+	hooks       []func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error)
+	history     []SoftcopyClientGetAuditLogFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAuditLog delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetAuditLog(v0 context.Context, v1 *proto.GetAuditLogRequest, v2 ...grpc.CallOption) (*proto.GetAuditLogResponse, error) {
+	r0, r1 := m.GetAuditLogFunc.nextHook()(v0, v1, v2...)
+	m.GetAuditLogFunc.appendCall(SoftcopyClientGetAuditLogFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAuditLog method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientGetAuditLogFunc) SetDefaultHook(hook func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAuditLog method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetAuditLogFunc) PushHook(hook func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetAuditLogFunc) SetDefaultReturn(r0 *proto.GetAuditLogResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetAuditLogFunc) PushReturn(r0 *proto.GetAuditLogResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetAuditLogFunc) nextHook() func(context.Context, *proto.GetAuditLogRequest, ...grpc.CallOption) (*proto.GetAuditLogResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetAuditLogFunc) appendCall(r0 SoftcopyClientGetAuditLogFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetAuditLogFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetAuditLogFunc) History() []SoftcopyClientGetAuditLogFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetAuditLogFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetAuditLogFuncCall is an object that describes an
+// invocation of method GetAuditLog on an instance of MockSoftcopyClient.
+type SoftcopyClientGetAuditLogFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetAuditLogRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetAuditLogResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetAuditLogFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetAuditLogFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileFunc describes the behavior when the GetFile method
+// of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetFileFunc struct {
+	defaultHook func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)
+	hooks       []func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)
+	history     []SoftcopyClientGetFileFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFile delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFile(v0 context.Context, v1 *proto.GetFileRequest, v2 ...grpc.CallOption) (*proto.GetFileResponse, error) {
+	r0, r1 := m.GetFileFunc.nextHook()(v0, v1, v2...)
+	m.GetFileFunc.appendCall(SoftcopyClientGetFileFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFile method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientGetFileFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFile method of the parent MockSoftcopyClient instance inovkes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileFunc) PushHook(hook func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileFunc) SetDefaultReturn(r0 *proto.GetFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileFunc) PushReturn(r0 *proto.GetFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileFunc) nextHook() func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileFunc) appendCall(r0 SoftcopyClientGetFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetFileFunc) History() []SoftcopyClientGetFileFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileFuncCall is an object that describes an invocation
+// of method GetFile on an instance of MockSoftcopyClient.
+type SoftcopyClientGetFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileDaysFunc describes the behavior when the GetFileDays
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetFileDaysFunc struct {
+	defaultHook func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)
+	hooks       []func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)
+	history     []SoftcopyClientGetFileDaysFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileDays delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileDays(v0 context.Context, v1 *proto.GetFileDaysRequest, v2 ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+	r0, r1 := m.GetFileDaysFunc.nextHook()(v0, v1, v2...)
+	m.GetFileDaysFunc.appendCall(SoftcopyClientGetFileDaysFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileDays method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientGetFileDaysFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileDays method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileDaysFunc) PushHook(hook func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileDaysFunc) SetDefaultReturn(r0 *proto.GetFileDaysResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileDaysFunc) PushReturn(r0 *proto.GetFileDaysResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileDaysFunc) nextHook() func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileDaysFunc) appendCall(r0 SoftcopyClientGetFileDaysFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileDaysFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetFileDaysFunc) History() []SoftcopyClientGetFileDaysFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileDaysFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileDaysFuncCall is an object that describes an
+// invocation of method GetFileDays on an instance of MockSoftcopyClient.
+type SoftcopyClientGetFileDaysFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileDaysRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileDaysResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileDaysFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileDaysFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileFieldsFunc describes the behavior when the
+// GetFileFields method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientGetFileFieldsFunc struct {
+	defaultHook func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error)
+	hooks       []func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error)
+	history     []SoftcopyClientGetFileFieldsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileFields delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileFields(v0 context.Context, v1 *proto.GetFileFieldsRequest, v2 ...grpc.CallOption) (*proto.GetFileFieldsResponse, error) {
+	r0, r1 := m.GetFileFieldsFunc.nextHook()(v0, v1, v2...)
+	m.GetFileFieldsFunc.appendCall(SoftcopyClientGetFileFieldsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileFields method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientGetFileFieldsFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileFields method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileFieldsFunc) PushHook(hook func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileFieldsFunc) SetDefaultReturn(r0 *proto.GetFileFieldsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileFieldsFunc) PushReturn(r0 *proto.GetFileFieldsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileFieldsFunc) nextHook() func(context.Context, *proto.GetFileFieldsRequest, ...grpc.CallOption) (*proto.GetFileFieldsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileFieldsFunc) appendCall(r0 SoftcopyClientGetFileFieldsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileFieldsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetFileFieldsFunc) History() []SoftcopyClientGetFileFieldsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileFieldsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileFieldsFuncCall is an object that describes an
+// invocation of method GetFileFields on an instance of MockSoftcopyClient.
+type SoftcopyClientGetFileFieldsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileFieldsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileFieldsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileFieldsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileFieldsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileLinksFunc describes the behavior when the
+// GetFileLinks method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetFileLinksFunc struct {
+	defaultHook func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error)
+	hooks       []func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error)
+	history     []SoftcopyClientGetFileLinksFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileLinks delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileLinks(v0 context.Context, v1 *proto.GetFileLinksRequest, v2 ...grpc.CallOption) (*proto.GetFileLinksResponse, error) {
+	r0, r1 := m.GetFileLinksFunc.nextHook()(v0, v1, v2...)
+	m.GetFileLinksFunc.appendCall(SoftcopyClientGetFileLinksFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileLinks method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientGetFileLinksFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileLinks method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileLinksFunc) PushHook(hook func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileLinksFunc) SetDefaultReturn(r0 *proto.GetFileLinksResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileLinksFunc) PushReturn(r0 *proto.GetFileLinksResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileLinksFunc) nextHook() func(context.Context, *proto.GetFileLinksRequest, ...grpc.CallOption) (*proto.GetFileLinksResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileLinksFunc) appendCall(r0 SoftcopyClientGetFileLinksFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileLinksFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetFileLinksFunc) History() []SoftcopyClientGetFileLinksFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileLinksFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileLinksFuncCall is an object that describes an
+// invocation of method GetFileLinks on an instance of MockSoftcopyClient.
+type SoftcopyClientGetFileLinksFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileLinksRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileLinksResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileLinksFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileLinksFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileMonthsFunc describes the behavior when the
+// GetFileMonths method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientGetFileMonthsFunc struct {
+	defaultHook func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)
+	hooks       []func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)
+	history     []SoftcopyClientGetFileMonthsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileMonths delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileMonths(v0 context.Context, v1 *proto.GetFileMonthsRequest, v2 ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+	r0, r1 := m.GetFileMonthsFunc.nextHook()(v0, v1, v2...)
+	m.GetFileMonthsFunc.appendCall(SoftcopyClientGetFileMonthsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileMonths method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientGetFileMonthsFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileMonths method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileMonthsFunc) PushHook(hook func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileMonthsFunc) SetDefaultReturn(r0 *proto.GetFileMonthsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileMonthsFunc) PushReturn(r0 *proto.GetFileMonthsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileMonthsFunc) nextHook() func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileMonthsFunc) appendCall(r0 SoftcopyClientGetFileMonthsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileMonthsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetFileMonthsFunc) History() []SoftcopyClientGetFileMonthsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileMonthsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileMonthsFuncCall is an object that describes an
+// invocation of method GetFileMonths on an instance of MockSoftcopyClient.
+type SoftcopyClientGetFileMonthsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileMonthsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileMonthsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileMonthsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileMonthsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileVersionsFunc describes the behavior when the
+// GetFileVersions method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientGetFileVersionsFunc struct {
+	defaultHook func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error)
+	hooks       []func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error)
+	history     []SoftcopyClientGetFileVersionsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileVersions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileVersions(v0 context.Context, v1 *proto.GetFileVersionsRequest, v2 ...grpc.CallOption) (*proto.GetFileVersionsResponse, error) {
+	r0, r1 := m.GetFileVersionsFunc.nextHook()(v0, v1, v2...)
+	m.GetFileVersionsFunc.appendCall(SoftcopyClientGetFileVersionsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileVersions
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientGetFileVersionsFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileVersions method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileVersionsFunc) PushHook(hook func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileVersionsFunc) SetDefaultReturn(r0 *proto.GetFileVersionsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileVersionsFunc) PushReturn(r0 *proto.GetFileVersionsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileVersionsFunc) nextHook() func(context.Context, *proto.GetFileVersionsRequest, ...grpc.CallOption) (*proto.GetFileVersionsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileVersionsFunc) appendCall(r0 SoftcopyClientGetFileVersionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileVersionsFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientGetFileVersionsFunc) History() []SoftcopyClientGetFileVersionsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileVersionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileVersionsFuncCall is an object that describes an
+// invocation of method GetFileVersions on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientGetFileVersionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileVersionsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileVersionsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileVersionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileVersionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileWithDateFunc describes the behavior when the
+// GetFileWithDate method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientGetFileWithDateFunc struct {
+	defaultHook func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)
+	hooks       []func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)
+	history     []SoftcopyClientGetFileWithDateFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileWithDate delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileWithDate(v0 context.Context, v1 *proto.GetFileWithDateRequest, v2 ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+	r0, r1 := m.GetFileWithDateFunc.nextHook()(v0, v1, v2...)
+	m.GetFileWithDateFunc.appendCall(SoftcopyClientGetFileWithDateFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileWithDate
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientGetFileWithDateFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileWithDate method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileWithDateFunc) PushHook(hook func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileWithDateFunc) SetDefaultReturn(r0 *proto.GetFileWithDateResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileWithDateFunc) PushReturn(r0 *proto.GetFileWithDateResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileWithDateFunc) nextHook() func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileWithDateFunc) appendCall(r0 SoftcopyClientGetFileWithDateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileWithDateFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientGetFileWithDateFunc) History() []SoftcopyClientGetFileWithDateFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileWithDateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileWithDateFuncCall is an object that describes an
+// invocation of method GetFileWithDate on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientGetFileWithDateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileWithDateRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileWithDateResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileWithDateFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileWithDateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetFileYearsFunc describes the behavior when the
+// GetFileYears method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetFileYearsFunc struct {
+	defaultHook func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)
+	hooks       []func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)
+	history     []SoftcopyClientGetFileYearsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFileYears delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetFileYears(v0 context.Context, v1 *proto.GetFileYearsRequest, v2 ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+	r0, r1 := m.GetFileYearsFunc.nextHook()(v0, v1, v2...)
+	m.GetFileYearsFunc.appendCall(SoftcopyClientGetFileYearsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetFileYears method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientGetFileYearsFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFileYears method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetFileYearsFunc) PushHook(hook func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetFileYearsFunc) SetDefaultReturn(r0 *proto.GetFileYearsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetFileYearsFunc) PushReturn(r0 *proto.GetFileYearsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetFileYearsFunc) nextHook() func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetFileYearsFunc) appendCall(r0 SoftcopyClientGetFileYearsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetFileYearsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetFileYearsFunc) History() []SoftcopyClientGetFileYearsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetFileYearsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetFileYearsFuncCall is an object that describes an
+// invocation of method GetFileYears on an instance of MockSoftcopyClient.
+type SoftcopyClientGetFileYearsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetFileYearsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetFileYearsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetFileYearsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetFileYearsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetStatsFunc describes the behavior when the GetStats
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientGetStatsFunc struct {
+	defaultHook func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error)
+	hooks       []func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error)
+	history     []SoftcopyClientGetStatsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetStats delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetStats(v0 context.Context, v1 *proto.GetStatsRequest, v2 ...grpc.CallOption) (*proto.GetStatsResponse, error) {
+	r0, r1 := m.GetStatsFunc.nextHook()(v0, v1, v2...)
+	m.GetStatsFunc.appendCall(SoftcopyClientGetStatsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetStats method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientGetStatsFunc) SetDefaultHook(hook func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetStats method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetStatsFunc) PushHook(hook func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetStatsFunc) SetDefaultReturn(r0 *proto.GetStatsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetStatsFunc) PushReturn(r0 *proto.GetStatsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetStatsFunc) nextHook() func(context.Context, *proto.GetStatsRequest, ...grpc.CallOption) (*proto.GetStatsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetStatsFunc) appendCall(r0 SoftcopyClientGetStatsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetStatsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientGetStatsFunc) History() []SoftcopyClientGetStatsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetStatsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetStatsFuncCall is an object that describes an invocation
+// of method GetStats on an instance of MockSoftcopyClient.
+type SoftcopyClientGetStatsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetStatsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetStatsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetStatsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetStatsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientGetTagsForFileFunc describes the behavior when the
+// GetTagsForFile method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientGetTagsForFileFunc struct {
+	defaultHook func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)
+	hooks       []func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)
+	history     []SoftcopyClientGetTagsForFileFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTagsForFile delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) GetTagsForFile(v0 context.Context, v1 *proto.GetTagsForFileRequest, v2 ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+	r0, r1 := m.GetTagsForFileFunc.nextHook()(v0, v1, v2...)
+	m.GetTagsForFileFunc.appendCall(SoftcopyClientGetTagsForFileFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTagsForFile
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientGetTagsForFileFunc) SetDefaultHook(hook func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTagsForFile method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientGetTagsForFileFunc) PushHook(hook func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientGetTagsForFileFunc) SetDefaultReturn(r0 *proto.GetTagsForFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientGetTagsForFileFunc) PushReturn(r0 *proto.GetTagsForFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientGetTagsForFileFunc) nextHook() func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientGetTagsForFileFunc) appendCall(r0 SoftcopyClientGetTagsForFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientGetTagsForFileFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientGetTagsForFileFunc) History() []SoftcopyClientGetTagsForFileFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientGetTagsForFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientGetTagsForFileFuncCall is an object that describes an
+// invocation of method GetTagsForFile on an instance of MockSoftcopyClient.
+type SoftcopyClientGetTagsForFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.GetTagsForFileRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.GetTagsForFileResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientGetTagsForFileFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientGetTagsForFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientListFilesFunc describes the behavior when the ListFiles
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientListFilesFunc struct {
+	defaultHook func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error)
+	hooks       []func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error)
+	history     []SoftcopyClientListFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListFiles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) ListFiles(v0 context.Context, v1 *proto.ListFilesRequest, v2 ...grpc.CallOption) (*proto.ListFilesResponse, error) {
+	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2...)
+	m.ListFilesFunc.appendCall(SoftcopyClientListFilesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListFiles method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientListFilesFunc) SetDefaultHook(hook func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListFiles method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientListFilesFunc) PushHook(hook func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientListFilesFunc) SetDefaultReturn(r0 *proto.ListFilesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientListFilesFunc) PushReturn(r0 *proto.ListFilesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientListFilesFunc) nextHook() func(context.Context, *proto.ListFilesRequest, ...grpc.CallOption) (*proto.ListFilesResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientListFilesFunc) appendCall(r0 SoftcopyClientListFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientListFilesFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientListFilesFunc) History() []SoftcopyClientListFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientListFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientListFilesFuncCall is an object that describes an invocation
+// of method ListFiles on an instance of MockSoftcopyClient.
+type SoftcopyClientListFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.ListFilesRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.ListFilesResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientListFilesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientListFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientListTrashFunc describes the behavior when the ListTrash
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientListTrashFunc struct {
+	defaultHook func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error)
+	hooks       []func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error)
+	history     []SoftcopyClientListTrashFuncCall
+	mutex       sync.Mutex
+}
+
+// ListTrash delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) ListTrash(v0 context.Context, v1 *proto.ListTrashRequest, v2 ...grpc.CallOption) (*proto.ListTrashResponse, error) {
+	r0, r1 := m.ListTrashFunc.nextHook()(v0, v1, v2...)
+	m.ListTrashFunc.appendCall(SoftcopyClientListTrashFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListTrash method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientListTrashFunc) SetDefaultHook(hook func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListTrash method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientListTrashFunc) PushHook(hook func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientListTrashFunc) SetDefaultReturn(r0 *proto.ListTrashResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientListTrashFunc) PushReturn(r0 *proto.ListTrashResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientListTrashFunc) nextHook() func(context.Context, *proto.ListTrashRequest, ...grpc.CallOption) (*proto.ListTrashResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientListTrashFunc) appendCall(r0 SoftcopyClientListTrashFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientListTrashFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientListTrashFunc) History() []SoftcopyClientListTrashFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientListTrashFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientListTrashFuncCall is an object that describes an invocation
+// of method ListTrash on an instance of MockSoftcopyClient.
+type SoftcopyClientListTrashFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.ListTrashRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.ListTrashResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientListTrashFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientListTrashFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientMergeTagsFunc describes the behavior when the MergeTags
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientMergeTagsFunc struct {
+	defaultHook func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error)
+	hooks       []func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error)
+	history     []SoftcopyClientMergeTagsFuncCall
+	mutex       sync.Mutex
+}
+
+// MergeTags delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) MergeTags(v0 context.Context, v1 *proto.MergeTagsRequest, v2 ...grpc.CallOption) (*proto.MergeTagsResponse, error) {
+	r0, r1 := m.MergeTagsFunc.nextHook()(v0, v1, v2...)
+	m.MergeTagsFunc.appendCall(SoftcopyClientMergeTagsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MergeTags method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientMergeTagsFunc) SetDefaultHook(hook func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MergeTags method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientMergeTagsFunc) PushHook(hook func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientMergeTagsFunc) SetDefaultReturn(r0 *proto.MergeTagsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientMergeTagsFunc) PushReturn(r0 *proto.MergeTagsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientMergeTagsFunc) nextHook() func(context.Context, *proto.MergeTagsRequest, ...grpc.CallOption) (*proto.MergeTagsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientMergeTagsFunc) appendCall(r0 SoftcopyClientMergeTagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientMergeTagsFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientMergeTagsFunc) History() []SoftcopyClientMergeTagsFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientMergeTagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientMergeTagsFuncCall is an object that describes an invocation
+// of method MergeTags on an instance of MockSoftcopyClient.
+type SoftcopyClientMergeTagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.MergeTagsRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.MergeTagsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientMergeTagsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientMergeTagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientOpenFileFunc describes the behavior when the OpenFile
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientOpenFileFunc struct {
+	defaultHook func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)
+	hooks       []func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)
+	history     []SoftcopyClientOpenFileFuncCall
+	mutex       sync.Mutex
+}
+
+// OpenFile delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) OpenFile(v0 context.Context, v1 *proto.OpenFileRequest, v2 ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+	r0, r1 := m.OpenFileFunc.nextHook()(v0, v1, v2...)
+	m.OpenFileFunc.appendCall(SoftcopyClientOpenFileFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the OpenFile method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientOpenFileFunc) SetDefaultHook(hook func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// OpenFile method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientOpenFileFunc) PushHook(hook func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientOpenFileFunc) SetDefaultReturn(r0 *proto.OpenFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientOpenFileFunc) PushReturn(r0 *proto.OpenFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientOpenFileFunc) nextHook() func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientOpenFileFunc) appendCall(r0 SoftcopyClientOpenFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientOpenFileFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientOpenFileFunc) History() []SoftcopyClientOpenFileFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientOpenFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientOpenFileFuncCall is an object that describes an invocation
+// of method OpenFile on an instance of MockSoftcopyClient.
+type SoftcopyClientOpenFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.OpenFileRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.OpenFileResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientOpenFileFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientOpenFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SoftcopyClientPurgeFileFunc describes the behavior when the PurgeFile
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientPurgeFileFunc struct {
+	defaultHook func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error)
+	hooks       []func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error)
+	history     []SoftcopyClientPurgeFileFuncCall
+	mutex       sync.Mutex
+}
+
+// PurgeFile delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) PurgeFile(v0 context.Context, v1 *proto.PurgeFileRequest, v2 ...grpc.CallOption) (*proto.PurgeFileResponse, error) {
+	r0, r1 := m.PurgeFileFunc.nextHook()(v0, v1, v2...)
+	m.PurgeFileFunc.appendCall(SoftcopyClientPurgeFileFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the PurgeFile method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientPurgeFileFunc) SetDefaultHook(hook func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FindFilesWithTags method of the parent MockSoftcopyClient instance
-// inovkes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SoftcopyClientFindFilesWithTagsFunc) PushHook(hook func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error)) {
+// PurgeFile method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientPurgeFileFunc) PushHook(hook func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -900,21 +3917,21 @@ func (f *SoftcopyClientFindFilesWithTagsFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientFindFilesWithTagsFunc) SetDefaultReturn(r0 *proto.FindFilesWithTagsResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+func (f *SoftcopyClientPurgeFileFunc) SetDefaultReturn(r0 *proto.PurgeFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientFindFilesWithTagsFunc) PushReturn(r0 *proto.FindFilesWithTagsResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+func (f *SoftcopyClientPurgeFileFunc) PushReturn(r0 *proto.PurgeFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientFindFilesWithTagsFunc) nextHook() func(context.Context, *proto.FindFilesWithTagsRequest, ...grpc.CallOption) (*proto.FindFilesWithTagsResponse, error) {
+func (f *SoftcopyClientPurgeFileFunc) nextHook() func(context.Context, *proto.PurgeFileRequest, ...grpc.CallOption) (*proto.PurgeFileResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -927,39 +3944,38 @@ func (f *SoftcopyClientFindFilesWithTagsFunc) nextHook() func(context.Context, *
 	return hook
 }
 
-func (f *SoftcopyClientFindFilesWithTagsFunc) appendCall(r0 SoftcopyClientFindFilesWithTagsFuncCall) {
+func (f *SoftcopyClientPurgeFileFunc) appendCall(r0 SoftcopyClientPurgeFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientFindFilesWithTagsFuncCall
-// objects describing the invocations of this function.
-func (f *SoftcopyClientFindFilesWithTagsFunc) History() []SoftcopyClientFindFilesWithTagsFuncCall {
+// History returns a sequence of SoftcopyClientPurgeFileFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientPurgeFileFunc) History() []SoftcopyClientPurgeFileFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientFindFilesWithTagsFuncCall, len(f.history))
+	history := make([]SoftcopyClientPurgeFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientFindFilesWithTagsFuncCall is an object that describes an
-// invocation of method FindFilesWithTags on an instance of
-// MockSoftcopyClient.
-type SoftcopyClientFindFilesWithTagsFuncCall struct {
+// SoftcopyClientPurgeFileFuncCall is an object that describes an invocation
+// of method PurgeFile on an instance of MockSoftcopyClient.
+type SoftcopyClientPurgeFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.FindFilesWithTagsRequest
+	Arg1 *proto.PurgeFileRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.FindFilesWithTagsResponse
+	Result0 *proto.PurgeFileResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -969,7 +3985,7 @@ type SoftcopyClientFindFilesWithTagsFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientFindFilesWithTagsFuncCall) Args() []interface{} {
+func (c SoftcopyClientPurgeFileFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -980,40 +3996,39 @@ func (c SoftcopyClientFindFilesWithTagsFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientFindFilesWithTagsFuncCall) Results() []interface{} {
+func (c SoftcopyClientPurgeFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientFindTagByNameFunc describes the behavior when the
-// FindTagByName method of the parent MockSoftcopyClient instance is
-// invoked.
-type SoftcopyClientFindTagByNameFunc struct {
-	defaultHook func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)
-	hooks       []func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)
-	history     []SoftcopyClientFindTagByNameFuncCall
+// SoftcopyClientQueryFilesFunc describes the behavior when the QueryFiles
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientQueryFilesFunc struct {
+	defaultHook func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error)
+	hooks       []func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error)
+	history     []SoftcopyClientQueryFilesFuncCall
 	mutex       sync.Mutex
 }
 
-// FindTagByName delegates to the next hook function in the queue and stores
+// QueryFiles delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) FindTagByName(v0 context.Context, v1 *proto.FindTagByNameRequest, v2 ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
-	r0, r1 := m.FindTagByNameFunc.nextHook()(v0, v1, v2...)
-	m.FindTagByNameFunc.appendCall(SoftcopyClientFindTagByNameFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSoftcopyClient) QueryFiles(v0 context.Context, v1 *proto.QueryFilesRequest, v2 ...grpc.CallOption) (*proto.QueryFilesResponse, error) {
+	r0, r1 := m.QueryFilesFunc.nextHook()(v0, v1, v2...)
+	m.QueryFilesFunc.appendCall(SoftcopyClientQueryFilesFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FindTagByName method
-// of the parent MockSoftcopyClient instance is invoked and the hook queue
-// is empty.
-func (f *SoftcopyClientFindTagByNameFunc) SetDefaultHook(hook func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)) {
+// SetDefaultHook sets function that is called when the QueryFiles method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientQueryFilesFunc) SetDefaultHook(hook func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FindTagByName method of the parent MockSoftcopyClient instance inovkes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SoftcopyClientFindTagByNameFunc) PushHook(hook func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error)) {
+// QueryFiles method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientQueryFilesFunc) PushHook(hook func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1021,21 +4036,21 @@ func (f *SoftcopyClientFindTagByNameFunc) PushHook(hook func(context.Context, *p
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientFindTagByNameFunc) SetDefaultReturn(r0 *proto.FindTagByNameResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+func (f *SoftcopyClientQueryFilesFunc) SetDefaultReturn(r0 *proto.QueryFilesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientFindTagByNameFunc) PushReturn(r0 *proto.FindTagByNameResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+func (f *SoftcopyClientQueryFilesFunc) PushReturn(r0 *proto.QueryFilesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientFindTagByNameFunc) nextHook() func(context.Context, *proto.FindTagByNameRequest, ...grpc.CallOption) (*proto.FindTagByNameResponse, error) {
+func (f *SoftcopyClientQueryFilesFunc) nextHook() func(context.Context, *proto.QueryFilesRequest, ...grpc.CallOption) (*proto.QueryFilesResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1048,38 +4063,38 @@ func (f *SoftcopyClientFindTagByNameFunc) nextHook() func(context.Context, *prot
 	return hook
 }
 
-func (f *SoftcopyClientFindTagByNameFunc) appendCall(r0 SoftcopyClientFindTagByNameFuncCall) {
+func (f *SoftcopyClientQueryFilesFunc) appendCall(r0 SoftcopyClientQueryFilesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientFindTagByNameFuncCall objects
+// History returns a sequence of SoftcopyClientQueryFilesFuncCall objects
 // describing the invocations of this function.
-func (f *SoftcopyClientFindTagByNameFunc) History() []SoftcopyClientFindTagByNameFuncCall {
+func (f *SoftcopyClientQueryFilesFunc) History() []SoftcopyClientQueryFilesFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientFindTagByNameFuncCall, len(f.history))
+	history := make([]SoftcopyClientQueryFilesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientFindTagByNameFuncCall is an object that describes an
-// invocation of method FindTagByName on an instance of MockSoftcopyClient.
-type SoftcopyClientFindTagByNameFuncCall struct {
+// SoftcopyClientQueryFilesFuncCall is an object that describes an
+// invocation of method QueryFiles on an instance of MockSoftcopyClient.
+type SoftcopyClientQueryFilesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.FindTagByNameRequest
+	Arg1 *proto.QueryFilesRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.FindTagByNameResponse
+	Result0 *proto.QueryFilesResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1089,7 +4104,7 @@ type SoftcopyClientFindTagByNameFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientFindTagByNameFuncCall) Args() []interface{} {
+func (c SoftcopyClientQueryFilesFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1100,39 +4115,39 @@ func (c SoftcopyClientFindTagByNameFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientFindTagByNameFuncCall) Results() []interface{} {
+func (c SoftcopyClientQueryFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientFlushFileFunc describes the behavior when the FlushFile
+// SoftcopyClientReadFileFunc describes the behavior when the ReadFile
 // method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientFlushFileFunc struct {
-	defaultHook func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)
-	hooks       []func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)
-	history     []SoftcopyClientFlushFileFuncCall
+type SoftcopyClientReadFileFunc struct {
+	defaultHook func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)
+	hooks       []func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)
+	history     []SoftcopyClientReadFileFuncCall
 	mutex       sync.Mutex
 }
 
-// FlushFile delegates to the next hook function in the queue and stores the
+// ReadFile delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSoftcopyClient) FlushFile(v0 context.Context, v1 *proto.FlushFileRequest, v2 ...grpc.CallOption) (*proto.FlushFileResponse, error) {
-	r0, r1 := m.FlushFileFunc.nextHook()(v0, v1, v2...)
-	m.FlushFileFunc.appendCall(SoftcopyClientFlushFileFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSoftcopyClient) ReadFile(v0 context.Context, v1 *proto.ReadFileRequest, v2 ...grpc.CallOption) (*proto.ReadFileResponse, error) {
+	r0, r1 := m.ReadFileFunc.nextHook()(v0, v1, v2...)
+	m.ReadFileFunc.appendCall(SoftcopyClientReadFileFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FlushFile method of
+// SetDefaultHook sets function that is called when the ReadFile method of
 // the parent MockSoftcopyClient instance is invoked and the hook queue is
 // empty.
-func (f *SoftcopyClientFlushFileFunc) SetDefaultHook(hook func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)) {
+func (f *SoftcopyClientReadFileFunc) SetDefaultHook(hook func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FlushFile method of the parent MockSoftcopyClient instance inovkes the
+// ReadFile method of the parent MockSoftcopyClient instance inovkes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SoftcopyClientFlushFileFunc) PushHook(hook func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error)) {
+func (f *SoftcopyClientReadFileFunc) PushHook(hook func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1140,21 +4155,21 @@ func (f *SoftcopyClientFlushFileFunc) PushHook(hook func(context.Context, *proto
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientFlushFileFunc) SetDefaultReturn(r0 *proto.FlushFileResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+func (f *SoftcopyClientReadFileFunc) SetDefaultReturn(r0 *proto.ReadFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientFlushFileFunc) PushReturn(r0 *proto.FlushFileResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+func (f *SoftcopyClientReadFileFunc) PushReturn(r0 *proto.ReadFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientFlushFileFunc) nextHook() func(context.Context, *proto.FlushFileRequest, ...grpc.CallOption) (*proto.FlushFileResponse, error) {
+func (f *SoftcopyClientReadFileFunc) nextHook() func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1167,38 +4182,38 @@ func (f *SoftcopyClientFlushFileFunc) nextHook() func(context.Context, *proto.Fl
 	return hook
 }
 
-func (f *SoftcopyClientFlushFileFunc) appendCall(r0 SoftcopyClientFlushFileFuncCall) {
+func (f *SoftcopyClientReadFileFunc) appendCall(r0 SoftcopyClientReadFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientFlushFileFuncCall objects
+// History returns a sequence of SoftcopyClientReadFileFuncCall objects
 // describing the invocations of this function.
-func (f *SoftcopyClientFlushFileFunc) History() []SoftcopyClientFlushFileFuncCall {
+func (f *SoftcopyClientReadFileFunc) History() []SoftcopyClientReadFileFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientFlushFileFuncCall, len(f.history))
+	history := make([]SoftcopyClientReadFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientFlushFileFuncCall is an object that describes an invocation
-// of method FlushFile on an instance of MockSoftcopyClient.
-type SoftcopyClientFlushFileFuncCall struct {
+// SoftcopyClientReadFileFuncCall is an object that describes an invocation
+// of method ReadFile on an instance of MockSoftcopyClient.
+type SoftcopyClientReadFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.FlushFileRequest
+	Arg1 *proto.ReadFileRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.FlushFileResponse
+	Result0 *proto.ReadFileResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1208,7 +4223,7 @@ type SoftcopyClientFlushFileFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientFlushFileFuncCall) Args() []interface{} {
+func (c SoftcopyClientReadFileFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1219,39 +4234,39 @@ func (c SoftcopyClientFlushFileFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientFlushFileFuncCall) Results() []interface{} {
+func (c SoftcopyClientReadFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetAllTagsFunc describes the behavior when the GetAllTags
+// SoftcopyClientRemoveFileFunc describes the behavior when the RemoveFile
 // method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientGetAllTagsFunc struct {
-	defaultHook func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)
-	hooks       []func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)
-	history     []SoftcopyClientGetAllTagsFuncCall
+type SoftcopyClientRemoveFileFunc struct {
+	defaultHook func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)
+	hooks       []func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)
+	history     []SoftcopyClientRemoveFileFuncCall
 	mutex       sync.Mutex
 }
 
-// GetAllTags delegates to the next hook function in the queue and stores
+// RemoveFile delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetAllTags(v0 context.Context, v1 *proto.GetAllTagsRequest, v2 ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
-	r0, r1 := m.GetAllTagsFunc.nextHook()(v0, v1, v2...)
-	m.GetAllTagsFunc.appendCall(SoftcopyClientGetAllTagsFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSoftcopyClient) RemoveFile(v0 context.Context, v1 *proto.RemoveFileRequest, v2 ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
+	r0, r1 := m.RemoveFileFunc.nextHook()(v0, v1, v2...)
+	m.RemoveFileFunc.appendCall(SoftcopyClientRemoveFileFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetAllTags method of
+// SetDefaultHook sets function that is called when the RemoveFile method of
 // the parent MockSoftcopyClient instance is invoked and the hook queue is
 // empty.
-func (f *SoftcopyClientGetAllTagsFunc) SetDefaultHook(hook func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)) {
+func (f *SoftcopyClientRemoveFileFunc) SetDefaultHook(hook func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetAllTags method of the parent MockSoftcopyClient instance inovkes the
+// RemoveFile method of the parent MockSoftcopyClient instance inovkes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SoftcopyClientGetAllTagsFunc) PushHook(hook func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error)) {
+func (f *SoftcopyClientRemoveFileFunc) PushHook(hook func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1259,21 +4274,21 @@ func (f *SoftcopyClientGetAllTagsFunc) PushHook(hook func(context.Context, *prot
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetAllTagsFunc) SetDefaultReturn(r0 *proto.GetAllTagsResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+func (f *SoftcopyClientRemoveFileFunc) SetDefaultReturn(r0 *proto.RemoveFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetAllTagsFunc) PushReturn(r0 *proto.GetAllTagsResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+func (f *SoftcopyClientRemoveFileFunc) PushReturn(r0 *proto.RemoveFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetAllTagsFunc) nextHook() func(context.Context, *proto.GetAllTagsRequest, ...grpc.CallOption) (*proto.GetAllTagsResponse, error) {
+func (f *SoftcopyClientRemoveFileFunc) nextHook() func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1286,38 +4301,38 @@ func (f *SoftcopyClientGetAllTagsFunc) nextHook() func(context.Context, *proto.G
 	return hook
 }
 
-func (f *SoftcopyClientGetAllTagsFunc) appendCall(r0 SoftcopyClientGetAllTagsFuncCall) {
+func (f *SoftcopyClientRemoveFileFunc) appendCall(r0 SoftcopyClientRemoveFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetAllTagsFuncCall objects
+// History returns a sequence of SoftcopyClientRemoveFileFuncCall objects
 // describing the invocations of this function.
-func (f *SoftcopyClientGetAllTagsFunc) History() []SoftcopyClientGetAllTagsFuncCall {
+func (f *SoftcopyClientRemoveFileFunc) History() []SoftcopyClientRemoveFileFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetAllTagsFuncCall, len(f.history))
+	history := make([]SoftcopyClientRemoveFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientGetAllTagsFuncCall is an object that describes an
-// invocation of method GetAllTags on an instance of MockSoftcopyClient.
-type SoftcopyClientGetAllTagsFuncCall struct {
+// SoftcopyClientRemoveFileFuncCall is an object that describes an
+// invocation of method RemoveFile on an instance of MockSoftcopyClient.
+type SoftcopyClientRemoveFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetAllTagsRequest
+	Arg1 *proto.RemoveFileRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetAllTagsResponse
+	Result0 *proto.RemoveFileResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1327,7 +4342,7 @@ type SoftcopyClientGetAllTagsFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetAllTagsFuncCall) Args() []interface{} {
+func (c SoftcopyClientRemoveFileFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1338,39 +4353,40 @@ func (c SoftcopyClientGetAllTagsFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetAllTagsFuncCall) Results() []interface{} {
+func (c SoftcopyClientRemoveFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetFileFunc describes the behavior when the GetFile method
-// of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientGetFileFunc struct {
-	defaultHook func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)
-	hooks       []func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)
-	history     []SoftcopyClientGetFileFuncCall
+// SoftcopyClientRemoveFileLinkFunc describes the behavior when the
+// RemoveFileLink method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientRemoveFileLinkFunc struct {
+	defaultHook func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error)
+	hooks       []func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error)
+	history     []SoftcopyClientRemoveFileLinkFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFile delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetFile(v0 context.Context, v1 *proto.GetFileRequest, v2 ...grpc.CallOption) (*proto.GetFileResponse, error) {
-	r0, r1 := m.GetFileFunc.nextHook()(v0, v1, v2...)
-	m.GetFileFunc.appendCall(SoftcopyClientGetFileFuncCall{v0, v1, v2, r0, r1})
+// RemoveFileLink delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) RemoveFileLink(v0 context.Context, v1 *proto.RemoveFileLinkRequest, v2 ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error) {
+	r0, r1 := m.RemoveFileLinkFunc.nextHook()(v0, v1, v2...)
+	m.RemoveFileLinkFunc.appendCall(SoftcopyClientRemoveFileLinkFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetFile method of
-// the parent MockSoftcopyClient instance is invoked and the hook queue is
-// empty.
-func (f *SoftcopyClientGetFileFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)) {
+// SetDefaultHook sets function that is called when the RemoveFileLink
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientRemoveFileLinkFunc) SetDefaultHook(hook func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFile method of the parent MockSoftcopyClient instance inovkes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *SoftcopyClientGetFileFunc) PushHook(hook func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error)) {
+// RemoveFileLink method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientRemoveFileLinkFunc) PushHook(hook func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1378,21 +4394,21 @@ func (f *SoftcopyClientGetFileFunc) PushHook(hook func(context.Context, *proto.G
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetFileFunc) SetDefaultReturn(r0 *proto.GetFileResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
+func (f *SoftcopyClientRemoveFileLinkFunc) SetDefaultReturn(r0 *proto.RemoveFileLinkResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetFileFunc) PushReturn(r0 *proto.GetFileResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
+func (f *SoftcopyClientRemoveFileLinkFunc) PushReturn(r0 *proto.RemoveFileLinkResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetFileFunc) nextHook() func(context.Context, *proto.GetFileRequest, ...grpc.CallOption) (*proto.GetFileResponse, error) {
+func (f *SoftcopyClientRemoveFileLinkFunc) nextHook() func(context.Context, *proto.RemoveFileLinkRequest, ...grpc.CallOption) (*proto.RemoveFileLinkResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1405,38 +4421,38 @@ func (f *SoftcopyClientGetFileFunc) nextHook() func(context.Context, *proto.GetF
 	return hook
 }
 
-func (f *SoftcopyClientGetFileFunc) appendCall(r0 SoftcopyClientGetFileFuncCall) {
+func (f *SoftcopyClientRemoveFileLinkFunc) appendCall(r0 SoftcopyClientRemoveFileLinkFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetFileFuncCall objects
-// describing the invocations of this function.
-func (f *SoftcopyClientGetFileFunc) History() []SoftcopyClientGetFileFuncCall {
+// History returns a sequence of SoftcopyClientRemoveFileLinkFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientRemoveFileLinkFunc) History() []SoftcopyClientRemoveFileLinkFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetFileFuncCall, len(f.history))
+	history := make([]SoftcopyClientRemoveFileLinkFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientGetFileFuncCall is an object that describes an invocation
-// of method GetFile on an instance of MockSoftcopyClient.
-type SoftcopyClientGetFileFuncCall struct {
+// SoftcopyClientRemoveFileLinkFuncCall is an object that describes an
+// invocation of method RemoveFileLink on an instance of MockSoftcopyClient.
+type SoftcopyClientRemoveFileLinkFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetFileRequest
+	Arg1 *proto.RemoveFileLinkRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetFileResponse
+	Result0 *proto.RemoveFileLinkResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1446,7 +4462,7 @@ type SoftcopyClientGetFileFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetFileFuncCall) Args() []interface{} {
+func (c SoftcopyClientRemoveFileLinkFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1457,39 +4473,41 @@ func (c SoftcopyClientGetFileFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetFileFuncCall) Results() []interface{} {
+func (c SoftcopyClientRemoveFileLinkFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetFileDaysFunc describes the behavior when the GetFileDays
-// method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientGetFileDaysFunc struct {
-	defaultHook func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)
-	hooks       []func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)
-	history     []SoftcopyClientGetFileDaysFuncCall
+// SoftcopyClientRemoveTagCategoryFunc describes the behavior when the
+// RemoveTagCategory method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientRemoveTagCategoryFunc struct {
+	defaultHook func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error)
+	hooks       []func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error)
+	history     []SoftcopyClientRemoveTagCategoryFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFileDays delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetFileDays(v0 context.Context, v1 *proto.GetFileDaysRequest, v2 ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
-	r0, r1 := m.GetFileDaysFunc.nextHook()(v0, v1, v2...)
-	m.GetFileDaysFunc.appendCall(SoftcopyClientGetFileDaysFuncCall{v0, v1, v2, r0, r1})
+// RemoveTagCategory delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) RemoveTagCategory(v0 context.Context, v1 *proto.RemoveTagCategoryRequest, v2 ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error) {
+	r0, r1 := m.RemoveTagCategoryFunc.nextHook()(v0, v1, v2...)
+	m.RemoveTagCategoryFunc.appendCall(SoftcopyClientRemoveTagCategoryFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetFileDays method
-// of the parent MockSoftcopyClient instance is invoked and the hook queue
-// is empty.
-func (f *SoftcopyClientGetFileDaysFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)) {
+// SetDefaultHook sets function that is called when the RemoveTagCategory
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientRemoveTagCategoryFunc) SetDefaultHook(hook func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFileDays method of the parent MockSoftcopyClient instance inovkes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SoftcopyClientGetFileDaysFunc) PushHook(hook func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error)) {
+// RemoveTagCategory method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientRemoveTagCategoryFunc) PushHook(hook func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1497,21 +4515,21 @@ func (f *SoftcopyClientGetFileDaysFunc) PushHook(hook func(context.Context, *pro
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetFileDaysFunc) SetDefaultReturn(r0 *proto.GetFileDaysResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+func (f *SoftcopyClientRemoveTagCategoryFunc) SetDefaultReturn(r0 *proto.RemoveTagCategoryResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetFileDaysFunc) PushReturn(r0 *proto.GetFileDaysResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+func (f *SoftcopyClientRemoveTagCategoryFunc) PushReturn(r0 *proto.RemoveTagCategoryResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetFileDaysFunc) nextHook() func(context.Context, *proto.GetFileDaysRequest, ...grpc.CallOption) (*proto.GetFileDaysResponse, error) {
+func (f *SoftcopyClientRemoveTagCategoryFunc) nextHook() func(context.Context, *proto.RemoveTagCategoryRequest, ...grpc.CallOption) (*proto.RemoveTagCategoryResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1524,38 +4542,39 @@ func (f *SoftcopyClientGetFileDaysFunc) nextHook() func(context.Context, *proto.
 	return hook
 }
 
-func (f *SoftcopyClientGetFileDaysFunc) appendCall(r0 SoftcopyClientGetFileDaysFuncCall) {
+func (f *SoftcopyClientRemoveTagCategoryFunc) appendCall(r0 SoftcopyClientRemoveTagCategoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetFileDaysFuncCall objects
-// describing the invocations of this function.
-func (f *SoftcopyClientGetFileDaysFunc) History() []SoftcopyClientGetFileDaysFuncCall {
+// History returns a sequence of SoftcopyClientRemoveTagCategoryFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientRemoveTagCategoryFunc) History() []SoftcopyClientRemoveTagCategoryFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetFileDaysFuncCall, len(f.history))
+	history := make([]SoftcopyClientRemoveTagCategoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientGetFileDaysFuncCall is an object that describes an
-// invocation of method GetFileDays on an instance of MockSoftcopyClient.
-type SoftcopyClientGetFileDaysFuncCall struct {
+// SoftcopyClientRemoveTagCategoryFuncCall is an object that describes an
+// invocation of method RemoveTagCategory on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientRemoveTagCategoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetFileDaysRequest
+	Arg1 *proto.RemoveTagCategoryRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetFileDaysResponse
+	Result0 *proto.RemoveTagCategoryResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1565,7 +4584,7 @@ type SoftcopyClientGetFileDaysFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetFileDaysFuncCall) Args() []interface{} {
+func (c SoftcopyClientRemoveTagCategoryFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1576,40 +4595,39 @@ func (c SoftcopyClientGetFileDaysFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetFileDaysFuncCall) Results() []interface{} {
+func (c SoftcopyClientRemoveTagCategoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetFileMonthsFunc describes the behavior when the
-// GetFileMonths method of the parent MockSoftcopyClient instance is
-// invoked.
-type SoftcopyClientGetFileMonthsFunc struct {
-	defaultHook func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)
-	hooks       []func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)
-	history     []SoftcopyClientGetFileMonthsFuncCall
+// SoftcopyClientRenameTagFunc describes the behavior when the RenameTag
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientRenameTagFunc struct {
+	defaultHook func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error)
+	hooks       []func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error)
+	history     []SoftcopyClientRenameTagFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFileMonths delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetFileMonths(v0 context.Context, v1 *proto.GetFileMonthsRequest, v2 ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
-	r0, r1 := m.GetFileMonthsFunc.nextHook()(v0, v1, v2...)
-	m.GetFileMonthsFunc.appendCall(SoftcopyClientGetFileMonthsFuncCall{v0, v1, v2, r0, r1})
+// RenameTag delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSoftcopyClient) RenameTag(v0 context.Context, v1 *proto.RenameTagRequest, v2 ...grpc.CallOption) (*proto.RenameTagResponse, error) {
+	r0, r1 := m.RenameTagFunc.nextHook()(v0, v1, v2...)
+	m.RenameTagFunc.appendCall(SoftcopyClientRenameTagFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetFileMonths method
-// of the parent MockSoftcopyClient instance is invoked and the hook queue
-// is empty.
-func (f *SoftcopyClientGetFileMonthsFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)) {
+// SetDefaultHook sets function that is called when the RenameTag method of
+// the parent MockSoftcopyClient instance is invoked and the hook queue is
+// empty.
+func (f *SoftcopyClientRenameTagFunc) SetDefaultHook(hook func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFileMonths method of the parent MockSoftcopyClient instance inovkes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SoftcopyClientGetFileMonthsFunc) PushHook(hook func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error)) {
+// RenameTag method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientRenameTagFunc) PushHook(hook func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1617,21 +4635,21 @@ func (f *SoftcopyClientGetFileMonthsFunc) PushHook(hook func(context.Context, *p
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetFileMonthsFunc) SetDefaultReturn(r0 *proto.GetFileMonthsResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+func (f *SoftcopyClientRenameTagFunc) SetDefaultReturn(r0 *proto.RenameTagResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetFileMonthsFunc) PushReturn(r0 *proto.GetFileMonthsResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+func (f *SoftcopyClientRenameTagFunc) PushReturn(r0 *proto.RenameTagResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetFileMonthsFunc) nextHook() func(context.Context, *proto.GetFileMonthsRequest, ...grpc.CallOption) (*proto.GetFileMonthsResponse, error) {
+func (f *SoftcopyClientRenameTagFunc) nextHook() func(context.Context, *proto.RenameTagRequest, ...grpc.CallOption) (*proto.RenameTagResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1644,38 +4662,38 @@ func (f *SoftcopyClientGetFileMonthsFunc) nextHook() func(context.Context, *prot
 	return hook
 }
 
-func (f *SoftcopyClientGetFileMonthsFunc) appendCall(r0 SoftcopyClientGetFileMonthsFuncCall) {
+func (f *SoftcopyClientRenameTagFunc) appendCall(r0 SoftcopyClientRenameTagFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetFileMonthsFuncCall objects
+// History returns a sequence of SoftcopyClientRenameTagFuncCall objects
 // describing the invocations of this function.
-func (f *SoftcopyClientGetFileMonthsFunc) History() []SoftcopyClientGetFileMonthsFuncCall {
+func (f *SoftcopyClientRenameTagFunc) History() []SoftcopyClientRenameTagFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetFileMonthsFuncCall, len(f.history))
+	history := make([]SoftcopyClientRenameTagFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientGetFileMonthsFuncCall is an object that describes an
-// invocation of method GetFileMonths on an instance of MockSoftcopyClient.
-type SoftcopyClientGetFileMonthsFuncCall struct {
+// SoftcopyClientRenameTagFuncCall is an object that describes an invocation
+// of method RenameTag on an instance of MockSoftcopyClient.
+type SoftcopyClientRenameTagFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetFileMonthsRequest
+	Arg1 *proto.RenameTagRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetFileMonthsResponse
+	Result0 *proto.RenameTagResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1685,7 +4703,7 @@ type SoftcopyClientGetFileMonthsFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetFileMonthsFuncCall) Args() []interface{} {
+func (c SoftcopyClientRenameTagFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1696,40 +4714,39 @@ func (c SoftcopyClientGetFileMonthsFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetFileMonthsFuncCall) Results() []interface{} {
+func (c SoftcopyClientRenameTagFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetFileWithDateFunc describes the behavior when the
-// GetFileWithDate method of the parent MockSoftcopyClient instance is
-// invoked.
-type SoftcopyClientGetFileWithDateFunc struct {
-	defaultHook func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)
-	hooks       []func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)
-	history     []SoftcopyClientGetFileWithDateFuncCall
+// SoftcopyClientRestoreFileFunc describes the behavior when the RestoreFile
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientRestoreFileFunc struct {
+	defaultHook func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error)
+	hooks       []func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error)
+	history     []SoftcopyClientRestoreFileFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFileWithDate delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetFileWithDate(v0 context.Context, v1 *proto.GetFileWithDateRequest, v2 ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
-	r0, r1 := m.GetFileWithDateFunc.nextHook()(v0, v1, v2...)
-	m.GetFileWithDateFunc.appendCall(SoftcopyClientGetFileWithDateFuncCall{v0, v1, v2, r0, r1})
+// RestoreFile delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) RestoreFile(v0 context.Context, v1 *proto.RestoreFileRequest, v2 ...grpc.CallOption) (*proto.RestoreFileResponse, error) {
+	r0, r1 := m.RestoreFileFunc.nextHook()(v0, v1, v2...)
+	m.RestoreFileFunc.appendCall(SoftcopyClientRestoreFileFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetFileWithDate
-// method of the parent MockSoftcopyClient instance is invoked and the hook
-// queue is empty.
-func (f *SoftcopyClientGetFileWithDateFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)) {
+// SetDefaultHook sets function that is called when the RestoreFile method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientRestoreFileFunc) SetDefaultHook(hook func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFileWithDate method of the parent MockSoftcopyClient instance inovkes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SoftcopyClientGetFileWithDateFunc) PushHook(hook func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error)) {
+// RestoreFile method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientRestoreFileFunc) PushHook(hook func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1737,21 +4754,21 @@ func (f *SoftcopyClientGetFileWithDateFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetFileWithDateFunc) SetDefaultReturn(r0 *proto.GetFileWithDateResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+func (f *SoftcopyClientRestoreFileFunc) SetDefaultReturn(r0 *proto.RestoreFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetFileWithDateFunc) PushReturn(r0 *proto.GetFileWithDateResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+func (f *SoftcopyClientRestoreFileFunc) PushReturn(r0 *proto.RestoreFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetFileWithDateFunc) nextHook() func(context.Context, *proto.GetFileWithDateRequest, ...grpc.CallOption) (*proto.GetFileWithDateResponse, error) {
+func (f *SoftcopyClientRestoreFileFunc) nextHook() func(context.Context, *proto.RestoreFileRequest, ...grpc.CallOption) (*proto.RestoreFileResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1764,39 +4781,38 @@ func (f *SoftcopyClientGetFileWithDateFunc) nextHook() func(context.Context, *pr
 	return hook
 }
 
-func (f *SoftcopyClientGetFileWithDateFunc) appendCall(r0 SoftcopyClientGetFileWithDateFuncCall) {
+func (f *SoftcopyClientRestoreFileFunc) appendCall(r0 SoftcopyClientRestoreFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetFileWithDateFuncCall
-// objects describing the invocations of this function.
-func (f *SoftcopyClientGetFileWithDateFunc) History() []SoftcopyClientGetFileWithDateFuncCall {
+// History returns a sequence of SoftcopyClientRestoreFileFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientRestoreFileFunc) History() []SoftcopyClientRestoreFileFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetFileWithDateFuncCall, len(f.history))
+	history := make([]SoftcopyClientRestoreFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientGetFileWithDateFuncCall is an object that describes an
-// invocation of method GetFileWithDate on an instance of
-// MockSoftcopyClient.
-type SoftcopyClientGetFileWithDateFuncCall struct {
+// SoftcopyClientRestoreFileFuncCall is an object that describes an
+// invocation of method RestoreFile on an instance of MockSoftcopyClient.
+type SoftcopyClientRestoreFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetFileWithDateRequest
+	Arg1 *proto.RestoreFileRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetFileWithDateResponse
+	Result0 *proto.RestoreFileResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1806,7 +4822,7 @@ type SoftcopyClientGetFileWithDateFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetFileWithDateFuncCall) Args() []interface{} {
+func (c SoftcopyClientRestoreFileFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1817,39 +4833,41 @@ func (c SoftcopyClientGetFileWithDateFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetFileWithDateFuncCall) Results() []interface{} {
+func (c SoftcopyClientRestoreFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetFileYearsFunc describes the behavior when the
-// GetFileYears method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientGetFileYearsFunc struct {
-	defaultHook func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)
-	hooks       []func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)
-	history     []SoftcopyClientGetFileYearsFuncCall
+// SoftcopyClientRestoreFileVersionFunc describes the behavior when the
+// RestoreFileVersion method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientRestoreFileVersionFunc struct {
+	defaultHook func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error)
+	hooks       []func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error)
+	history     []SoftcopyClientRestoreFileVersionFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFileYears delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetFileYears(v0 context.Context, v1 *proto.GetFileYearsRequest, v2 ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
-	r0, r1 := m.GetFileYearsFunc.nextHook()(v0, v1, v2...)
-	m.GetFileYearsFunc.appendCall(SoftcopyClientGetFileYearsFuncCall{v0, v1, v2, r0, r1})
+// RestoreFileVersion delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) RestoreFileVersion(v0 context.Context, v1 *proto.RestoreFileVersionRequest, v2 ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error) {
+	r0, r1 := m.RestoreFileVersionFunc.nextHook()(v0, v1, v2...)
+	m.RestoreFileVersionFunc.appendCall(SoftcopyClientRestoreFileVersionFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetFileYears method
-// of the parent MockSoftcopyClient instance is invoked and the hook queue
-// is empty.
-func (f *SoftcopyClientGetFileYearsFunc) SetDefaultHook(hook func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)) {
+// SetDefaultHook sets function that is called when the RestoreFileVersion
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientRestoreFileVersionFunc) SetDefaultHook(hook func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFileYears method of the parent MockSoftcopyClient instance inovkes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SoftcopyClientGetFileYearsFunc) PushHook(hook func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error)) {
+// RestoreFileVersion method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientRestoreFileVersionFunc) PushHook(hook func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1857,21 +4875,21 @@ func (f *SoftcopyClientGetFileYearsFunc) PushHook(hook func(context.Context, *pr
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetFileYearsFunc) SetDefaultReturn(r0 *proto.GetFileYearsResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+func (f *SoftcopyClientRestoreFileVersionFunc) SetDefaultReturn(r0 *proto.RestoreFileVersionResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetFileYearsFunc) PushReturn(r0 *proto.GetFileYearsResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+func (f *SoftcopyClientRestoreFileVersionFunc) PushReturn(r0 *proto.RestoreFileVersionResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetFileYearsFunc) nextHook() func(context.Context, *proto.GetFileYearsRequest, ...grpc.CallOption) (*proto.GetFileYearsResponse, error) {
+func (f *SoftcopyClientRestoreFileVersionFunc) nextHook() func(context.Context, *proto.RestoreFileVersionRequest, ...grpc.CallOption) (*proto.RestoreFileVersionResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1884,38 +4902,39 @@ func (f *SoftcopyClientGetFileYearsFunc) nextHook() func(context.Context, *proto
 	return hook
 }
 
-func (f *SoftcopyClientGetFileYearsFunc) appendCall(r0 SoftcopyClientGetFileYearsFuncCall) {
+func (f *SoftcopyClientRestoreFileVersionFunc) appendCall(r0 SoftcopyClientRestoreFileVersionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetFileYearsFuncCall objects
-// describing the invocations of this function.
-func (f *SoftcopyClientGetFileYearsFunc) History() []SoftcopyClientGetFileYearsFuncCall {
+// History returns a sequence of SoftcopyClientRestoreFileVersionFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientRestoreFileVersionFunc) History() []SoftcopyClientRestoreFileVersionFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetFileYearsFuncCall, len(f.history))
+	history := make([]SoftcopyClientRestoreFileVersionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
-}
-
-// SoftcopyClientGetFileYearsFuncCall is an object that describes an
-// invocation of method GetFileYears on an instance of MockSoftcopyClient.
-type SoftcopyClientGetFileYearsFuncCall struct {
+}
+
+// SoftcopyClientRestoreFileVersionFuncCall is an object that describes an
+// invocation of method RestoreFileVersion on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientRestoreFileVersionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetFileYearsRequest
+	Arg1 *proto.RestoreFileVersionRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetFileYearsResponse
+	Result0 *proto.RestoreFileVersionResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1925,7 +4944,7 @@ type SoftcopyClientGetFileYearsFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetFileYearsFuncCall) Args() []interface{} {
+func (c SoftcopyClientRestoreFileVersionFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1936,40 +4955,39 @@ func (c SoftcopyClientGetFileYearsFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetFileYearsFuncCall) Results() []interface{} {
+func (c SoftcopyClientRestoreFileVersionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientGetTagsForFileFunc describes the behavior when the
-// GetTagsForFile method of the parent MockSoftcopyClient instance is
-// invoked.
-type SoftcopyClientGetTagsForFileFunc struct {
-	defaultHook func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)
-	hooks       []func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)
-	history     []SoftcopyClientGetTagsForFileFuncCall
+// SoftcopyClientSearchFilesFunc describes the behavior when the SearchFiles
+// method of the parent MockSoftcopyClient instance is invoked.
+type SoftcopyClientSearchFilesFunc struct {
+	defaultHook func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error)
+	hooks       []func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error)
+	history     []SoftcopyClientSearchFilesFuncCall
 	mutex       sync.Mutex
 }
 
-// GetTagsForFile delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) GetTagsForFile(v0 context.Context, v1 *proto.GetTagsForFileRequest, v2 ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
-	r0, r1 := m.GetTagsForFileFunc.nextHook()(v0, v1, v2...)
-	m.GetTagsForFileFunc.appendCall(SoftcopyClientGetTagsForFileFuncCall{v0, v1, v2, r0, r1})
+// SearchFiles delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) SearchFiles(v0 context.Context, v1 *proto.SearchFilesRequest, v2 ...grpc.CallOption) (*proto.SearchFilesResponse, error) {
+	r0, r1 := m.SearchFilesFunc.nextHook()(v0, v1, v2...)
+	m.SearchFilesFunc.appendCall(SoftcopyClientSearchFilesFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetTagsForFile
-// method of the parent MockSoftcopyClient instance is invoked and the hook
-// queue is empty.
-func (f *SoftcopyClientGetTagsForFileFunc) SetDefaultHook(hook func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)) {
+// SetDefaultHook sets function that is called when the SearchFiles method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientSearchFilesFunc) SetDefaultHook(hook func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetTagsForFile method of the parent MockSoftcopyClient instance inovkes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SoftcopyClientGetTagsForFileFunc) PushHook(hook func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error)) {
+// SearchFiles method of the parent MockSoftcopyClient instance inovkes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SoftcopyClientSearchFilesFunc) PushHook(hook func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1977,21 +4995,21 @@ func (f *SoftcopyClientGetTagsForFileFunc) PushHook(hook func(context.Context, *
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientGetTagsForFileFunc) SetDefaultReturn(r0 *proto.GetTagsForFileResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+func (f *SoftcopyClientSearchFilesFunc) SetDefaultReturn(r0 *proto.SearchFilesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientGetTagsForFileFunc) PushReturn(r0 *proto.GetTagsForFileResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+func (f *SoftcopyClientSearchFilesFunc) PushReturn(r0 *proto.SearchFilesResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientGetTagsForFileFunc) nextHook() func(context.Context, *proto.GetTagsForFileRequest, ...grpc.CallOption) (*proto.GetTagsForFileResponse, error) {
+func (f *SoftcopyClientSearchFilesFunc) nextHook() func(context.Context, *proto.SearchFilesRequest, ...grpc.CallOption) (*proto.SearchFilesResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2004,38 +5022,38 @@ func (f *SoftcopyClientGetTagsForFileFunc) nextHook() func(context.Context, *pro
 	return hook
 }
 
-func (f *SoftcopyClientGetTagsForFileFunc) appendCall(r0 SoftcopyClientGetTagsForFileFuncCall) {
+func (f *SoftcopyClientSearchFilesFunc) appendCall(r0 SoftcopyClientSearchFilesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientGetTagsForFileFuncCall
-// objects describing the invocations of this function.
-func (f *SoftcopyClientGetTagsForFileFunc) History() []SoftcopyClientGetTagsForFileFuncCall {
+// History returns a sequence of SoftcopyClientSearchFilesFuncCall objects
+// describing the invocations of this function.
+func (f *SoftcopyClientSearchFilesFunc) History() []SoftcopyClientSearchFilesFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientGetTagsForFileFuncCall, len(f.history))
+	history := make([]SoftcopyClientSearchFilesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientGetTagsForFileFuncCall is an object that describes an
-// invocation of method GetTagsForFile on an instance of MockSoftcopyClient.
-type SoftcopyClientGetTagsForFileFuncCall struct {
+// SoftcopyClientSearchFilesFuncCall is an object that describes an
+// invocation of method SearchFiles on an instance of MockSoftcopyClient.
+type SoftcopyClientSearchFilesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.GetTagsForFileRequest
+	Arg1 *proto.SearchFilesRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.GetTagsForFileResponse
+	Result0 *proto.SearchFilesResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2045,7 +5063,7 @@ type SoftcopyClientGetTagsForFileFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientGetTagsForFileFuncCall) Args() []interface{} {
+func (c SoftcopyClientSearchFilesFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -2056,39 +5074,40 @@ func (c SoftcopyClientGetTagsForFileFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientGetTagsForFileFuncCall) Results() []interface{} {
+func (c SoftcopyClientSearchFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientOpenFileFunc describes the behavior when the OpenFile
-// method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientOpenFileFunc struct {
-	defaultHook func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)
-	hooks       []func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)
-	history     []SoftcopyClientOpenFileFuncCall
+// SoftcopyClientSetFileFieldsFunc describes the behavior when the
+// SetFileFields method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientSetFileFieldsFunc struct {
+	defaultHook func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error)
+	hooks       []func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error)
+	history     []SoftcopyClientSetFileFieldsFuncCall
 	mutex       sync.Mutex
 }
 
-// OpenFile delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSoftcopyClient) OpenFile(v0 context.Context, v1 *proto.OpenFileRequest, v2 ...grpc.CallOption) (*proto.OpenFileResponse, error) {
-	r0, r1 := m.OpenFileFunc.nextHook()(v0, v1, v2...)
-	m.OpenFileFunc.appendCall(SoftcopyClientOpenFileFuncCall{v0, v1, v2, r0, r1})
+// SetFileFields delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) SetFileFields(v0 context.Context, v1 *proto.SetFileFieldsRequest, v2 ...grpc.CallOption) (*proto.SetFileFieldsResponse, error) {
+	r0, r1 := m.SetFileFieldsFunc.nextHook()(v0, v1, v2...)
+	m.SetFileFieldsFunc.appendCall(SoftcopyClientSetFileFieldsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the OpenFile method of
-// the parent MockSoftcopyClient instance is invoked and the hook queue is
-// empty.
-func (f *SoftcopyClientOpenFileFunc) SetDefaultHook(hook func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)) {
+// SetDefaultHook sets function that is called when the SetFileFields method
+// of the parent MockSoftcopyClient instance is invoked and the hook queue
+// is empty.
+func (f *SoftcopyClientSetFileFieldsFunc) SetDefaultHook(hook func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// OpenFile method of the parent MockSoftcopyClient instance inovkes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SoftcopyClientOpenFileFunc) PushHook(hook func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error)) {
+// SetFileFields method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientSetFileFieldsFunc) PushHook(hook func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2096,21 +5115,21 @@ func (f *SoftcopyClientOpenFileFunc) PushHook(hook func(context.Context, *proto.
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientOpenFileFunc) SetDefaultReturn(r0 *proto.OpenFileResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+func (f *SoftcopyClientSetFileFieldsFunc) SetDefaultReturn(r0 *proto.SetFileFieldsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientOpenFileFunc) PushReturn(r0 *proto.OpenFileResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+func (f *SoftcopyClientSetFileFieldsFunc) PushReturn(r0 *proto.SetFileFieldsResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientOpenFileFunc) nextHook() func(context.Context, *proto.OpenFileRequest, ...grpc.CallOption) (*proto.OpenFileResponse, error) {
+func (f *SoftcopyClientSetFileFieldsFunc) nextHook() func(context.Context, *proto.SetFileFieldsRequest, ...grpc.CallOption) (*proto.SetFileFieldsResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2123,38 +5142,38 @@ func (f *SoftcopyClientOpenFileFunc) nextHook() func(context.Context, *proto.Ope
 	return hook
 }
 
-func (f *SoftcopyClientOpenFileFunc) appendCall(r0 SoftcopyClientOpenFileFuncCall) {
+func (f *SoftcopyClientSetFileFieldsFunc) appendCall(r0 SoftcopyClientSetFileFieldsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientOpenFileFuncCall objects
+// History returns a sequence of SoftcopyClientSetFileFieldsFuncCall objects
 // describing the invocations of this function.
-func (f *SoftcopyClientOpenFileFunc) History() []SoftcopyClientOpenFileFuncCall {
+func (f *SoftcopyClientSetFileFieldsFunc) History() []SoftcopyClientSetFileFieldsFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientOpenFileFuncCall, len(f.history))
+	history := make([]SoftcopyClientSetFileFieldsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientOpenFileFuncCall is an object that describes an invocation
-// of method OpenFile on an instance of MockSoftcopyClient.
-type SoftcopyClientOpenFileFuncCall struct {
+// SoftcopyClientSetFileFieldsFuncCall is an object that describes an
+// invocation of method SetFileFields on an instance of MockSoftcopyClient.
+type SoftcopyClientSetFileFieldsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.OpenFileRequest
+	Arg1 *proto.SetFileFieldsRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.OpenFileResponse
+	Result0 *proto.SetFileFieldsResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2164,7 +5183,7 @@ type SoftcopyClientOpenFileFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientOpenFileFuncCall) Args() []interface{} {
+func (c SoftcopyClientSetFileFieldsFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -2175,39 +5194,40 @@ func (c SoftcopyClientOpenFileFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientOpenFileFuncCall) Results() []interface{} {
+func (c SoftcopyClientSetFileFieldsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientReadFileFunc describes the behavior when the ReadFile
-// method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientReadFileFunc struct {
-	defaultHook func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)
-	hooks       []func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)
-	history     []SoftcopyClientReadFileFuncCall
+// SoftcopyClientSetTagCategoryFunc describes the behavior when the
+// SetTagCategory method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientSetTagCategoryFunc struct {
+	defaultHook func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error)
+	hooks       []func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error)
+	history     []SoftcopyClientSetTagCategoryFuncCall
 	mutex       sync.Mutex
 }
 
-// ReadFile delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSoftcopyClient) ReadFile(v0 context.Context, v1 *proto.ReadFileRequest, v2 ...grpc.CallOption) (*proto.ReadFileResponse, error) {
-	r0, r1 := m.ReadFileFunc.nextHook()(v0, v1, v2...)
-	m.ReadFileFunc.appendCall(SoftcopyClientReadFileFuncCall{v0, v1, v2, r0, r1})
+// SetTagCategory delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) SetTagCategory(v0 context.Context, v1 *proto.SetTagCategoryRequest, v2 ...grpc.CallOption) (*proto.SetTagCategoryResponse, error) {
+	r0, r1 := m.SetTagCategoryFunc.nextHook()(v0, v1, v2...)
+	m.SetTagCategoryFunc.appendCall(SoftcopyClientSetTagCategoryFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ReadFile method of
-// the parent MockSoftcopyClient instance is invoked and the hook queue is
-// empty.
-func (f *SoftcopyClientReadFileFunc) SetDefaultHook(hook func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)) {
+// SetDefaultHook sets function that is called when the SetTagCategory
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientSetTagCategoryFunc) SetDefaultHook(hook func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ReadFile method of the parent MockSoftcopyClient instance inovkes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SoftcopyClientReadFileFunc) PushHook(hook func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error)) {
+// SetTagCategory method of the parent MockSoftcopyClient instance inovkes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SoftcopyClientSetTagCategoryFunc) PushHook(hook func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2215,21 +5235,21 @@ func (f *SoftcopyClientReadFileFunc) PushHook(hook func(context.Context, *proto.
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientReadFileFunc) SetDefaultReturn(r0 *proto.ReadFileResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
+func (f *SoftcopyClientSetTagCategoryFunc) SetDefaultReturn(r0 *proto.SetTagCategoryResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientReadFileFunc) PushReturn(r0 *proto.ReadFileResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
+func (f *SoftcopyClientSetTagCategoryFunc) PushReturn(r0 *proto.SetTagCategoryResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientReadFileFunc) nextHook() func(context.Context, *proto.ReadFileRequest, ...grpc.CallOption) (*proto.ReadFileResponse, error) {
+func (f *SoftcopyClientSetTagCategoryFunc) nextHook() func(context.Context, *proto.SetTagCategoryRequest, ...grpc.CallOption) (*proto.SetTagCategoryResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2242,38 +5262,38 @@ func (f *SoftcopyClientReadFileFunc) nextHook() func(context.Context, *proto.Rea
 	return hook
 }
 
-func (f *SoftcopyClientReadFileFunc) appendCall(r0 SoftcopyClientReadFileFuncCall) {
+func (f *SoftcopyClientSetTagCategoryFunc) appendCall(r0 SoftcopyClientSetTagCategoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientReadFileFuncCall objects
-// describing the invocations of this function.
-func (f *SoftcopyClientReadFileFunc) History() []SoftcopyClientReadFileFuncCall {
+// History returns a sequence of SoftcopyClientSetTagCategoryFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientSetTagCategoryFunc) History() []SoftcopyClientSetTagCategoryFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientReadFileFuncCall, len(f.history))
+	history := make([]SoftcopyClientSetTagCategoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientReadFileFuncCall is an object that describes an invocation
-// of method ReadFile on an instance of MockSoftcopyClient.
-type SoftcopyClientReadFileFuncCall struct {
+// SoftcopyClientSetTagCategoryFuncCall is an object that describes an
+// invocation of method SetTagCategory on an instance of MockSoftcopyClient.
+type SoftcopyClientSetTagCategoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.ReadFileRequest
+	Arg1 *proto.SetTagCategoryRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.ReadFileResponse
+	Result0 *proto.SetTagCategoryResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2283,7 +5303,7 @@ type SoftcopyClientReadFileFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientReadFileFuncCall) Args() []interface{} {
+func (c SoftcopyClientSetTagCategoryFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -2294,39 +5314,39 @@ func (c SoftcopyClientReadFileFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientReadFileFuncCall) Results() []interface{} {
+func (c SoftcopyClientSetTagCategoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SoftcopyClientRemoveFileFunc describes the behavior when the RemoveFile
+// SoftcopyClientUpdateFileFunc describes the behavior when the UpdateFile
 // method of the parent MockSoftcopyClient instance is invoked.
-type SoftcopyClientRemoveFileFunc struct {
-	defaultHook func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)
-	hooks       []func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)
-	history     []SoftcopyClientRemoveFileFuncCall
+type SoftcopyClientUpdateFileFunc struct {
+	defaultHook func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error)
+	hooks       []func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error)
+	history     []SoftcopyClientUpdateFileFuncCall
 	mutex       sync.Mutex
 }
 
-// RemoveFile delegates to the next hook function in the queue and stores
+// UpdateFile delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockSoftcopyClient) RemoveFile(v0 context.Context, v1 *proto.RemoveFileRequest, v2 ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
-	r0, r1 := m.RemoveFileFunc.nextHook()(v0, v1, v2...)
-	m.RemoveFileFunc.appendCall(SoftcopyClientRemoveFileFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSoftcopyClient) UpdateFile(v0 context.Context, v1 *proto.UpdateFileRequest, v2 ...grpc.CallOption) (*proto.UpdateFileResponse, error) {
+	r0, r1 := m.UpdateFileFunc.nextHook()(v0, v1, v2...)
+	m.UpdateFileFunc.appendCall(SoftcopyClientUpdateFileFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RemoveFile method of
+// SetDefaultHook sets function that is called when the UpdateFile method of
 // the parent MockSoftcopyClient instance is invoked and the hook queue is
 // empty.
-func (f *SoftcopyClientRemoveFileFunc) SetDefaultHook(hook func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)) {
+func (f *SoftcopyClientUpdateFileFunc) SetDefaultHook(hook func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RemoveFile method of the parent MockSoftcopyClient instance inovkes the
+// UpdateFile method of the parent MockSoftcopyClient instance inovkes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SoftcopyClientRemoveFileFunc) PushHook(hook func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error)) {
+func (f *SoftcopyClientUpdateFileFunc) PushHook(hook func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2334,21 +5354,21 @@ func (f *SoftcopyClientRemoveFileFunc) PushHook(hook func(context.Context, *prot
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *SoftcopyClientRemoveFileFunc) SetDefaultReturn(r0 *proto.RemoveFileResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
+func (f *SoftcopyClientUpdateFileFunc) SetDefaultReturn(r0 *proto.UpdateFileResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *SoftcopyClientRemoveFileFunc) PushReturn(r0 *proto.RemoveFileResponse, r1 error) {
-	f.PushHook(func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
+func (f *SoftcopyClientUpdateFileFunc) PushReturn(r0 *proto.UpdateFileResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *SoftcopyClientRemoveFileFunc) nextHook() func(context.Context, *proto.RemoveFileRequest, ...grpc.CallOption) (*proto.RemoveFileResponse, error) {
+func (f *SoftcopyClientUpdateFileFunc) nextHook() func(context.Context, *proto.UpdateFileRequest, ...grpc.CallOption) (*proto.UpdateFileResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2361,38 +5381,38 @@ func (f *SoftcopyClientRemoveFileFunc) nextHook() func(context.Context, *proto.R
 	return hook
 }
 
-func (f *SoftcopyClientRemoveFileFunc) appendCall(r0 SoftcopyClientRemoveFileFuncCall) {
+func (f *SoftcopyClientUpdateFileFunc) appendCall(r0 SoftcopyClientUpdateFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SoftcopyClientRemoveFileFuncCall objects
+// History returns a sequence of SoftcopyClientUpdateFileFuncCall objects
 // describing the invocations of this function.
-func (f *SoftcopyClientRemoveFileFunc) History() []SoftcopyClientRemoveFileFuncCall {
+func (f *SoftcopyClientUpdateFileFunc) History() []SoftcopyClientUpdateFileFuncCall {
 	f.mutex.Lock()
-	history := make([]SoftcopyClientRemoveFileFuncCall, len(f.history))
+	history := make([]SoftcopyClientUpdateFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SoftcopyClientRemoveFileFuncCall is an object that describes an
-// invocation of method RemoveFile on an instance of MockSoftcopyClient.
-type SoftcopyClientRemoveFileFuncCall struct {
+// SoftcopyClientUpdateFileFuncCall is an object that describes an
+// invocation of method UpdateFile on an instance of MockSoftcopyClient.
+type SoftcopyClientUpdateFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *proto.RemoveFileRequest
+	Arg1 *proto.UpdateFileRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *proto.RemoveFileResponse
+	Result0 *proto.UpdateFileResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2402,7 +5422,7 @@ type SoftcopyClientRemoveFileFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c SoftcopyClientRemoveFileFuncCall) Args() []interface{} {
+func (c SoftcopyClientUpdateFileFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -2413,7 +5433,7 @@ func (c SoftcopyClientRemoveFileFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SoftcopyClientRemoveFileFuncCall) Results() []interface{} {
+func (c SoftcopyClientUpdateFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -2657,6 +5677,128 @@ func (c SoftcopyClientUpdateFileTagsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SoftcopyClientUpdateTagCategoryFunc describes the behavior when the
+// UpdateTagCategory method of the parent MockSoftcopyClient instance is
+// invoked.
+type SoftcopyClientUpdateTagCategoryFunc struct {
+	defaultHook func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error)
+	hooks       []func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error)
+	history     []SoftcopyClientUpdateTagCategoryFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTagCategory delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSoftcopyClient) UpdateTagCategory(v0 context.Context, v1 *proto.UpdateTagCategoryRequest, v2 ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error) {
+	r0, r1 := m.UpdateTagCategoryFunc.nextHook()(v0, v1, v2...)
+	m.UpdateTagCategoryFunc.appendCall(SoftcopyClientUpdateTagCategoryFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UpdateTagCategory
+// method of the parent MockSoftcopyClient instance is invoked and the hook
+// queue is empty.
+func (f *SoftcopyClientUpdateTagCategoryFunc) SetDefaultHook(hook func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTagCategory method of the parent MockSoftcopyClient instance
+// inovkes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SoftcopyClientUpdateTagCategoryFunc) PushHook(hook func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SoftcopyClientUpdateTagCategoryFunc) SetDefaultReturn(r0 *proto.UpdateTagCategoryResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SoftcopyClientUpdateTagCategoryFunc) PushReturn(r0 *proto.UpdateTagCategoryResponse, r1 error) {
+	f.PushHook(func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *SoftcopyClientUpdateTagCategoryFunc) nextHook() func(context.Context, *proto.UpdateTagCategoryRequest, ...grpc.CallOption) (*proto.UpdateTagCategoryResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SoftcopyClientUpdateTagCategoryFunc) appendCall(r0 SoftcopyClientUpdateTagCategoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SoftcopyClientUpdateTagCategoryFuncCall
+// objects describing the invocations of this function.
+func (f *SoftcopyClientUpdateTagCategoryFunc) History() []SoftcopyClientUpdateTagCategoryFuncCall {
+	f.mutex.Lock()
+	history := make([]SoftcopyClientUpdateTagCategoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SoftcopyClientUpdateTagCategoryFuncCall is an object that describes an
+// invocation of method UpdateTagCategory on an instance of
+// MockSoftcopyClient.
+type SoftcopyClientUpdateTagCategoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *proto.UpdateTagCategoryRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *proto.UpdateTagCategoryResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c SoftcopyClientUpdateTagCategoryFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SoftcopyClientUpdateTagCategoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // SoftcopyClientWriteFileFunc describes the behavior when the WriteFile
 // method of the parent MockSoftcopyClient instance is invoked.
 type SoftcopyClientWriteFileFunc struct {
@@ -5,6 +5,7 @@ SCRIPT_DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" >/dev/null 2>&1 && pwd )"
 VAULT_PATHS=(
     "internal/app/softcopy-server/uiserver/backend"
     "internal/app/softcopy-server/uiserver/frontend"
+    "internal/pkg/storage/data/postgres/migrations"
     "internal/pkg/storage/data/sqlite/migrations"
 )
 